@@ -56,6 +56,7 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager"
 	"github.com/kubeedge/kubeedge/common/constants"
 	"github.com/kubeedge/kubeedge/pkg/features"
+	"github.com/kubeedge/kubeedge/pkg/tracing"
 	"github.com/kubeedge/kubeedge/pkg/util"
 	"github.com/kubeedge/kubeedge/pkg/util/flag"
 	"github.com/kubeedge/kubeedge/pkg/version"
@@ -94,6 +95,11 @@ kubernetes controller which manages devices so that the device metadata/status d
 			// start monitor server
 			go monitor.ServeMonitor(config.CommonConfig.MonitorServer)
 
+			shutdownTracing, err := tracing.Init(context.Background(), cmd.Name(), config.CommonConfig.Tracing)
+			if err != nil {
+				klog.Exit(err)
+			}
+
 			// To help debugging, immediately log version
 			klog.Infof("Version: %+v", version.Get())
 			enableImpersonation := config.Modules.CloudHub.Authorization != nil &&
@@ -101,16 +107,23 @@ kubernetes controller which manages devices so that the device metadata/status d
 				!config.Modules.CloudHub.Authorization.Debug
 			client.InitKubeEdgeClient(config.KubeAPIConfig, enableImpersonation)
 
-			// Negotiate TunnelPort for multi cloudcore instances
-			waitTime := rand.Int31n(10)
-			time.Sleep(time.Duration(waitTime) * time.Second)
-			tunnelport, err := NegotiateTunnelPort()
-			if err != nil {
-				panic(err)
+			tunnelRouting := cloudstream.TunnelRoutingEnabled(config.Modules.CloudStream)
+			if tunnelRouting {
+				// The stream requests are routed between the cloudcore instances, the kubelet endpoint
+				// of the nodes is the stream server of any instance.
+				config.CommonConfig.TunnelPort = int(config.Modules.CloudStream.StreamPort)
+			} else {
+				// Negotiate TunnelPort for multi cloudcore instances
+				waitTime := rand.Int31n(10)
+				time.Sleep(time.Duration(waitTime) * time.Second)
+				tunnelport, err := NegotiateTunnelPort()
+				if err != nil {
+					panic(err)
+				}
+
+				config.CommonConfig.TunnelPort = *tunnelport
 			}
 
-			config.CommonConfig.TunnelPort = *tunnelport
-
 			if changed := v1alpha1.AdjustCloudCoreConfig(config); changed {
 				updateCloudCoreConfigMap(config)
 			}
@@ -125,7 +138,11 @@ kubernetes controller which manages devices so that the device metadata/status d
 
 			registerModules(config)
 
-			if config.Modules.IptablesManager == nil || config.Modules.IptablesManager.Enable && config.Modules.IptablesManager.Mode == v1alpha1.InternalMode {
+			// The tunnel port rules would also forward the stream requests proxied between the instances
+			// when the tunnel port is the stream port, the kube-apiserver reaches the edge nodes through
+			// the egress proxy of the stream server instead.
+			if !tunnelRouting && (config.Modules.IptablesManager == nil ||
+				config.Modules.IptablesManager.Enable && config.Modules.IptablesManager.Mode == v1alpha1.InternalMode) {
 				// By default, IptablesManager manages tunnel port related iptables rules
 				// The internal mode will share the host network, forward to the stream port.
 				streamPort := int(config.Modules.CloudStream.StreamPort)
@@ -136,6 +153,13 @@ kubernetes controller which manages devices so that the device metadata/status d
 			core.StartModules()
 			gis.Start(ctx.Done())
 			core.GracefulShutdown()
+
+			// flush the spans which are not exported yet
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				klog.Errorf("failed to shutdown tracing: %v", err)
+			}
 		},
 	}
 	fs := cmd.Flags()
@@ -65,7 +65,7 @@ func addToScheme(scheme *runtime.Scheme) {
 // AdmissionController implements the admission webhook for validation of configuration.
 type AdmissionController struct {
 	Client    *kubernetes.Clientset
-	CrdClient *versioned.Clientset
+	CrdClient versioned.Interface
 }
 
 func strPtr(s string) *string { return &s }
@@ -395,6 +395,10 @@ func (ac *AdmissionController) getRuleEndpoint(namespace, name string) (*v1.Rule
 	return ac.CrdClient.RulesV1().RuleEndpoints(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 }
 
+func (ac *AdmissionController) getDeviceModel(namespace, name string) (*v1beta1.DeviceModel, error) {
+	return ac.CrdClient.DevicesV1beta1().DeviceModels(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
 func (ac *AdmissionController) listRule(namespace string) ([]v1.Rule, error) {
 	rules, err := ac.CrdClient.RulesV1().Rules(namespace).List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
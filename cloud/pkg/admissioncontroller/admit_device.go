@@ -1,10 +1,16 @@
 package admissioncontroller
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 
@@ -25,7 +31,15 @@ func admitDevice(review admissionv1.AdmissionReview) *admissionv1.AdmissionRespo
 			klog.Errorf("validation failed with error: %v", err)
 			return toAdmissionResponse(err)
 		}
-		msg = validateDevice(&device, &reviewResponse)
+		var oldDevice *devicesv1beta1.Device
+		if review.Request.Operation == admissionv1.Update && len(review.Request.OldObject.Raw) > 0 {
+			oldDevice = &devicesv1beta1.Device{}
+			if _, _, err := deserializer.Decode(review.Request.OldObject.Raw, nil, oldDevice); err != nil {
+				klog.Errorf("validation failed with error: %v", err)
+				return toAdmissionResponse(err)
+			}
+		}
+		msg = validateDevice(&device, oldDevice, &reviewResponse)
 	case admissionv1.Delete, admissionv1.Connect:
 		//no rule defined for above operations, greenlight for all of above.
 		reviewResponse.Allowed = true
@@ -40,7 +54,8 @@ func admitDevice(review admissionv1.AdmissionReview) *admissionv1.AdmissionRespo
 	return &reviewResponse
 }
 
-func validateDevice(device *devicesv1beta1.Device, response *admissionv1.AdmissionResponse) string {
+// validateDevice validates the device, oldDevice is the device being updated and is nil on creation.
+func validateDevice(device, oldDevice *devicesv1beta1.Device, response *admissionv1.AdmissionResponse) string {
 	//device properties name must be unique.
 	var msg string
 	size := len(device.Spec.Properties)
@@ -54,9 +69,154 @@ func validateDevice(device *devicesv1beta1.Device, response *admissionv1.Admissi
 		}
 	}
 
+	if device.Spec.DeviceModelRef == nil || device.Spec.DeviceModelRef.Name == "" {
+		return msg
+	}
+	model, err := controller.getDeviceModel(device.Namespace, device.Spec.DeviceModelRef.Name)
+	if err != nil {
+		// the device model may be created after the device, and the request should not be
+		// denied because of transient errors, so the device is allowed without validating
+		// it against the device model.
+		klog.Warningf("failed to get device model %s/%s, %v", device.Namespace, device.Spec.DeviceModelRef.Name, err)
+		if apierrors.IsNotFound(err) {
+			response.Warnings = append(response.Warnings, fmt.Sprintf("device model %s is not found, "+
+				"the device is not validated against it", device.Spec.DeviceModelRef.Name))
+		} else {
+			response.Warnings = append(response.Warnings, fmt.Sprintf("failed to get device model %s, "+
+				"the device is not validated against it: %v", device.Spec.DeviceModelRef.Name, err))
+		}
+		return msg
+	}
+	if err := validateDeviceWithModel(device, oldDevice, model); err != nil {
+		response.Allowed = false
+		return err.Error()
+	}
+
 	return msg
 }
 
+// validateDeviceWithModel checks the properties of the device against the properties defined
+// in its device model, and the visitors against the validator of the device protocol. The visitors
+// are only validated on creation or when they are changed, so that the devices created before
+// can still be updated.
+func validateDeviceWithModel(device, oldDevice *devicesv1beta1.Device, model *devicesv1beta1.DeviceModel) error {
+	modelProperties := make(map[string]*devicesv1beta1.ModelProperty, len(model.Spec.Properties))
+	for i := range model.Spec.Properties {
+		modelProperties[model.Spec.Properties[i].Name] = &model.Spec.Properties[i]
+	}
+	oldVisitors := make(map[string]*devicesv1beta1.VisitorConfig)
+	if oldDevice != nil {
+		for i := range oldDevice.Spec.Properties {
+			oldVisitors[oldDevice.Spec.Properties[i].Name] = &oldDevice.Spec.Properties[i].Visitors
+		}
+	}
+
+	protocolName := device.Spec.Protocol.ProtocolName
+	for i := range device.Spec.Properties {
+		property := &device.Spec.Properties[i]
+		modelProperty, ok := modelProperties[property.Name]
+		if !ok {
+			return fmt.Errorf("property %s is not defined in device model %s", property.Name, model.Name)
+		}
+		if err := validateDesiredValue(property.Desired.Value, modelProperty); err != nil {
+			return fmt.Errorf("invalid desired value of property %s: %v", property.Name, err)
+		}
+
+		visitors := &property.Visitors
+		if visitors.ProtocolName != "" && protocolName != "" && visitors.ProtocolName != protocolName {
+			return fmt.Errorf("protocol %s of the visitors of property %s is different from device protocol %s",
+				visitors.ProtocolName, property.Name, protocolName)
+		}
+		if old, ok := oldVisitors[property.Name]; ok && oldDevice.Spec.Protocol.ProtocolName == protocolName &&
+			equality.Semantic.DeepEqual(old, visitors) {
+			continue
+		}
+		name := visitors.ProtocolName
+		if name == "" {
+			name = protocolName
+		}
+		if validate := getVisitorValidator(name); validate != nil {
+			if err := validate(visitors, modelProperty); err != nil {
+				return fmt.Errorf("invalid visitors of property %s: %v", property.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateDesiredValue checks whether the desired value can be converted to the type of the
+// model property, is within its range and is allowed by its access mode.
+func validateDesiredValue(value string, modelProperty *devicesv1beta1.ModelProperty) error {
+	if value == "" {
+		return nil
+	}
+	if modelProperty.AccessMode == devicesv1beta1.ReadOnly {
+		return fmt.Errorf("desired value cannot be set on %s property", devicesv1beta1.ReadOnly)
+	}
+
+	var number float64
+	var err error
+	switch modelProperty.Type {
+	case devicesv1beta1.INT:
+		var i int64
+		i, err = strconv.ParseInt(value, 10, 64)
+		number = float64(i)
+	case devicesv1beta1.FLOAT:
+		number, err = strconv.ParseFloat(value, 32)
+	case devicesv1beta1.DOUBLE:
+		number, err = strconv.ParseFloat(value, 64)
+	case devicesv1beta1.BOOLEAN:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%q is not a valid %s value", value, modelProperty.Type)
+		}
+		return nil
+	case devicesv1beta1.ENUM:
+		for _, allowed := range modelProperty.EnumValues {
+			if value == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not one of the allowed values %v", value, modelProperty.EnumValues)
+	case devicesv1beta1.OBJECT:
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(value), &object); err != nil || object == nil {
+			return fmt.Errorf("%q is not a valid %s value", value, modelProperty.Type)
+		}
+		return nil
+	case devicesv1beta1.ARRAY:
+		var array []interface{}
+		if err := json.Unmarshal([]byte(value), &array); err != nil || array == nil {
+			return fmt.Errorf("%q is not a valid %s value", value, modelProperty.Type)
+		}
+		return nil
+	default:
+		return nil
+	}
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return fmt.Errorf("%q is not a valid %s value", value, modelProperty.Type)
+	}
+
+	if modelProperty.Minimum != "" {
+		minimum, err := strconv.ParseFloat(modelProperty.Minimum, 64)
+		if err != nil {
+			return fmt.Errorf("invalid minimum %q in device model", modelProperty.Minimum)
+		}
+		if number < minimum {
+			return fmt.Errorf("%s is less than the minimum %s", value, modelProperty.Minimum)
+		}
+	}
+	if modelProperty.Maximum != "" {
+		maximum, err := strconv.ParseFloat(modelProperty.Maximum, 64)
+		if err != nil {
+			return fmt.Errorf("invalid maximum %q in device model", modelProperty.Maximum)
+		}
+		if number > maximum {
+			return fmt.Errorf("%s is greater than the maximum %s", value, modelProperty.Maximum)
+		}
+	}
+	return nil
+}
+
 func serveDevice(w http.ResponseWriter, r *http.Request) {
 	serve(w, r, admitDevice)
 }
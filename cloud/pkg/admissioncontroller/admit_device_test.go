@@ -17,13 +17,18 @@ package admissioncontroller
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
 
 	devicesv1beta1 "github.com/kubeedge/api/apis/devices/v1beta1"
+	"github.com/kubeedge/api/client/clientset/versioned/fake"
 )
 
 func TestAdmitDevice(t *testing.T) {
@@ -184,10 +189,260 @@ func TestValidateDevice(t *testing.T) {
 				Allowed: true,
 			}
 
-			msg := validateDevice(tc.device, response)
+			msg := validateDevice(tc.device, nil, response)
 
 			assert.Equal(tc.expectedAllowed, response.Allowed)
 			assert.Equal(tc.expectedMessage, msg)
 		})
 	}
 }
+
+func TestValidateDeviceWithModel(t *testing.T) {
+	assert := assert.New(t)
+
+	model := &devicesv1beta1.DeviceModel{
+		ObjectMeta: metav1.ObjectMeta{Name: "thermometer", Namespace: "default"},
+		Spec: devicesv1beta1.DeviceModelSpec{
+			Properties: []devicesv1beta1.ModelProperty{
+				{Name: "temperature", Type: devicesv1beta1.INT, AccessMode: devicesv1beta1.ReadWrite, Minimum: "-40", Maximum: "120"},
+				{Name: "humidity", Type: devicesv1beta1.FLOAT, AccessMode: devicesv1beta1.ReadOnly},
+				{Name: "enabled", Type: devicesv1beta1.BOOLEAN, AccessMode: devicesv1beta1.ReadWrite},
+				{Name: "mode", Type: devicesv1beta1.ENUM, AccessMode: devicesv1beta1.ReadWrite, EnumValues: []string{"auto", "manual"}},
+				{Name: "target", Type: devicesv1beta1.OBJECT, AccessMode: devicesv1beta1.ReadWrite},
+			},
+		},
+	}
+	origin := controller.CrdClient
+	controller.CrdClient = fake.NewSimpleClientset(model)
+	defer func() { controller.CrdClient = origin }()
+
+	modbusVisitors := func(register string, offset, limit interface{}) devicesv1beta1.VisitorConfig {
+		return devicesv1beta1.VisitorConfig{
+			ProtocolName: "modbus",
+			ConfigData: &devicesv1beta1.CustomizedValue{Data: map[string]interface{}{
+				"register": register, "offset": offset, "limit": limit,
+			}},
+		}
+	}
+	newDevice := func(modelName string, properties ...devicesv1beta1.DeviceProperty) *devicesv1beta1.Device {
+		return &devicesv1beta1.Device{
+			ObjectMeta: metav1.ObjectMeta{Name: "device", Namespace: "default"},
+			Spec: devicesv1beta1.DeviceSpec{
+				DeviceModelRef: &corev1.LocalObjectReference{Name: modelName},
+				Protocol:       devicesv1beta1.ProtocolConfig{ProtocolName: "modbus"},
+				Properties:     properties,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		device          *devicesv1beta1.Device
+		expectedAllowed bool
+		expectedMessage string
+	}{
+		{
+			name: "Valid device",
+			device: newDevice("thermometer",
+				devicesv1beta1.DeviceProperty{Name: "temperature", Desired: devicesv1beta1.TwinProperty{Value: "25"},
+					Visitors: modbusVisitors("HoldingRegister", float64(0), float64(1))},
+				devicesv1beta1.DeviceProperty{Name: "humidity", Visitors: modbusVisitors("InputRegister", float64(1), float64(2))},
+				devicesv1beta1.DeviceProperty{Name: "enabled", Desired: devicesv1beta1.TwinProperty{Value: "true"},
+					Visitors: modbusVisitors("CoilRegister", "3", "1")},
+			),
+			expectedAllowed: true,
+		},
+		{
+			name: "Valid structured desired values",
+			device: newDevice("thermometer",
+				devicesv1beta1.DeviceProperty{Name: "mode", Desired: devicesv1beta1.TwinProperty{Value: "auto"},
+					Visitors: modbusVisitors("HoldingRegister", float64(4), float64(1))},
+				devicesv1beta1.DeviceProperty{Name: "target", Desired: devicesv1beta1.TwinProperty{Value: `{"x":1,"y":2}`},
+					Visitors: modbusVisitors("HoldingRegister", float64(5), float64(4))},
+			),
+			expectedAllowed: true,
+		},
+		{
+			name: "Desired value not in enum values",
+			device: newDevice("thermometer", devicesv1beta1.DeviceProperty{Name: "mode",
+				Desired: devicesv1beta1.TwinProperty{Value: "off"}}),
+			expectedAllowed: false,
+			expectedMessage: `invalid desired value of property mode: "off" is not one of the allowed values [auto manual]`,
+		},
+		{
+			name: "Desired value not an object",
+			device: newDevice("thermometer", devicesv1beta1.DeviceProperty{Name: "target",
+				Desired: devicesv1beta1.TwinProperty{Value: "[1,2]"}}),
+			expectedAllowed: false,
+			expectedMessage: `invalid desired value of property target: "[1,2]" is not a valid OBJECT value`,
+		},
+		{
+			name:            "Device model not found",
+			device:          newDevice("unknown", devicesv1beta1.DeviceProperty{Name: "pressure"}),
+			expectedAllowed: true,
+		},
+		{
+			name:            "Property not in device model",
+			device:          newDevice("thermometer", devicesv1beta1.DeviceProperty{Name: "pressure"}),
+			expectedAllowed: false,
+			expectedMessage: "property pressure is not defined in device model thermometer",
+		},
+		{
+			name: "Desired value of wrong type",
+			device: newDevice("thermometer", devicesv1beta1.DeviceProperty{Name: "temperature",
+				Desired: devicesv1beta1.TwinProperty{Value: "abc"}}),
+			expectedAllowed: false,
+			expectedMessage: `invalid desired value of property temperature: "abc" is not a valid INT value`,
+		},
+		{
+			name: "Desired value out of range",
+			device: newDevice("thermometer", devicesv1beta1.DeviceProperty{Name: "temperature",
+				Desired: devicesv1beta1.TwinProperty{Value: "200"}}),
+			expectedAllowed: false,
+			expectedMessage: "invalid desired value of property temperature: 200 is greater than the maximum 120",
+		},
+		{
+			name: "Desired value of read only property",
+			device: newDevice("thermometer", devicesv1beta1.DeviceProperty{Name: "humidity",
+				Desired: devicesv1beta1.TwinProperty{Value: "0.5"}}),
+			expectedAllowed: false,
+			expectedMessage: "invalid desired value of property humidity: desired value cannot be set on ReadOnly property",
+		},
+		{
+			name: "Writable property on read only register",
+			device: newDevice("thermometer", devicesv1beta1.DeviceProperty{Name: "temperature",
+				Visitors: modbusVisitors("InputRegister", float64(0), float64(1))}),
+			expectedAllowed: false,
+			expectedMessage: "invalid visitors of property temperature: modbus register InputRegister is read only, but the property is ReadWrite",
+		},
+		{
+			name: "Invalid modbus limit",
+			device: newDevice("thermometer", devicesv1beta1.DeviceProperty{Name: "humidity",
+				Visitors: modbusVisitors("InputRegister", float64(0), float64(-1))}),
+			expectedAllowed: false,
+			expectedMessage: "invalid visitors of property humidity: limit must be a non-negative integer",
+		},
+		{
+			name: "Visitors of different protocol",
+			device: newDevice("thermometer", devicesv1beta1.DeviceProperty{Name: "humidity",
+				Visitors: devicesv1beta1.VisitorConfig{ProtocolName: "opcua"}}),
+			expectedAllowed: false,
+			expectedMessage: "protocol opcua of the visitors of property humidity is different from device protocol modbus",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response := &admissionv1.AdmissionResponse{
+				Allowed: true,
+			}
+
+			msg := validateDevice(tc.device, nil, response)
+
+			assert.Equal(tc.expectedAllowed, response.Allowed)
+			assert.Equal(tc.expectedMessage, msg)
+		})
+	}
+}
+
+func TestValidateDeviceModelUnavailable(t *testing.T) {
+	assert := assert.New(t)
+
+	client := fake.NewSimpleClientset()
+	origin := controller.CrdClient
+	controller.CrdClient = client
+	defer func() { controller.CrdClient = origin }()
+
+	device := &devicesv1beta1.Device{
+		ObjectMeta: metav1.ObjectMeta{Name: "device", Namespace: "default"},
+		Spec: devicesv1beta1.DeviceSpec{
+			DeviceModelRef: &corev1.LocalObjectReference{Name: "thermometer"},
+			Properties:     []devicesv1beta1.DeviceProperty{{Name: "temperature"}},
+		},
+	}
+
+	response := &admissionv1.AdmissionResponse{Allowed: true}
+	assert.Equal("", validateDevice(device, nil, response))
+	assert.True(response.Allowed)
+	assert.Equal([]string{"device model thermometer is not found, the device is not validated against it"}, response.Warnings)
+
+	// transient errors do not deny the request
+	client.PrependReactor("get", "devicemodels", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	response = &admissionv1.AdmissionResponse{Allowed: true}
+	assert.Equal("", validateDevice(device, nil, response))
+	assert.True(response.Allowed)
+	assert.Equal([]string{"failed to get device model thermometer, the device is not validated against it: connection refused"},
+		response.Warnings)
+}
+
+func TestValidateDeviceWithModelOnUpdate(t *testing.T) {
+	assert := assert.New(t)
+
+	model := &devicesv1beta1.DeviceModel{
+		ObjectMeta: metav1.ObjectMeta{Name: "thermometer"},
+		Spec: devicesv1beta1.DeviceModelSpec{
+			Properties: []devicesv1beta1.ModelProperty{
+				{Name: "temperature", Type: devicesv1beta1.INT, AccessMode: devicesv1beta1.ReadWrite},
+			},
+		},
+	}
+	// the visitors are invalid since the register is read only
+	visitors := devicesv1beta1.VisitorConfig{
+		ProtocolName: "modbus",
+		ConfigData: &devicesv1beta1.CustomizedValue{Data: map[string]interface{}{
+			"register": "InputRegister", "offset": float64(0), "limit": float64(1),
+		}},
+	}
+	newDevice := func(desired string, offset float64) *devicesv1beta1.Device {
+		propertyVisitors := *visitors.DeepCopy()
+		propertyVisitors.ConfigData.Data["offset"] = offset
+		return &devicesv1beta1.Device{
+			Spec: devicesv1beta1.DeviceSpec{
+				Protocol: devicesv1beta1.ProtocolConfig{ProtocolName: "modbus"},
+				Properties: []devicesv1beta1.DeviceProperty{{
+					Name: "temperature", Desired: devicesv1beta1.TwinProperty{Value: desired}, Visitors: propertyVisitors,
+				}},
+			},
+		}
+	}
+
+	oldDevice := newDevice("20", 0)
+	assert.Error(validateDeviceWithModel(oldDevice, nil, model))
+	// the unchanged visitors are not validated again
+	assert.NoError(validateDeviceWithModel(newDevice("25", 0), oldDevice, model))
+	// the changed visitors are validated
+	assert.Error(validateDeviceWithModel(newDevice("25", 1), oldDevice, model))
+	// the desired value is always validated
+	assert.Error(validateDeviceWithModel(newDevice("abc", 0), oldDevice, model))
+}
+
+func TestRegisterVisitorValidator(t *testing.T) {
+	assert := assert.New(t)
+
+	called := false
+	RegisterVisitorValidator("test-protocol", func(*devicesv1beta1.VisitorConfig, *devicesv1beta1.ModelProperty) error {
+		called = true
+		return nil
+	})
+	defer func() {
+		visitorValidatorsLock.Lock()
+		delete(visitorValidators, "test-protocol")
+		visitorValidatorsLock.Unlock()
+	}()
+
+	device := &devicesv1beta1.Device{
+		Spec: devicesv1beta1.DeviceSpec{
+			Protocol:   devicesv1beta1.ProtocolConfig{ProtocolName: "test-protocol"},
+			Properties: []devicesv1beta1.DeviceProperty{{Name: "prop1"}},
+		},
+	}
+	model := &devicesv1beta1.DeviceModel{
+		Spec: devicesv1beta1.DeviceModelSpec{
+			Properties: []devicesv1beta1.ModelProperty{{Name: "prop1", Type: devicesv1beta1.STRING}},
+		},
+	}
+	assert.NoError(validateDeviceWithModel(device, nil, model))
+	assert.True(called)
+}
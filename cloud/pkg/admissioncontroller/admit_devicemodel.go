@@ -1,6 +1,8 @@
 package admissioncontroller
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -50,11 +52,51 @@ func validateDeviceModel(devicemodel *devicesv1beta1.DeviceModel, response *admi
 		} else {
 			msg = "property names must be unique."
 			response.Allowed = false
+			return msg
+		}
+		if err := validateModelPropertyType(&property); err != nil {
+			msg = fmt.Sprintf("invalid property %s: %v", property.Name, err)
+			response.Allowed = false
+			return msg
 		}
 	}
 	return msg
 }
 
+// validateModelPropertyType checks the allowed values of ENUM properties and
+// the schema of OBJECT and ARRAY properties.
+func validateModelPropertyType(property *devicesv1beta1.ModelProperty) error {
+	switch property.Type {
+	case devicesv1beta1.ENUM:
+		if len(property.EnumValues) == 0 {
+			return fmt.Errorf("enumValues is required for %s property", devicesv1beta1.ENUM)
+		}
+		values := make(map[string]bool, len(property.EnumValues))
+		for _, v := range property.EnumValues {
+			if values[v] {
+				return fmt.Errorf("enumValues must be unique, %q is duplicated", v)
+			}
+			values[v] = true
+		}
+	case devicesv1beta1.OBJECT, devicesv1beta1.ARRAY:
+		if property.Schema == nil {
+			return nil
+		}
+		var schema map[string]interface{}
+		if err := json.Unmarshal(property.Schema.Raw, &schema); err != nil || schema == nil {
+			return fmt.Errorf("schema must be a JSON object")
+		}
+		if schemaType, ok := schema["type"]; ok && schemaType != strings.ToLower(string(property.Type)) {
+			return fmt.Errorf("schema type %v does not match property type %s", schemaType, property.Type)
+		}
+	default:
+		if len(property.EnumValues) != 0 {
+			return fmt.Errorf("enumValues is only allowed for %s property", devicesv1beta1.ENUM)
+		}
+	}
+	return nil
+}
+
 func serveDeviceModel(w http.ResponseWriter, r *http.Request) {
 	serve(w, r, admitDeviceModel)
 }
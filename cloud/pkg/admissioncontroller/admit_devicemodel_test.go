@@ -157,6 +157,58 @@ func TestValidateDeviceModel(t *testing.T) {
 			expectedAllowed: true,
 			expectedMessage: "",
 		},
+		{
+			name: "Device model with structured properties",
+			deviceModel: &devicesv1beta1.DeviceModel{
+				Spec: devicesv1beta1.DeviceModelSpec{
+					Properties: []devicesv1beta1.ModelProperty{
+						{Name: "mode", Type: devicesv1beta1.ENUM, EnumValues: []string{"auto", "manual"}},
+						{Name: "acceleration", Type: devicesv1beta1.OBJECT,
+							Schema: &runtime.RawExtension{Raw: []byte(`{"type":"object","properties":{"x":{"type":"number"}}}`)}},
+						{Name: "waveform", Type: devicesv1beta1.ARRAY},
+					},
+				},
+			},
+			expectedAllowed: true,
+			expectedMessage: "",
+		},
+		{
+			name: "Enum property without values",
+			deviceModel: &devicesv1beta1.DeviceModel{
+				Spec: devicesv1beta1.DeviceModelSpec{
+					Properties: []devicesv1beta1.ModelProperty{
+						{Name: "mode", Type: devicesv1beta1.ENUM},
+					},
+				},
+			},
+			expectedAllowed: false,
+			expectedMessage: "invalid property mode: enumValues is required for ENUM property",
+		},
+		{
+			name: "Enum values on non-enum property",
+			deviceModel: &devicesv1beta1.DeviceModel{
+				Spec: devicesv1beta1.DeviceModelSpec{
+					Properties: []devicesv1beta1.ModelProperty{
+						{Name: "temperature", Type: devicesv1beta1.INT, EnumValues: []string{"1"}},
+					},
+				},
+			},
+			expectedAllowed: false,
+			expectedMessage: "invalid property temperature: enumValues is only allowed for ENUM property",
+		},
+		{
+			name: "Schema of mismatched type",
+			deviceModel: &devicesv1beta1.DeviceModel{
+				Spec: devicesv1beta1.DeviceModelSpec{
+					Properties: []devicesv1beta1.ModelProperty{
+						{Name: "waveform", Type: devicesv1beta1.ARRAY,
+							Schema: &runtime.RawExtension{Raw: []byte(`{"type":"object"}`)}},
+					},
+				},
+			},
+			expectedAllowed: false,
+			expectedMessage: "invalid property waveform: schema type object does not match property type ARRAY",
+		},
 	}
 
 	for _, tc := range testCases {
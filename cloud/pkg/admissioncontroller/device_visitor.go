@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admissioncontroller
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	devicesv1beta1 "github.com/kubeedge/api/apis/devices/v1beta1"
+)
+
+// VisitorValidateFunc validates the protocol specific visitors of a device property
+// against the property defined in the device model.
+type VisitorValidateFunc func(visitors *devicesv1beta1.VisitorConfig, property *devicesv1beta1.ModelProperty) error
+
+var (
+	visitorValidatorsLock sync.RWMutex
+	visitorValidators     = map[string]VisitorValidateFunc{}
+)
+
+func init() {
+	RegisterVisitorValidator("modbus", validateModbusVisitors)
+}
+
+// RegisterVisitorValidator registers the visitor validator of the protocol,
+// the validator registered before for the same protocol will be replaced.
+func RegisterVisitorValidator(protocolName string, validate VisitorValidateFunc) {
+	visitorValidatorsLock.Lock()
+	defer visitorValidatorsLock.Unlock()
+	visitorValidators[protocolName] = validate
+}
+
+func getVisitorValidator(protocolName string) VisitorValidateFunc {
+	visitorValidatorsLock.RLock()
+	defer visitorValidatorsLock.RUnlock()
+	return visitorValidators[protocolName]
+}
+
+// modbus register types, only coils and holding registers are writable.
+var modbusRegisters = map[string]bool{
+	"CoilRegister":          true,
+	"DiscreteInputRegister": false,
+	"InputRegister":         false,
+	"HoldingRegister":       true,
+}
+
+func validateModbusVisitors(visitors *devicesv1beta1.VisitorConfig, property *devicesv1beta1.ModelProperty) error {
+	if visitors.ConfigData == nil || visitors.ConfigData.Data == nil {
+		return fmt.Errorf("configData is required")
+	}
+	data := visitors.ConfigData.Data
+
+	register, _ := data["register"].(string)
+	writable, ok := modbusRegisters[register]
+	if !ok {
+		return fmt.Errorf("unsupported modbus register %q", register)
+	}
+	if !writable && property.AccessMode == devicesv1beta1.ReadWrite {
+		return fmt.Errorf("modbus register %s is read only, but the property is %s", register, devicesv1beta1.ReadWrite)
+	}
+
+	offset, err := modbusUint(data, "offset")
+	if err != nil {
+		return err
+	}
+	limit, err := modbusUint(data, "limit")
+	if err != nil {
+		return err
+	}
+	if limit == 0 {
+		return fmt.Errorf("limit must be greater than 0")
+	}
+	// modbus addresses registers with 16 bits
+	if offset+limit > 1<<16 {
+		return fmt.Errorf("offset %d and limit %d exceed the modbus address range", offset, limit)
+	}
+	return nil
+}
+
+func modbusUint(data map[string]interface{}, key string) (uint64, error) {
+	var value uint64
+	var err error
+	switch v := data[key].(type) {
+	case float64:
+		if v < 0 || v != float64(uint64(v)) {
+			return 0, fmt.Errorf("%s must be a non-negative integer", key)
+		}
+		value = uint64(v)
+	case int64:
+		if v < 0 {
+			return 0, fmt.Errorf("%s must be a non-negative integer", key)
+		}
+		value = uint64(v)
+	case string:
+		value, err = strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s must be a non-negative integer", key)
+		}
+	case nil:
+		return 0, fmt.Errorf("%s is required", key)
+	default:
+		return 0, fmt.Errorf("%s must be a non-negative integer", key)
+	}
+	return value, nil
+}
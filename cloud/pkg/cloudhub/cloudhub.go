@@ -3,8 +3,12 @@ package cloudhub
 import (
 	"errors"
 	"fmt"
+	"net"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/kubeapiserver/authorizer/modes"
@@ -16,13 +20,16 @@ import (
 	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/dispatcher"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/handler"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/routing"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/httpserver"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/httpserver/forward"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/udsserver"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/session"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/informers"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/pkg/util"
 )
 
 var DoneTLSTunnelCerts = make(chan bool, 1)
@@ -34,6 +41,8 @@ type cloudHub struct {
 
 	messageHandler handler.Handler
 	dispatcher     dispatcher.MessageDispatcher
+	// router routes the downstream messages between the replicas, it's nil if message routing is disabled
+	router *routing.Router
 }
 
 var _ core.Module = (*cloudHub)(nil)
@@ -46,9 +55,21 @@ func newCloudHub(enable bool) *cloudHub {
 
 	sessionManager := session.NewSessionManager(hubconfig.Config.NodeLimit)
 
+	// the interfaces stay nil if message routing is disabled
+	var router *routing.Router
+	var messageRouter dispatcher.Router
+	var nodeOwner handler.NodeOwner
+	if r := hubconfig.Config.MessageRouting; r != nil && r.Enable {
+		var err error
+		if router, err = newRouter(r); err != nil {
+			klog.Exitf("Failed to init message routing of cloudhub: %v", err)
+		}
+		messageRouter, nodeOwner = router, router
+	}
+
 	messageDispatcher := dispatcher.NewMessageDispatcher(
 		sessionManager, objectSyncInformer.Lister(),
-		clusterObjectSyncInformer.Lister(), client.GetCRDClient())
+		clusterObjectSyncInformer.Lister(), client.GetCRDClient(), messageRouter)
 
 	config := getAuthConfig()
 	authorizer, err := config.New()
@@ -59,13 +80,15 @@ func newCloudHub(enable bool) *cloudHub {
 	messageHandler := handler.NewMessageHandler(
 		int(hubconfig.Config.KeepaliveInterval),
 		sessionManager, client.GetCRDClient(),
-		messageDispatcher, authorizer)
+		messageDispatcher, authorizer, nodeOwner)
 	sessionMgr = sessionManager
+	prometheus.MustRegister(session.NewQueueCollector(sessionManager))
 
 	ch := &cloudHub{
 		enable:         enable,
 		dispatcher:     messageDispatcher,
 		messageHandler: messageHandler,
+		router:         router,
 	}
 
 	ch.informersSyncedFuncs = append(ch.informersSyncedFuncs, clusterObjectSyncInformer.Informer().HasSynced)
@@ -116,9 +139,19 @@ func (ch *cloudHub) Start() {
 		klog.Exit(err)
 	}
 
+	var receiver forward.MessageReceiver
+	if ch.router != nil {
+		httpClient, err := routing.NewHTTPClient(hubconfig.Config.Ca)
+		if err != nil {
+			klog.Exit(err)
+		}
+		go ch.router.Run(ctx, httpClient, hubconfig.Config.CaKey)
+		receiver = ch.dispatcher
+	}
+
 	// HttpServer mainly used to issue certificates for the edge
 	go func() {
-		if err := httpserver.StartHTTPServer(); err != nil {
+		if err := httpserver.StartHTTPServer(sessionMgr, receiver); err != nil {
 			klog.Exit(err)
 		}
 	}()
@@ -132,6 +165,23 @@ func (ch *cloudHub) Start() {
 	}
 }
 
+func newRouter(c *v1alpha1.CloudHubMessageRouting) (*routing.Router, error) {
+	hostname := util.GetHostname()
+	address := c.AdvertiseAddress
+	if address == "" {
+		var err error
+		if address, err = util.GetLocalIP(hostname); err != nil {
+			return nil, fmt.Errorf("failed to get the advertise address: %v", err)
+		}
+	}
+	leaseDuration := time.Duration(c.LeaseDurationSeconds) * time.Second
+	if leaseDuration == 0 {
+		leaseDuration = routing.DefaultLeaseDurationSeconds * time.Second
+	}
+	address = net.JoinHostPort(address, strconv.Itoa(int(hubconfig.Config.HTTPS.Port)))
+	return routing.NewRouter(hostname, address, leaseDuration, client.GetKubeClient()), nil
+}
+
 func getAuthConfig() authorization.Config {
 	enabled := hubconfig.Config.Authorization != nil && hubconfig.Config.Authorization.Enable
 	debug := enabled && hubconfig.Config.Authorization.Debug
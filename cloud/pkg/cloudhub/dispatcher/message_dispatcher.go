@@ -37,6 +37,7 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/messagelayer"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
 	"github.com/kubeedge/kubeedge/cloud/pkg/synccontroller"
 	taskutil "github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util"
 	commonconst "github.com/kubeedge/kubeedge/common/constants"
@@ -44,6 +45,7 @@ import (
 	"github.com/kubeedge/kubeedge/pkg/metaserver"
 	"github.com/kubeedge/kubeedge/pkg/metaserver/util"
 	taskmsg "github.com/kubeedge/kubeedge/pkg/nodetask/message"
+	"github.com/kubeedge/kubeedge/pkg/tracing"
 )
 
 // There are two `AcknowledgeMode` for message that send to edge node
@@ -89,6 +91,18 @@ type MessageDispatcher interface {
 
 	// Publish sends the given message to module according to the message source
 	Publish(msg *beehivemodel.Message) error
+
+	// DispatchForwarded dispatches the downstream message forwarded by another CloudCore
+	// replica to the message queue of the edge node connected to this replica.
+	DispatchForwarded(message *beehivemodel.Message) error
+}
+
+// Router forwards the downstream messages to the CloudCore replica the edge node connects to
+type Router interface {
+	// Forward queues the message to be forwarded to the replica the node connects to without
+	// blocking, it returns false if the node doesn't connect to another replica or the message
+	// isn't queued. fallback is called with the queued message if it fails to be forwarded.
+	Forward(nodeID string, msg *beehivemodel.Message, fallback func(*beehivemodel.Message)) bool
 }
 
 type messageDispatcher struct {
@@ -107,19 +121,26 @@ type messageDispatcher struct {
 
 	// clusterObjectSyncLister can list/get clusterObjectSync from the shared informer's store
 	clusterObjectSyncLister synclisters.ClusterObjectSyncLister
+
+	// router forwards the messages of the nodes connected to the other replicas, it's nil
+	// if the messages are not routed between the replicas
+	router Router
 }
 
-// NewMessageDispatcher initializes a new MessageDispatcher
+// NewMessageDispatcher initializes a new MessageDispatcher, router is nil
+// if the messages are not routed between the replicas
 func NewMessageDispatcher(
 	sessionManager *session.Manager,
 	objectSyncLister synclisters.ObjectSyncLister,
 	clusterObjectSyncLister synclisters.ClusterObjectSyncLister,
-	reliableClient reliableclient.Interface) MessageDispatcher {
+	reliableClient reliableclient.Interface,
+	router Router) MessageDispatcher {
 	return &messageDispatcher{
 		objectSyncLister:        objectSyncLister,
 		clusterObjectSyncLister: clusterObjectSyncLister,
 		reliableClient:          reliableClient,
 		SessionManager:          sessionManager,
+		router:                  router,
 	}
 }
 
@@ -150,16 +171,57 @@ func (md *messageDispatcher) DispatchDownstream() {
 				continue
 			}
 
-			switch {
-			case noAckRequired(&msg):
-				md.enqueueNoAckMessage(nodeID, &msg)
-			default:
-				md.enqueueAckMessage(nodeID, &msg)
+			span := tracing.StartSpan(&msg, modules.CloudHubModuleName)
+			if !md.forward(nodeID, &msg) {
+				md.enqueue(nodeID, &msg)
 			}
+			span.End()
 		}
 	}
 }
 
+func (md *messageDispatcher) DispatchForwarded(message *beehivemodel.Message) error {
+	nodeID, err := GetNodeID(message)
+	if err != nil {
+		return err
+	}
+	if !model.IsToEdge(message) {
+		return fmt.Errorf("message %s is not to edge node %s", message.GetID(), nodeID)
+	}
+	// the message isn't forwarded again, the sender dispatches it if the node is not here
+	if _, exist := md.SessionManager.GetSession(nodeID); !exist {
+		return fmt.Errorf("node %s is not connected to this replica", nodeID)
+	}
+
+	klog.V(4).Infof("[DispatchForwarded] dispatch forwarded Message to edge: %+v", message)
+	md.enqueue(nodeID, message)
+	return nil
+}
+
+// forward forwards the message to the replica the node connects to, if the node
+// is not connected to this replica. It returns whether the message is forwarded.
+func (md *messageDispatcher) forward(nodeID string, msg *beehivemodel.Message) bool {
+	if md.router == nil {
+		return false
+	}
+	if _, exist := md.SessionManager.GetSession(nodeID); exist {
+		return false
+	}
+
+	return md.router.Forward(nodeID, msg, func(msg *beehivemodel.Message) {
+		md.enqueue(nodeID, msg)
+	})
+}
+
+func (md *messageDispatcher) enqueue(nodeID string, msg *beehivemodel.Message) {
+	switch {
+	case noAckRequired(msg):
+		md.enqueueNoAckMessage(nodeID, msg)
+	default:
+		md.enqueueAckMessage(nodeID, msg)
+	}
+}
+
 func (md *messageDispatcher) DispatchUpstream(message *beehivemodel.Message, info *model.HubInfo) {
 	switch {
 	case message.GetOperation() == model.OpKeepalive:
@@ -266,6 +328,7 @@ func (md *messageDispatcher) enqueueAckMessage(nodeID string, msg *beehivemodel.
 			synccontroller.CompareResourceVersion(msg.GetResourceVersion(), msgInStore.GetResourceVersion()) <= 0 {
 			// If the message resource version is older than the message in store or the operation
 			// for the message in store is delete, The message will be discarded directly.
+			monitor.MessageDrops.WithLabelValues(nodeID, monitor.DropReasonOutdated).Inc()
 			return
 		}
 		shouldEnqueue = true
@@ -298,6 +361,7 @@ func (md *messageDispatcher) enqueueNonNamespacedResource(nodeID string, msg *be
 		if synccontroller.CompareResourceVersion(msg.GetResourceVersion(), clusterObjectSync.Status.ObjectResourceVersion) > 0 {
 			return true
 		}
+		monitor.MessageDrops.WithLabelValues(nodeID, monitor.DropReasonOutdated).Inc()
 
 	case err != nil && apierrors.IsNotFound(err):
 		// If clusterObjectSync is not exist, this indicates that the message is coming
@@ -363,6 +427,7 @@ func (md *messageDispatcher) enqueueNamespacedResource(nodeID string, msg *beehi
 		if synccontroller.CompareResourceVersion(msg.GetResourceVersion(), objectSync.Status.ObjectResourceVersion) > 0 {
 			return true
 		}
+		monitor.MessageDrops.WithLabelValues(nodeID, monitor.DropReasonOutdated).Inc()
 
 	case err != nil && apierrors.IsNotFound(err):
 		// If objectSync is not exist, this indicates that the message is coming
@@ -498,7 +563,10 @@ func isVolumeOperation(op string) bool {
 	return op == commonconst.CSIOperationTypeCreateVolume ||
 		op == commonconst.CSIOperationTypeDeleteVolume ||
 		op == commonconst.CSIOperationTypeControllerPublishVolume ||
-		op == commonconst.CSIOperationTypeControllerUnpublishVolume
+		op == commonconst.CSIOperationTypeControllerUnpublishVolume ||
+		op == commonconst.CSIOperationTypeControllerExpandVolume ||
+		op == commonconst.CSIOperationTypeGetCapacity ||
+		op == commonconst.CSIOperationTypeListVolumes
 }
 
 // GetNodeMessagePool returns the message pool for given node
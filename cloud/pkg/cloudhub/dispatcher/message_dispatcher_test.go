@@ -369,7 +369,7 @@ func TestGetAddNodeMessagePool(t *testing.T) {
 	objectSyncInformer := syncinformer.NewSharedInformerFactory(client, 0).Reliablesyncs().V1alpha1().ObjectSyncs()
 	clusterObjectSyncInformer := syncinformer.NewSharedInformerFactory(client, 0).Reliablesyncs().V1alpha1().ClusterObjectSyncs()
 
-	dispatcher := NewMessageDispatcher(manager, objectSyncInformer.Lister(), clusterObjectSyncInformer.Lister(), client)
+	dispatcher := NewMessageDispatcher(manager, objectSyncInformer.Lister(), clusterObjectSyncInformer.Lister(), client, nil)
 
 	nmp := common.InitNodeMessagePool(tf.TestNodeID)
 	dispatcher.AddNodeMessagePool(tf.TestNodeID, nmp)
@@ -405,3 +405,106 @@ func TestDeleteNodeMessagePool(t *testing.T) {
 		t.Errorf("expected pool not exist but got it")
 	}
 }
+
+type fakeRouter struct {
+	forwarded bool
+	// fail calls the fallback as the forwarding goroutine when the replica is unreachable
+	fail     bool
+	messages []*beehivemodel.Message
+}
+
+func (r *fakeRouter) Forward(_ string, msg *beehivemodel.Message, fallback func(*beehivemodel.Message)) bool {
+	r.messages = append(r.messages, msg)
+	if r.fail {
+		fallback(msg)
+	}
+	return r.forwarded
+}
+
+func TestForward(t *testing.T) {
+	msg := beehivemodel.NewMessage("").SetResourceOperation("node/"+tf.TestNodeID+"/default/podlist", "response")
+
+	cases := []struct {
+		name             string
+		router           *fakeRouter
+		connected        bool
+		expectForwarded  bool
+		expectForwarding bool
+		expectEnqueued   bool
+	}{
+		{
+			name:             "forwarded to another replica",
+			router:           &fakeRouter{forwarded: true},
+			expectForwarded:  true,
+			expectForwarding: true,
+		},
+		{
+			name:             "node not connected to another replica",
+			router:           &fakeRouter{},
+			expectForwarding: true,
+		},
+		{
+			name:             "failed to forward",
+			router:           &fakeRouter{forwarded: true, fail: true},
+			expectForwarded:  true,
+			expectForwarding: true,
+			expectEnqueued:   true,
+		},
+		{
+			name:      "node connected to this replica",
+			router:    &fakeRouter{forwarded: true},
+			connected: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client := &fake.Clientset{}
+			manager := session.NewSessionManager(10)
+			if c.connected {
+				mockController := gomock.NewController(t)
+				defer mockController.Finish()
+				mockConn := mockcon.NewMockConnection(mockController)
+				nmp := common.InitNodeMessagePool(tf.TestNodeID)
+				manager.AddSession(session.NewNodeSession(tf.TestNodeID, tf.TestProjectID, mockConn, tf.KeepaliveInterval, nmp, client))
+			}
+			dispatcher := &messageDispatcher{SessionManager: manager, router: c.router}
+
+			if forwarded := dispatcher.forward(tf.TestNodeID, msg); forwarded != c.expectForwarded {
+				t.Errorf("expected forwarded %v, but got %v", c.expectForwarded, forwarded)
+			}
+			if forwarding := len(c.router.messages) > 0; forwarding != c.expectForwarding {
+				t.Errorf("expected forwarding %v, but got %v", c.expectForwarding, forwarding)
+			}
+			if enqueued := dispatcher.GetNodeMessagePool(tf.TestNodeID).NoAckMessageQueue.Len() > 0; enqueued != c.expectEnqueued {
+				t.Errorf("expected enqueued %v, but got %v", c.expectEnqueued, enqueued)
+			}
+		})
+	}
+}
+
+func TestDispatchForwarded(t *testing.T) {
+	client := &fake.Clientset{}
+	manager := session.NewSessionManager(10)
+	dispatcher := &messageDispatcher{SessionManager: manager}
+	msg := beehivemodel.NewMessage("").BuildRouter("edgecontroller", "resource",
+		"node/"+tf.TestNodeID+"/default/podlist", "response")
+
+	// the node is not connected to this replica
+	if err := dispatcher.DispatchForwarded(msg); err == nil {
+		t.Errorf("expected error when the node is not connected")
+	}
+
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockConn := mockcon.NewMockConnection(mockController)
+	nmp := common.InitNodeMessagePool(tf.TestNodeID)
+	manager.AddSession(session.NewNodeSession(tf.TestNodeID, tf.TestProjectID, mockConn, tf.KeepaliveInterval, nmp, client))
+	dispatcher.AddNodeMessagePool(tf.TestNodeID, nmp)
+
+	if err := dispatcher.DispatchForwarded(msg); err != nil {
+		t.Errorf("failed to dispatch forwarded message: %v", err)
+	}
+	if length := nmp.NoAckMessageQueue.Len(); length != 1 {
+		t.Errorf("expected 1 message in the queue, but got %d", length)
+	}
+}
@@ -51,18 +51,31 @@ type Handler interface {
 	OnReadTransportErr(nodeID, projectID string)
 }
 
+// NodeOwner records the CloudCore replica the edge nodes connect to
+type NodeOwner interface {
+	// Own records that the node connects to this replica
+	Own(ctx context.Context, nodeID string) error
+
+	// Disown records that the node disconnects from this replica
+	Disown(ctx context.Context, nodeID string) error
+}
+
+// NewMessageHandler initializes a new Handler, nodeOwner is nil
+// if the messages are not routed between the replicas
 func NewMessageHandler(
 	KeepaliveInterval int,
 	manager *session.Manager,
 	reliableClient reliableclient.Interface,
 	dispatcher dispatcher.MessageDispatcher,
-	authorizer authorization.Authorizer) Handler {
+	authorizer authorization.Authorizer,
+	nodeOwner NodeOwner) Handler {
 	messageHandler := &messageHandler{
 		KeepaliveInterval: KeepaliveInterval,
 		SessionManager:    manager,
 		MessageDispatcher: dispatcher,
 		reliableClient:    reliableClient,
 		authorizer:        authorizer,
+		nodeOwner:         nodeOwner,
 	}
 
 	// init handler that process upstream message
@@ -85,6 +98,9 @@ type messageHandler struct {
 
 	// authorizer
 	authorizer authorization.Authorizer
+
+	// nodeOwner
+	nodeOwner NodeOwner
 }
 
 // initServerEntries register handler func
@@ -152,6 +168,7 @@ func (mh *messageHandler) HandleConnection(connection conn.Connection) {
 			keepaliveInterval, nodeMessagePool, mh.reliableClient)
 		// add node session to the session manager
 		mh.SessionManager.AddSession(nodeSession)
+		mh.own(nodeID)
 		go func() {
 			err := retry.Do(
 				func() error {
@@ -175,10 +192,36 @@ func (mh *messageHandler) HandleConnection(connection conn.Connection) {
 		// clean node message pool and session
 		mh.MessageDispatcher.DeleteNodeMessagePool(nodeInfo.NodeID, nodeMessagePool)
 		mh.SessionManager.DeleteSession(nodeSession)
+		mh.disown(nodeID)
 		mh.OnEdgeNodeDisconnect(nodeInfo, connection)
 	}()
 }
 
+// own records the node connects to this replica, so the other replicas
+// forward the messages of the node to this replica
+func (mh *messageHandler) own(nodeID string) {
+	if mh.nodeOwner == nil {
+		return
+	}
+	if err := mh.nodeOwner.Own(context.TODO(), nodeID); err != nil {
+		klog.Errorf("failed to record node %s connects to this replica: %v", nodeID, err)
+	}
+}
+
+// disown records the node disconnects from this replica, unless the node
+// has reconnected to this replica with a new session
+func (mh *messageHandler) disown(nodeID string) {
+	if mh.nodeOwner == nil {
+		return
+	}
+	if _, exist := mh.SessionManager.GetSession(nodeID); exist {
+		return
+	}
+	if err := mh.nodeOwner.Disown(context.TODO(), nodeID); err != nil {
+		klog.Errorf("failed to record node %s disconnects from this replica: %v", nodeID, err)
+	}
+}
+
 func (mh *messageHandler) OnEdgeNodeConnect(info *model.HubInfo, connection conn.Connection) error {
 	err := mh.MessageDispatcher.Publish(common.ConstructConnectMessage(info, true))
 	if err != nil {
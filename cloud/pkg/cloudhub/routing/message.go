@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+)
+
+const (
+	contentTypeString = "string"
+	contentTypeBytes  = "bytes"
+	contentTypeObject = "object"
+
+	// tokenSubject distinguishes the tokens of the replicas from the tokens of
+	// the edge nodes, which are signed by the same CA key
+	tokenSubject  = "cloudhub-replica"
+	tokenLifetime = time.Minute
+)
+
+// forwardedMessage is the message forwarded between the replicas. The type of the content is
+// kept, so the forwarded message is dispatched and sent to the edge as the original one.
+type forwardedMessage struct {
+	Header      beehivemodel.MessageHeader `json:"header"`
+	Router      beehivemodel.MessageRoute  `json:"route"`
+	ContentType string                     `json:"contentType,omitempty"`
+	Content     []byte                     `json:"content,omitempty"`
+}
+
+// Object is the content of the forwarded messages holding Kubernetes objects. It exposes the
+// metadata of the object to the dispatcher, and is marshaled as the original object.
+type Object struct {
+	metav1.ObjectMeta
+	raw json.RawMessage
+}
+
+// MarshalJSON returns the original object
+func (o *Object) MarshalJSON() ([]byte, error) {
+	return o.raw, nil
+}
+
+func encodeMessage(msg *beehivemodel.Message) ([]byte, error) {
+	fm := forwardedMessage{Header: msg.Header, Router: msg.Router}
+	switch content := msg.GetContent().(type) {
+	case nil:
+	case string:
+		fm.ContentType, fm.Content = contentTypeString, []byte(content)
+	case []byte:
+		fm.ContentType, fm.Content = contentTypeBytes, content
+	default:
+		data, err := json.Marshal(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal message content: %v", err)
+		}
+		fm.ContentType, fm.Content = contentTypeObject, data
+	}
+	return json.Marshal(fm)
+}
+
+// DecodeMessage decodes the message forwarded by another replica
+func DecodeMessage(data []byte) (*beehivemodel.Message, error) {
+	var fm forwardedMessage
+	if err := json.Unmarshal(data, &fm); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal forwarded message: %v", err)
+	}
+
+	msg := &beehivemodel.Message{Header: fm.Header, Router: fm.Router}
+	switch fm.ContentType {
+	case "":
+	case contentTypeString:
+		msg.Content = string(fm.Content)
+	case contentTypeBytes:
+		msg.Content = fm.Content
+	case contentTypeObject:
+		msg.Content = decodeObject(fm.Content)
+	default:
+		return nil, fmt.Errorf("unknown content type %q of forwarded message", fm.ContentType)
+	}
+	return msg, nil
+}
+
+func decodeObject(data []byte) interface{} {
+	var partial struct {
+		Metadata *metav1.ObjectMeta `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &partial); err != nil || partial.Metadata == nil {
+		// the content isn't a Kubernetes object
+		return json.RawMessage(data)
+	}
+	return &Object{ObjectMeta: *partial.Metadata, raw: data}
+}
+
+// NewToken returns the token authenticating the messages forwarded by the replicas
+func NewToken(caKey []byte) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   tokenSubject,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenLifetime)),
+	})
+	return token.SignedString(caKey)
+}
+
+// Authenticate verifies the bearer token of the messages forwarded by the other replicas
+func Authenticate(authorization string, caKey []byte) error {
+	bearerToken, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || bearerToken == "" {
+		return errors.New("bearer token is required")
+	}
+	_, err := jwt.Parse(bearerToken, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("invalid token method type, want *jwt.SigningMethodHMAC, but is %T", token.Method)
+		}
+		return caKey, nil
+	}, jwt.WithSubject(tokenSubject), jwt.WithExpirationRequired())
+	if err != nil {
+		return fmt.Errorf("token validation failure: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routing
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/pkg/security/token"
+)
+
+func TestEncodeDecodeMessage(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default", UID: "pod-uid", ResourceVersion: "10"},
+		Spec:       v1.PodSpec{NodeName: testNodeID},
+	}
+	cases := []struct {
+		name    string
+		content interface{}
+	}{
+		{
+			name: "no content",
+		},
+		{
+			name:    "string content",
+			content: "OK",
+		},
+		{
+			name:    "bytes content",
+			content: []byte{0, 1, 2},
+		},
+		{
+			name:    "object content",
+			content: pod,
+		},
+		{
+			name:    "map content",
+			content: map[string]string{"key": "value"},
+		},
+	}
+	for _, c := range cases {
+		msg := beehivemodel.NewMessage("").BuildRouter("edgecontroller", "resource",
+			"node/"+testNodeID+"/default/pod/pod", beehivemodel.UpdateOperation).
+			SetResourceVersion("10").FillBody(c.content)
+		data, err := encodeMessage(msg)
+		if err != nil {
+			t.Errorf("%s: failed to encode message: %v", c.name, err)
+			continue
+		}
+		got, err := DecodeMessage(data)
+		if err != nil {
+			t.Errorf("%s: failed to decode message: %v", c.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got.Header, msg.Header) || !reflect.DeepEqual(got.Router, msg.Router) {
+			t.Errorf("%s: expected message %v, but got %v", c.name, msg, got)
+		}
+
+		// the content is sent to the edge as the original one
+		expected, _ := msg.GetContentData()
+		content, err := got.GetContentData()
+		if err != nil || !bytes.Equal(content, expected) {
+			t.Errorf("%s: expected content %s, but got %s, err: %v", c.name, expected, content, err)
+		}
+	}
+}
+
+func TestDecodeObject(t *testing.T) {
+	data, _ := json.Marshal(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", UID: "pod-uid"}})
+	accessor, err := meta.Accessor(decodeObject(data))
+	if err != nil {
+		t.Fatalf("expected the metadata of the object accessible, but got err: %v", err)
+	}
+	if accessor.GetName() != "pod" || accessor.GetUID() != "pod-uid" {
+		t.Errorf("expected the metadata of pod, but got %s/%s", accessor.GetName(), accessor.GetUID())
+	}
+
+	if _, ok := decodeObject([]byte(`{"key":"value"}`)).(json.RawMessage); !ok {
+		t.Errorf("expected the content without metadata decoded as raw message")
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	caKey := []byte("ca key")
+	replicaToken, err := NewToken(caKey)
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+	otherToken, err := NewToken([]byte("another ca key"))
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+	// the token of the edge nodes is signed by the same CA key
+	edgeToken, err := token.Create([]byte("ca"), caKey, 1)
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+	_, edgeJWT, _ := bytes.Cut([]byte(edgeToken), []byte("."))
+	expiredToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   tokenSubject,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+	}).SignedString(caKey)
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+
+	cases := []struct {
+		name          string
+		authorization string
+		valid         bool
+	}{
+		{
+			name: "no token",
+		},
+		{
+			name:          "token of another CA key",
+			authorization: "Bearer " + otherToken,
+		},
+		{
+			name:          "token of edge nodes",
+			authorization: "Bearer " + string(edgeJWT),
+		},
+		{
+			name:          "expired token",
+			authorization: "Bearer " + expiredToken,
+		},
+		{
+			name:          "token of replicas",
+			authorization: "Bearer " + replicaToken,
+			valid:         true,
+		},
+	}
+	for _, c := range cases {
+		if err := Authenticate(c.authorization, caKey); (err == nil) != c.valid {
+			t.Errorf("%s: expected valid %v, but got err: %v", c.name, c.valid, err)
+		}
+	}
+}
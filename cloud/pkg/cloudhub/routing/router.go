@@ -0,0 +1,318 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routing
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	coordinationclientv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	coordinationlisters "k8s.io/client-go/listers/coordination/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/replicas"
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/common/types"
+)
+
+const (
+	// DefaultLeaseDurationSeconds is the default duration of the Leases registering the replicas
+	DefaultLeaseDurationSeconds = 40
+
+	// replicaLeasePrefix is the name prefix of the Leases registering the HTTPS servers of the replicas
+	replicaLeasePrefix = "cloudhub-replica-"
+	// nodeLeasePrefix is the name prefix of the Leases registering the replicas the nodes connect to
+	nodeLeasePrefix = "cloudhub-node-"
+
+	forwardTimeout = 10 * time.Second
+	// peerQueueSize is the number of the messages queued for every other replica
+	peerQueueSize = 1024
+	// peerRetryInterval is the interval the messages for an unreachable replica are dispatched locally
+	// without being forwarded, so the queue of the replica is drained quickly
+	peerRetryInterval = 5 * time.Second
+)
+
+// Router routes the downstream messages between the CloudCore replicas. Every replica renews a Lease
+// holding the address of its HTTPS server, and takes the Lease of a node when the node connects to it,
+// so a message for a node connected to another replica is forwarded to that replica immediately.
+// The ownership of a node transfers as soon as the node reconnects to another replica.
+type Router struct {
+	identity      string
+	address       string
+	leaseDuration time.Duration
+	leases        coordinationclientv1.LeaseInterface
+	informer      cache.SharedIndexInformer
+	lister        coordinationlisters.LeaseNamespaceLister
+
+	// mu guards the fields below, which are set when the router runs, as the certificates
+	// of CloudHub are prepared then
+	mu     sync.Mutex
+	ctx    context.Context
+	client *http.Client
+	caKey  []byte
+	// peers are the queues of the messages forwarded to the other replicas, keyed by their addresses
+	peers map[string]*peer
+}
+
+// peer forwards the messages queued for a replica in its own goroutine, so an unreachable
+// replica doesn't block the messages of the other replicas
+type peer struct {
+	address string
+	queue   chan forwardRequest
+}
+
+type forwardRequest struct {
+	msg      *beehivemodel.Message
+	fallback func(*beehivemodel.Message)
+}
+
+// NewRouter returns a router of the replica identified by identity, whose HTTPS server is reached at address
+func NewRouter(identity, address string, leaseDuration time.Duration, kubeClient kubernetes.Interface) *Router {
+	informer, lister := replicas.NewLeaseInformer(kubeClient)
+	return &Router{
+		identity:      strings.ToLower(identity),
+		address:       address,
+		leaseDuration: leaseDuration,
+		leases:        kubeClient.CoordinationV1().Leases(constants.SystemNamespace),
+		informer:      informer,
+		lister:        lister,
+		peers:         make(map[string]*peer),
+	}
+}
+
+// NewHTTPClient returns the client forwarding the messages to the HTTPS servers of the other replicas,
+// which verifies their certificates against the CA of CloudHub.
+func NewHTTPClient(ca []byte) (*http.Client, error) {
+	caCert, err := x509.ParseCertificate(ca)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CA certificate: %v", err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = replicas.NewTLSConfig(pool)
+	return &http.Client{Transport: transport, Timeout: forwardTimeout}, nil
+}
+
+// Run forwards the messages with client, renews the Lease of this replica and deletes the Leases
+// of the gone replicas until ctx is done. The forwarded messages carry the tokens signed by caKey,
+// which the other replicas verify.
+func (r *Router) Run(ctx context.Context, client *http.Client, caKey []byte) {
+	r.mu.Lock()
+	r.ctx, r.client, r.caKey = ctx, client, caKey
+	r.mu.Unlock()
+
+	go r.informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), r.informer.HasSynced) {
+		klog.Error("unable to sync caches for the message router")
+		return
+	}
+
+	go wait.UntilWithContext(ctx, r.collectGarbage, r.leaseDuration)
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := replicas.RenewLease(ctx, r.leases, replicaLeasePrefix+r.identity, r.address, r.leaseDuration); err != nil {
+			klog.Errorf("failed to renew the lease of replica %s: %v", r.identity, err)
+		}
+	}, r.leaseDuration/4)
+}
+
+// Own takes the Lease of the node connected to this replica
+func (r *Router) Own(ctx context.Context, nodeID string) error {
+	return replicas.RenewLease(ctx, r.leases, nodeLeasePrefix+nodeID, r.identity, r.leaseDuration)
+}
+
+// Disown deletes the Lease of the node disconnected from this replica, the Lease
+// is kept if the node has connected to another replica
+func (r *Router) Disown(ctx context.Context, nodeID string) error {
+	return replicas.ReleaseLease(ctx, r.leases, nodeLeasePrefix+nodeID, r.identity)
+}
+
+// Forward queues the message to be forwarded to the replica the node connects to without blocking.
+// It returns false if the node doesn't connect to another alive replica or the queue of the replica
+// is full, then the message should be dispatched locally. fallback is called with the queued message
+// if it fails to be forwarded.
+func (r *Router) Forward(nodeID string, msg *beehivemodel.Message, fallback func(*beehivemodel.Message)) bool {
+	if !r.informer.HasSynced() {
+		return false
+	}
+	address, err := r.owner(nodeID)
+	if err != nil {
+		klog.Warningf("failed to get the replica connected by node %s: %v", nodeID, err)
+		return false
+	}
+	if address == "" {
+		return false
+	}
+
+	p := r.peer(address)
+	if p == nil {
+		return false
+	}
+	select {
+	case p.queue <- forwardRequest{msg: msg, fallback: fallback}:
+		return true
+	default:
+		klog.Warningf("forward queue of replica %s is full, dispatch message %s of node %s locally", address, msg.GetID(), nodeID)
+		return false
+	}
+}
+
+// peer returns the queue of the replica, its goroutine is started on first use and runs until the router stops
+func (r *Router) peer(address string) *peer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.ctx == nil {
+		return nil
+	}
+	p, ok := r.peers[address]
+	if !ok {
+		p = &peer{address: address, queue: make(chan forwardRequest, peerQueueSize)}
+		r.peers[address] = p
+		go r.runPeer(r.ctx, p, r.client, r.caKey)
+	}
+	return p
+}
+
+func (r *Router) runPeer(ctx context.Context, p *peer, client *http.Client, caKey []byte) {
+	var unreachableUntil time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-p.queue:
+			if time.Now().Before(unreachableUntil) {
+				req.fallback(req.msg)
+				continue
+			}
+			if err := send(client, caKey, p.address, req.msg); err != nil {
+				klog.Warningf("failed to forward message %s, dispatch it locally: %v", req.msg.GetID(), err)
+				unreachableUntil = time.Now().Add(peerRetryInterval)
+				req.fallback(req.msg)
+			}
+		}
+	}
+}
+
+func send(client *http.Client, caKey []byte, address string, msg *beehivemodel.Message) error {
+	body, err := encodeMessage(msg)
+	if err != nil {
+		return err
+	}
+	token, err := NewToken(caKey)
+	if err != nil {
+		return fmt.Errorf("failed to create token: %v", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, "https://"+address+constants.DefaultForwardMessageURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(types.HeaderAuthorization, "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to forward message to replica %s: %v", address, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		reason, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("replica %s rejects the message with status %d: %s", address, resp.StatusCode, reason)
+	}
+	return nil
+}
+
+// owner returns the HTTPS server address of the other alive replica the node connects to
+func (r *Router) owner(nodeID string) (string, error) {
+	nodeLease, err := r.lister.Get(nodeLeasePrefix + nodeID)
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	holder := ptr.Deref(nodeLease.Spec.HolderIdentity, "")
+	if holder == "" || holder == r.identity {
+		return "", nil
+	}
+
+	replicaLease, err := r.lister.Get(replicaLeasePrefix + holder)
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if replicas.LeaseExpired(replicaLease, time.Now()) {
+		// the replica is gone, the node is going to connect to another replica
+		klog.V(4).Infof("replica %s connected by node %s is gone", holder, nodeID)
+		return "", nil
+	}
+	return ptr.Deref(replicaLease.Spec.HolderIdentity, ""), nil
+}
+
+// collectGarbage deletes the Leases of the replicas gone for a lease duration, and the Leases of the
+// nodes connected to the gone replicas, which are left when the replicas exit without disowning them
+func (r *Router) collectGarbage(ctx context.Context) {
+	leases, err := r.lister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list the leases of the message router: %v", err)
+		return
+	}
+	now := time.Now()
+	for _, lease := range leases {
+		if !r.garbage(lease, now) {
+			continue
+		}
+		if err := replicas.DeleteLease(ctx, r.leases, lease); err != nil && !apierrors.IsConflict(err) {
+			klog.Warningf("failed to delete lease %s: %v", lease.Name, err)
+		}
+	}
+}
+
+func (r *Router) garbage(lease *coordinationv1.Lease, now time.Time) bool {
+	switch {
+	case strings.HasPrefix(lease.Name, replicaLeasePrefix):
+		return lease.Name != replicaLeasePrefix+r.identity && replicas.LeaseExpired(lease, now.Add(-r.leaseDuration))
+	case strings.HasPrefix(lease.Name, nodeLeasePrefix):
+		holder := ptr.Deref(lease.Spec.HolderIdentity, "")
+		// the Leases of the nodes connected to this replica are deleted when the nodes disconnect, and
+		// a Lease taken within the lease duration is kept as its replica may not be registered yet
+		if holder == r.identity || !replicas.LeaseExpired(lease, now) {
+			return false
+		}
+		replicaLease, err := r.lister.Get(replicaLeasePrefix + holder)
+		return err != nil || replicas.LeaseExpired(replicaLease, now)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/replicas"
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/common/types"
+)
+
+const testNodeID = "edge-node"
+
+// startRouter starts the informer of the router without renewing the Lease of the replica
+func startRouter(t *testing.T, ctx context.Context, r *Router, client *http.Client, caKey []byte) {
+	r.mu.Lock()
+	r.ctx, r.client, r.caKey = ctx, client, caKey
+	r.mu.Unlock()
+	go r.informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), r.informer.HasSynced) {
+		t.Fatalf("failed to sync the lease informer")
+	}
+}
+
+// waitForward waits until Forward returns the expected result, as the leases are got from the informer
+func waitForward(t *testing.T, r *Router, msg *beehivemodel.Message, fallback func(*beehivemodel.Message), expected bool) {
+	var forwarded bool
+	_ = wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 5*time.Second, true,
+		func(context.Context) (bool, error) {
+			forwarded = r.Forward(testNodeID, msg, fallback)
+			return forwarded == expected, nil
+		})
+	if forwarded != expected {
+		t.Fatalf("expected forwarded %v, but got %v", expected, forwarded)
+	}
+}
+
+func noFallback(t *testing.T) func(*beehivemodel.Message) {
+	return func(msg *beehivemodel.Message) {
+		t.Errorf("unexpected fallback of message %s", msg.GetID())
+	}
+}
+
+func TestRouterForward(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	caKey := []byte("ca key")
+
+	received := make(chan *beehivemodel.Message, 10)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != constants.DefaultForwardMessageURL {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := Authenticate(r.Header.Get(types.HeaderAuthorization), caKey); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		msg, err := DecodeMessage(body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- msg
+	}))
+	defer server.Close()
+
+	client := fake.NewSimpleClientset()
+	replicaA := NewRouter("Replica-A", strings.TrimPrefix(server.URL, "https://"), 40*time.Second, client)
+	replicaB := NewRouter("replica-b", "10.0.0.2:10002", 40*time.Second, client)
+	startRouter(t, ctx, replicaB, server.Client(), caKey)
+
+	msg := beehivemodel.NewMessage("").BuildRouter("edgecontroller", "resource",
+		"node/"+testNodeID+"/default/configmap/cm", beehivemodel.UpdateOperation).FillBody("content")
+
+	// the node doesn't connect to any replica
+	if forwarded := replicaB.Forward(testNodeID, msg, noFallback(t)); forwarded {
+		t.Errorf("expected the message not forwarded")
+	}
+
+	if err := replicas.RenewLease(ctx, replicaA.leases, replicaLeasePrefix+replicaA.identity, replicaA.address, time.Minute); err != nil {
+		t.Fatalf("failed to renew replica lease: %v", err)
+	}
+	if err := replicaA.Own(ctx, testNodeID); err != nil {
+		t.Fatalf("failed to own node: %v", err)
+	}
+	waitForward(t, replicaB, msg, noFallback(t), true)
+	select {
+	case got := <-received:
+		if got.GetID() != msg.GetID() || got.GetResource() != msg.GetResource() || got.GetContent() != "content" {
+			t.Errorf("expected message %v, but got %v", msg, got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the forwarded message is not received")
+	}
+
+	// the node reconnects to replica b
+	if err := replicaB.Own(ctx, testNodeID); err != nil {
+		t.Fatalf("failed to own node: %v", err)
+	}
+	waitForward(t, replicaB, msg, noFallback(t), false)
+
+	// replica a doesn't release the node connected to replica b
+	if err := replicaA.Disown(ctx, testNodeID); err != nil {
+		t.Fatalf("failed to disown node: %v", err)
+	}
+	lease, err := client.CoordinationV1().Leases(constants.SystemNamespace).Get(ctx, nodeLeasePrefix+testNodeID, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get node lease: %v", err)
+	}
+	if holder := ptr.Deref(lease.Spec.HolderIdentity, ""); holder != "replica-b" {
+		t.Errorf("expected node lease held by replica-b, but got %q", holder)
+	}
+
+	if err := replicaB.Disown(ctx, testNodeID); err != nil {
+		t.Fatalf("failed to disown node: %v", err)
+	}
+	_, err = client.CoordinationV1().Leases(constants.SystemNamespace).Get(ctx, nodeLeasePrefix+testNodeID, metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected node lease deleted, but got err: %v", err)
+	}
+}
+
+func TestRouterForwardUnreachableReplica(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	renewTime := metav1.NewMicroTime(time.Now())
+	client := fake.NewSimpleClientset(
+		&coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: replicaLeasePrefix + "replica-a", Namespace: constants.SystemNamespace},
+			Spec: coordinationv1.LeaseSpec{
+				// nothing listens on the port
+				HolderIdentity:       ptr.To("127.0.0.1:1"),
+				LeaseDurationSeconds: ptr.To(int32(40)),
+				RenewTime:            &renewTime,
+			},
+		},
+		&coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: nodeLeasePrefix + testNodeID, Namespace: constants.SystemNamespace},
+			Spec:       coordinationv1.LeaseSpec{HolderIdentity: ptr.To("replica-a")},
+		},
+	)
+	router := NewRouter("replica-b", "10.0.0.2:10002", 40*time.Second, client)
+	startRouter(t, ctx, router, http.DefaultClient, []byte("ca key"))
+
+	msg := beehivemodel.NewMessage("").BuildRouter("edgecontroller", "resource",
+		"node/"+testNodeID+"/default/configmap/cm", beehivemodel.UpdateOperation).FillBody("content")
+	fallback := make(chan *beehivemodel.Message, 1)
+	// the message is queued without waiting for the unreachable replica
+	waitForward(t, router, msg, func(msg *beehivemodel.Message) { fallback <- msg }, true)
+	select {
+	case got := <-fallback:
+		if got.GetID() != msg.GetID() {
+			t.Errorf("expected fallback of message %s, but got %s", msg.GetID(), got.GetID())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the message failed to forward is not dispatched locally")
+	}
+}
+
+func TestRouterCollectGarbage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	now := metav1.NewMicroTime(time.Now())
+	staleTime := metav1.NewMicroTime(time.Now().Add(-2 * time.Minute))
+	lease := func(name, holder string, renewTime *metav1.MicroTime) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.SystemNamespace},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(holder),
+				LeaseDurationSeconds: ptr.To(int32(40)),
+				RenewTime:            renewTime,
+			},
+		}
+	}
+	client := fake.NewSimpleClientset(
+		lease(replicaLeasePrefix+"replica-a", "10.0.0.1:10002", &staleTime),
+		lease(replicaLeasePrefix+"replica-b", "10.0.0.2:10002", &staleTime),
+		lease(replicaLeasePrefix+"replica-c", "10.0.0.3:10002", &now),
+		lease(nodeLeasePrefix+"node-a", "replica-a", &staleTime),
+		lease(nodeLeasePrefix+"node-b", "replica-b", &staleTime),
+		lease(nodeLeasePrefix+"node-c", "replica-c", &staleTime),
+		lease(nodeLeasePrefix+"node-d", "replica-d", &now),
+		lease(nodeLeasePrefix+"node-e", "replica-d", &staleTime),
+	)
+	router := NewRouter("replica-b", "10.0.0.2:10002", 40*time.Second, client)
+	startRouter(t, ctx, router, http.DefaultClient, nil)
+
+	router.collectGarbage(ctx)
+
+	expected := map[string]bool{
+		// the gone replica
+		replicaLeasePrefix + "replica-a": false,
+		// this replica
+		replicaLeasePrefix + "replica-b": true,
+		replicaLeasePrefix + "replica-c": true,
+		// the node connected to the gone replica
+		nodeLeasePrefix + "node-a": false,
+		// the node connected to this replica
+		nodeLeasePrefix + "node-b": true,
+		nodeLeasePrefix + "node-c": true,
+		// the node just connected to a replica not registered yet
+		nodeLeasePrefix + "node-d": true,
+		// the node connected to a replica never registered
+		nodeLeasePrefix + "node-e": false,
+	}
+	for name, exist := range expected {
+		_, err := client.CoordinationV1().Leases(constants.SystemNamespace).Get(ctx, name, metav1.GetOptions{})
+		if got := err == nil; got != exist {
+			t.Errorf("expected lease %s exists %v, but got %v, err: %v", name, exist, got, err)
+		}
+	}
+}
+
+func TestRouterOwnerExpiredReplica(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	renewTime := metav1.NewMicroTime(time.Now().Add(-time.Minute))
+	client := fake.NewSimpleClientset(
+		&coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: replicaLeasePrefix + "replica-a", Namespace: constants.SystemNamespace},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To("10.0.0.1:10002"),
+				LeaseDurationSeconds: ptr.To(int32(40)),
+				RenewTime:            &renewTime,
+			},
+		},
+		&coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: nodeLeasePrefix + testNodeID, Namespace: constants.SystemNamespace},
+			Spec:       coordinationv1.LeaseSpec{HolderIdentity: ptr.To("replica-a")},
+		},
+	)
+	router := NewRouter("replica-b", "10.0.0.2:10002", 40*time.Second, client)
+	startRouter(t, ctx, router, http.DefaultClient, nil)
+
+	address, err := router.owner(testNodeID)
+	if err != nil || address != "" {
+		t.Errorf("expected no owner, but got %q, err: %v", address, err)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/httpserver/resps"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/session"
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/common/types"
+)
+
+// SessionLister lists the node sessions of CloudHub
+type SessionLister interface {
+	ListSessions() []session.Info
+}
+
+// Sessions returns the handler which lists the node sessions. The request must carry a
+// bearer token of the cluster, e.g. the token of a service account, whose user is allowed
+// to get the non-resource URL /debug/sessions. The token of CloudHub is not accepted since
+// every edge node which can join the cluster has it.
+func Sessions(lister SessionLister, kubeClient kubernetes.Interface) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		code, err := authorize(request.Request.Context(), kubeClient, request.Request.Header.Get(types.HeaderAuthorization))
+		if err != nil {
+			klog.Warningf("reject the request to list sessions: %v", err)
+			resps.Error(response, code, err)
+			return
+		}
+
+		body, err := json.Marshal(lister.ListSessions())
+		if err != nil {
+			resps.Error(response, http.StatusInternalServerError, fmt.Errorf("failed to marshal sessions: %v", err))
+			return
+		}
+		response.Header().Set(restful.HEADER_ContentType, restful.MIME_JSON)
+		resps.OK(response, body)
+	}
+}
+
+// authorize authenticates the bearer token with a TokenReview and checks whether its
+// user is allowed to get the sessions with a SubjectAccessReview. The http status code
+// to respond with is returned along with the error.
+func authorize(ctx context.Context, kubeClient kubernetes.Interface, authorization string) (int, error) {
+	bearerToken, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || bearerToken == "" {
+		return http.StatusUnauthorized, errors.New("bearer token is required")
+	}
+
+	tokenReview, err := kubeClient.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: bearerToken},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to review the token: %v", err)
+	}
+	if !tokenReview.Status.Authenticated {
+		return http.StatusUnauthorized, fmt.Errorf("token validation failure, %s", tokenReview.Status.Error)
+	}
+
+	user := tokenReview.Status.User
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	accessReview, err := kubeClient.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			NonResourceAttributes: &authorizationv1.NonResourceAttributes{
+				Path: constants.DefaultDebugSessionsURL,
+				Verb: "get",
+			},
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to review the access of user %s: %v", user.Username, err)
+	}
+	if !accessReview.Status.Allowed {
+		return http.StatusForbidden, fmt.Errorf("user %s is not allowed to get %s", user.Username, constants.DefaultDebugSessionsURL)
+	}
+	return http.StatusOK, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/session"
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/common/types"
+)
+
+type fakeLister []session.Info
+
+func (l fakeLister) ListSessions() []session.Info {
+	return l
+}
+
+func TestSessions(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "tokenreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		review := action.(clienttesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		switch review.Spec.Token {
+		case "admin-token":
+			review.Status = authenticationv1.TokenReviewStatus{Authenticated: true,
+				User: authenticationv1.UserInfo{Username: "admin", Groups: []string{"system:masters"}}}
+		case "viewer-token":
+			review.Status = authenticationv1.TokenReviewStatus{Authenticated: true,
+				User: authenticationv1.UserInfo{Username: "viewer"}}
+		default:
+			review.Status = authenticationv1.TokenReviewStatus{Error: "invalid bearer token"}
+		}
+		return true, review, nil
+	})
+	kubeClient.PrependReactor("create", "subjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		review := action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attrs := review.Spec.NonResourceAttributes
+		review.Status.Allowed = review.Spec.User == "admin" &&
+			attrs != nil && attrs.Path == constants.DefaultDebugSessionsURL && attrs.Verb == "get"
+		return true, review, nil
+	})
+
+	lister := fakeLister{{NodeID: "edge-node", Protocol: "websocket", Endpoint: "192.168.1.10:40000"}}
+
+	cases := []struct {
+		name          string
+		authorization string
+		code          int
+	}{
+		{
+			name: "no token",
+			code: http.StatusUnauthorized,
+		},
+		{
+			name:          "invalid token",
+			authorization: "Bearer edge-join-token",
+			code:          http.StatusUnauthorized,
+		},
+		{
+			name:          "user not allowed",
+			authorization: "Bearer viewer-token",
+			code:          http.StatusForbidden,
+		},
+		{
+			name:          "user allowed",
+			authorization: "Bearer admin-token",
+			code:          http.StatusOK,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			httpReq := httptest.NewRequest(http.MethodGet, "/debug/sessions", nil)
+			if c.authorization != "" {
+				httpReq.Header.Set(types.HeaderAuthorization, c.authorization)
+			}
+			recorder := httptest.NewRecorder()
+
+			Sessions(lister, kubeClient)(restful.NewRequest(httpReq), restful.NewResponse(recorder))
+
+			require.Equal(t, c.code, recorder.Code)
+			if c.code != http.StatusOK {
+				return
+			}
+			var infos []session.Info
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &infos))
+			require.Equal(t, []session.Info(lister), infos)
+		})
+	}
+}
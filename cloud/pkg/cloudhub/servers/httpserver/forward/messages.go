@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+	"k8s.io/klog/v2"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/routing"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/httpserver/resps"
+	"github.com/kubeedge/kubeedge/common/types"
+)
+
+// maxMessageBytes limits the size of the forwarded messages
+const maxMessageBytes = int64(16 * 1024 * 1024)
+
+// MessageReceiver dispatches the downstream messages forwarded by the other CloudCore replicas
+type MessageReceiver interface {
+	DispatchForwarded(message *beehivemodel.Message) error
+}
+
+// Messages returns the handler which receives the downstream messages forwarded by the other
+// CloudCore replicas. The request must carry the token of the replicas signed by the CA key.
+func Messages(receiver MessageReceiver) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		if err := routing.Authenticate(request.Request.Header.Get(types.HeaderAuthorization), hubconfig.Config.CaKey); err != nil {
+			klog.Warningf("reject the forwarded message: %v", err)
+			resps.Error(response, http.StatusUnauthorized, err)
+			return
+		}
+
+		lr := &io.LimitedReader{R: request.Request.Body, N: maxMessageBytes + 1}
+		body, err := io.ReadAll(lr)
+		if err != nil {
+			resps.Error(response, http.StatusBadRequest, fmt.Errorf("failed to read the forwarded message: %v", err))
+			return
+		}
+		if lr.N <= 0 {
+			resps.Error(response, http.StatusRequestEntityTooLarge, fmt.Errorf("the forwarded message exceeds %d bytes", maxMessageBytes))
+			return
+		}
+		msg, err := routing.DecodeMessage(body)
+		if err != nil {
+			resps.Error(response, http.StatusBadRequest, err)
+			return
+		}
+
+		// the node may have disconnected from this replica, the sender dispatches the message then
+		if err := receiver.DispatchForwarded(msg); err != nil {
+			resps.Error(response, http.StatusConflict, err)
+			return
+		}
+		resps.OK(response, nil)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful"
+	"github.com/stretchr/testify/require"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/routing"
+	"github.com/kubeedge/kubeedge/common/types"
+)
+
+type fakeReceiver struct {
+	err      error
+	messages []*beehivemodel.Message
+}
+
+func (r *fakeReceiver) DispatchForwarded(message *beehivemodel.Message) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.messages = append(r.messages, message)
+	return nil
+}
+
+func TestMessages(t *testing.T) {
+	hubconfig.Config.CaKey = []byte("ca key")
+	validToken, err := routing.NewToken(hubconfig.Config.CaKey)
+	require.NoError(t, err)
+	otherToken, err := routing.NewToken([]byte("another ca key"))
+	require.NoError(t, err)
+
+	body, err := json.Marshal(map[string]interface{}{
+		"header":      map[string]interface{}{"msg_id": "id"},
+		"route":       map[string]interface{}{"resource": "node/edge-node/default/configmap/cm", "operation": "update"},
+		"contentType": "string",
+		"content":     []byte("content"),
+	})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name          string
+		authorization string
+		body          []byte
+		receiveErr    error
+		code          int
+	}{
+		{
+			name: "no token",
+			body: body,
+			code: http.StatusUnauthorized,
+		},
+		{
+			name:          "token of another CA key",
+			authorization: "Bearer " + otherToken,
+			body:          body,
+			code:          http.StatusUnauthorized,
+		},
+		{
+			name:          "malformed message",
+			authorization: "Bearer " + validToken,
+			body:          []byte("{"),
+			code:          http.StatusBadRequest,
+		},
+		{
+			name:          "node not connected",
+			authorization: "Bearer " + validToken,
+			body:          body,
+			receiveErr:    errors.New("node edge-node is not connected to this replica"),
+			code:          http.StatusConflict,
+		},
+		{
+			name:          "message dispatched",
+			authorization: "Bearer " + validToken,
+			body:          body,
+			code:          http.StatusOK,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			httpReq := httptest.NewRequest(http.MethodPost, "/messages/forward", bytes.NewReader(c.body))
+			if c.authorization != "" {
+				httpReq.Header.Set(types.HeaderAuthorization, c.authorization)
+			}
+			recorder := httptest.NewRecorder()
+			receiver := &fakeReceiver{err: c.receiveErr}
+
+			Messages(receiver)(restful.NewRequest(httpReq), restful.NewResponse(recorder))
+
+			require.Equal(t, c.code, recorder.Code)
+			if c.code != http.StatusOK {
+				return
+			}
+			require.Len(t, receiver.messages, 1)
+			require.Equal(t, "id", receiver.messages[0].GetID())
+			require.Equal(t, "content", receiver.messages[0].GetContent())
+		})
+	}
+}
@@ -26,15 +26,19 @@ import (
 
 	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
 	certshandler "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/httpserver/certificate"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/httpserver/debug"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/httpserver/forward"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/httpserver/node"
 	nodetaskhandler "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/httpserver/nodetask"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
 	"github.com/kubeedge/kubeedge/common/constants"
 )
 
-// StartHTTPServer starts the http service
-func StartHTTPServer() error {
+// StartHTTPServer starts the http service, the messages forwarded by the other
+// replicas are received only if receiver is not nil
+func StartHTTPServer(sessionLister debug.SessionLister, receiver forward.MessageReceiver) error {
 	serverContainer := restful.NewContainer()
-	serverContainer.Add(routes())
+	serverContainer.Add(routes(sessionLister, receiver))
 	addr := fmt.Sprintf("%s:%d", hubconfig.Config.HTTPS.Address, hubconfig.Config.HTTPS.Port)
 	cert, err := tls.X509KeyPair(
 		pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: hubconfig.Config.Cert}),
@@ -55,7 +59,7 @@ func StartHTTPServer() error {
 	return server.ListenAndServeTLS("", "")
 }
 
-func routes() *restful.WebService {
+func routes(sessionLister debug.SessionLister, receiver forward.MessageReceiver) *restful.WebService {
 	ws := new(restful.WebService)
 	ws.Path("/")
 	ws.Route(ws.GET(constants.DefaultCertURL).To(certshandler.EdgeCoreClientCert))
@@ -63,5 +67,9 @@ func routes() *restful.WebService {
 	ws.Route(ws.GET(constants.DefaultCheckNodeURL).To(node.CheckNode))
 	ws.Route(ws.POST(constants.DefaultNodeUpgradeURL).To(nodetaskhandler.UpgradeEdge))
 	ws.Route(ws.POST(constants.DefaultTaskStateReportURL).To(nodetaskhandler.ReportStatus))
+	ws.Route(ws.GET(constants.DefaultDebugSessionsURL).To(debug.Sessions(sessionLister, client.GetKubeClient())))
+	if receiver != nil {
+		ws.Route(ws.POST(constants.DefaultForwardMessageURL).To(forward.Messages(receiver)))
+	}
 	return ws
 }
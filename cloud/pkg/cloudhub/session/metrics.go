@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package session
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
+)
+
+// queueCollector collects the queue length and the pending acks of the node sessions when scraped
+type queueCollector struct {
+	manager *Manager
+}
+
+// NewQueueCollector returns the collector of the message queues of the node sessions
+func NewQueueCollector(manager *Manager) prometheus.Collector {
+	return &queueCollector{manager: manager}
+}
+
+func (c *queueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- monitor.NodeQueueLength
+	ch <- monitor.NodePendingAcks
+}
+
+func (c *queueCollector) Collect(ch chan<- prometheus.Metric) {
+	c.manager.NodeSessions.Range(func(_, value interface{}) bool {
+		ns := value.(*NodeSession)
+		ch <- prometheus.MustNewConstMetric(monitor.NodeQueueLength, prometheus.GaugeValue,
+			float64(ns.nodeMessagePool.AckMessageQueue.Len()), ns.nodeID, monitor.QueueAck)
+		ch <- prometheus.MustNewConstMetric(monitor.NodeQueueLength, prometheus.GaugeValue,
+			float64(ns.nodeMessagePool.NoAckMessageQueue.Len()), ns.nodeID, monitor.QueueNoAck)
+		ch <- prometheus.MustNewConstMetric(monitor.NodePendingAcks, prometheus.GaugeValue,
+			float64(len(ns.awaitingAckMessageIDs())), ns.nodeID)
+		return true
+	})
+}
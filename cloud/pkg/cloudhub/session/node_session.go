@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -34,11 +35,13 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common/model"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/messagelayer"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
 	deviceconst "github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
 	edgeconst "github.com/kubeedge/kubeedge/cloud/pkg/edgecontroller/constants"
 	"github.com/kubeedge/kubeedge/cloud/pkg/synccontroller"
 	v2 "github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/v2"
 	"github.com/kubeedge/kubeedge/pkg/metaserver/util"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
 	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/conn"
 )
 
@@ -85,6 +88,9 @@ type NodeSession struct {
 	// terminateErr records the error type of session termination
 	terminateErr int32
 
+	// connectTime is the time when the edge node connected
+	connectTime time.Time
+
 	// stopOnce is used to mark that session Terminating can only be executed once
 	stopOnce sync.Once
 
@@ -111,6 +117,7 @@ func NewNodeSession(
 		nodeMessagePool:   nodeMessagePool,
 		reliableClient:    reliableClient,
 		terminateErr:      NoErr,
+		connectTime:       time.Now(),
 	}
 }
 
@@ -172,6 +179,7 @@ func (ns *NodeSession) KeepAliveCheck() {
 
 		case <-keepaliveTimer.C:
 			klog.Errorf("timeout to receive keepalive for node %s", ns.nodeID)
+			monitor.KeepaliveMisses.WithLabelValues(ns.nodeID).Inc()
 
 			ns.SetTerminateErr(TransportErr)
 
@@ -254,6 +262,49 @@ func (ns *NodeSession) GetTerminateErr() int32 {
 	return atomic.LoadInt32(&ns.terminateErr)
 }
 
+// Info returns the connection and the pending messages of the node session
+func (ns *NodeSession) Info() Info {
+	info := Info{
+		NodeID:                ns.nodeID,
+		ProjectID:             ns.projectID,
+		ConnectTime:           ns.connectTime,
+		Protocol:              protocolOf(ns.connection),
+		AckQueueLength:        ns.nodeMessagePool.AckMessageQueue.Len(),
+		NoAckQueueLength:      ns.nodeMessagePool.NoAckMessageQueue.Len(),
+		PendingAckMessages:    ns.nodeMessagePool.AckMessageStore.ListKeys(),
+		PendingNoAckMessages:  ns.nodeMessagePool.NoAckMessageStore.ListKeys(),
+		AwaitingAckMessageIDs: ns.awaitingAckMessageIDs(),
+	}
+	if addr := ns.connection.RemoteAddr(); addr != nil {
+		info.Endpoint = addr.String()
+	}
+	sort.Strings(info.PendingAckMessages)
+	sort.Strings(info.PendingNoAckMessages)
+	return info
+}
+
+// awaitingAckMessageIDs returns the IDs of the messages sent to the edge node which are waiting for acknowledgement
+func (ns *NodeSession) awaitingAckMessageIDs() []string {
+	ids := []string{}
+	ns.ackMessageCache.Range(func(key, _ interface{}) bool {
+		ids = append(ids, key.(string))
+		return true
+	})
+	sort.Strings(ids)
+	return ids
+}
+
+func protocolOf(connection conn.Connection) string {
+	switch connection.(type) {
+	case *conn.WSConnection:
+		return api.ProtocolTypeWS
+	case *conn.QuicConnection:
+		return api.ProtocolTypeQuic
+	default:
+		return "unknown"
+	}
+}
+
 func (ns *NodeSession) syncNoAckMessage() (bool, error) {
 	key, quit := ns.nodeMessagePool.NoAckMessageQueue.Get()
 	if quit {
@@ -291,6 +342,7 @@ func (ns *NodeSession) syncNoAckMessage() (bool, error) {
 	common.TrimMessage(msg)
 
 	if err := ns.connection.WriteMessageAsync(msg); err != nil {
+		monitor.MessageDrops.WithLabelValues(ns.nodeID, monitor.DropReasonSendFailed).Inc()
 		ns.SetTerminateErr(TransportErr)
 		return true, fmt.Errorf("send message to edge node %s err: %v", ns.nodeID, err)
 	}
@@ -338,6 +390,7 @@ func (ns *NodeSession) syncAckMessage() (bool, error) {
 func (ns *NodeSession) sendMessageWithRetry(copyMsg, msg *beehivemodel.Message) error {
 	ackChan := make(chan struct{})
 	ns.ackMessageCache.Store(copyMsg.GetID(), ackChan)
+	sendTime := time.Now()
 
 	// initialize retry count and timer for sending message
 	retryCount := 0
@@ -351,11 +404,14 @@ func (ns *NodeSession) sendMessageWithRetry(copyMsg, msg *beehivemodel.Message)
 	for {
 		select {
 		case <-ackChan:
+			monitor.MessageAckDuration.WithLabelValues(ns.nodeID).Observe(time.Since(sendTime).Seconds())
 			ns.saveSuccessPoint(msg)
 			return nil
 
 		case <-ticker.C:
 			if retryCount == 4 {
+				// the message will be sent again with a new ack channel
+				ns.ackMessageCache.Delete(copyMsg.GetID())
 				return ErrWaitTimeout
 			}
 
@@ -364,6 +420,7 @@ func (ns *NodeSession) sendMessageWithRetry(copyMsg, msg *beehivemodel.Message)
 				return err
 			}
 
+			monitor.MessageRetries.WithLabelValues(ns.nodeID).Inc()
 			retryCount++
 			ticker.Reset(sendRetryInterval)
 		}
@@ -18,14 +18,35 @@ package session
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
 )
 
+// Info describes the connection and the pending messages of a node session
+type Info struct {
+	NodeID      string    `json:"nodeID"`
+	ProjectID   string    `json:"projectID"`
+	ConnectTime time.Time `json:"connectTime"`
+	// Endpoint is the remote address of the edge node
+	Endpoint string `json:"endpoint"`
+	// Protocol is the protocol of the connection, websocket or quic
+	Protocol         string `json:"protocol"`
+	AckQueueLength   int    `json:"ackQueueLength"`
+	NoAckQueueLength int    `json:"noAckQueueLength"`
+	// PendingAckMessages are the keys of the messages requiring acknowledgement that are not acknowledged yet
+	PendingAckMessages []string `json:"pendingAckMessages"`
+	// PendingNoAckMessages are the keys of the messages not requiring acknowledgement that are not sent yet
+	PendingNoAckMessages []string `json:"pendingNoAckMessages"`
+	// AwaitingAckMessageIDs are the IDs of the messages that are sent and waiting for acknowledgement
+	AwaitingAckMessageIDs []string `json:"awaitingAckMessageIDs"`
+}
+
 type Manager struct {
 	// NodeNumber is the number of currently connected edge
 	// nodes for single cloudHub instance
@@ -78,6 +99,7 @@ func (sm *Manager) DeleteSession(session *NodeSession) {
 
 	sm.NodeSessions.Delete(session.nodeID)
 	monitor.ConnectedNodes.Set(float64(atomic.AddInt32(&sm.NodeNumber, -1)))
+	monitor.DeleteNodeMetrics(session.nodeID)
 }
 
 // GetSession get the node session for the node
@@ -90,6 +112,17 @@ func (sm *Manager) GetSession(nodeID string) (*NodeSession, bool) {
 	return nil, false
 }
 
+// ListSessions returns the info of all the node sessions sorted by node ID
+func (sm *Manager) ListSessions() []Info {
+	infos := []Info{}
+	sm.NodeSessions.Range(func(_, value interface{}) bool {
+		infos = append(infos, value.(*NodeSession).Info())
+		return true
+	})
+	sort.Slice(infos, func(i, j int) bool { return infos[i].NodeID < infos[j].NodeID })
+	return infos
+}
+
 // ReachLimit checks whether the connected nodes exceeds the node limit number
 func (sm *Manager) ReachLimit() bool {
 	return atomic.LoadInt32(&sm.NodeNumber) >= sm.NodeLimit
@@ -17,11 +17,13 @@ limitations under the License.
 package session
 
 import (
+	"net"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 
 	"github.com/kubeedge/api/client/clientset/versioned/fake"
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common"
 	tf "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common/testing"
 	mockcon "github.com/kubeedge/kubeedge/pkg/viaduct/pkg/conn/testing"
@@ -104,3 +106,48 @@ func TestManager_ReceiveMessageAck(t *testing.T) {
 		t.Errorf("expected err but got nil")
 	}
 }
+
+func TestListSessions(t *testing.T) {
+	client := &fake.Clientset{}
+	nmp := common.InitNodeMessagePool(tf.TestNodeID)
+	mockController := gomock.NewController(t)
+	mockConn := mockcon.NewMockConnection(mockController)
+	mockConn.EXPECT().RemoteAddr().Return(&net.TCPAddr{IP: net.ParseIP("192.168.1.10"), Port: 40000}).AnyTimes()
+	session := NewNodeSession(tf.TestNodeID, tf.TestProjectID, mockConn, tf.KeepaliveInterval, nmp, client)
+
+	msg := beehivemodel.NewMessage("").
+		BuildRouter("edgecontroller", "resource", "node/"+tf.TestNodeID+"/default/pod/test-pod", beehivemodel.UpdateOperation).
+		SetResourceVersion("1")
+	if err := nmp.NoAckMessageStore.Add(msg); err != nil {
+		t.Fatalf("failed to add message: %v", err)
+	}
+	key, _ := common.NoAckMessageKeyFunc(msg)
+	nmp.NoAckMessageQueue.Add(key)
+
+	manager := NewSessionManager(10)
+	if infos := manager.ListSessions(); len(infos) != 0 {
+		t.Errorf("expected no sessions but got %v", infos)
+	}
+	manager.AddSession(session)
+
+	infos := manager.ListSessions()
+	if len(infos) != 1 {
+		t.Fatalf("expected 1 session but got %d", len(infos))
+	}
+	info := infos[0]
+	if info.NodeID != tf.TestNodeID || info.ProjectID != tf.TestProjectID {
+		t.Errorf("unexpected node %s of project %s", info.NodeID, info.ProjectID)
+	}
+	if info.Endpoint != "192.168.1.10:40000" {
+		t.Errorf("expected endpoint 192.168.1.10:40000 but got %s", info.Endpoint)
+	}
+	if info.ConnectTime.IsZero() {
+		t.Errorf("expected connect time is set")
+	}
+	if info.NoAckQueueLength != 1 || info.AckQueueLength != 0 {
+		t.Errorf("expected queue length 1 and 0 but got %d and %d", info.NoAckQueueLength, info.AckQueueLength)
+	}
+	if len(info.PendingNoAckMessages) != 1 || info.PendingNoAckMessages[0] != key {
+		t.Errorf("expected pending message %s but got %v", key, info.PendingNoAckMessages)
+	}
+}
@@ -17,16 +17,27 @@ limitations under the License.
 package cloudstream
 
 import (
+	"fmt"
+	"time"
+
+	"k8s.io/klog/v2"
+
 	"github.com/kubeedge/api/apis/componentconfig/cloudcore/v1alpha1"
 	"github.com/kubeedge/beehive/pkg/core"
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudstream/config"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/informers"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/pkg/util"
 )
 
 type cloudStream struct {
 	enable     bool
 	tunnelPort int
+	// registry routes the stream requests between the replicas, it's nil if tunnel routing is disabled
+	registry *tunnelRegistry
 }
 
 var _ core.Module = (*cloudStream)(nil)
@@ -40,7 +51,42 @@ func newCloudStream(enable bool, tunnelPort int) *cloudStream {
 
 func Register(controller *v1alpha1.CloudStream, commonConfig *v1alpha1.CommonConfig) {
 	config.InitConfigure(controller)
-	core.Register(newCloudStream(controller.Enable, commonConfig.TunnelPort))
+	stream := newCloudStream(controller.Enable, commonConfig.TunnelPort)
+	if controller.Enable && TunnelRoutingEnabled(controller) {
+		registry, err := newTunnelRegistryForConfig(controller)
+		if err != nil {
+			klog.Exitf("Failed to init tunnel routing of cloudstream: %v", err)
+		}
+		stream.registry = registry
+	}
+	core.Register(stream)
+}
+
+// TunnelRoutingEnabled returns whether the stream requests are routed between the CloudCore replicas
+func TunnelRoutingEnabled(c *v1alpha1.CloudStream) bool {
+	return c != nil && c.TunnelRouting != nil && c.TunnelRouting.Enable
+}
+
+func newTunnelRegistryForConfig(c *v1alpha1.CloudStream) (*tunnelRegistry, error) {
+	hostname := util.GetHostname()
+	address := c.TunnelRouting.AdvertiseAddress
+	if address == "" {
+		var err error
+		if address, err = util.GetLocalIP(hostname); err != nil {
+			return nil, fmt.Errorf("failed to get the advertise address: %v", err)
+		}
+	}
+	leaseDuration := time.Duration(c.TunnelRouting.LeaseDurationSeconds) * time.Second
+	if leaseDuration == 0 {
+		leaseDuration = DefaultTunnelLeaseDurationSeconds * time.Second
+	}
+	transport, err := newStreamTransport(c)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := informers.GetInformersManager().EdgeNode().GetStore()
+	return newTunnelRegistry(hostname, streamAddress(address, c.StreamPort), leaseDuration, client.GetKubeClient(), nodes, transport), nil
 }
 
 func (s *cloudStream) Name() string {
@@ -56,6 +102,10 @@ func (s *cloudStream) Start() {
 	ok := <-cloudhub.DoneTLSTunnelCerts
 	if ok {
 		ts := newTunnelServer(s.tunnelPort)
+		if s.registry != nil {
+			ts.registry = s.registry
+			go s.registry.run(beehiveContext.GetContext())
+		}
 
 		// start new tunnel server
 		go ts.Start()
@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudstream
+
+import (
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// egressDialTimeout is the timeout of dialing the targets of the egress connections
+const egressDialTimeout = 10 * time.Second
+
+// egressProxy serves the HTTP CONNECT requests of the kube-apiserver whose egress to the cluster goes
+// through CloudCore, so that the stream requests to the kubelet endpoint of the edge nodes reach the
+// stream server without the tunnel port iptables rules. The connections to the edge nodes are passed to
+// the local stream server, which proxies them to the replica holding the tunnel if necessary, and the
+// connections to the other targets are dialed directly.
+type egressProxy struct {
+	registry *tunnelRegistry
+	// streamAddress is the address of the local stream server
+	streamAddress string
+	// kubeletPort is the kubelet endpoint port of the edge nodes, which is the stream port
+	kubeletPort string
+	// authenticate returns whether the request is sent by the kube-apiserver
+	authenticate func(r *http.Request) bool
+	// next serves the requests other than CONNECT
+	next http.Handler
+}
+
+func newEgressProxy(registry *tunnelRegistry, streamPort uint32, clientCAs *x509.CertPool, next http.Handler) *egressProxy {
+	port := strconv.Itoa(int(streamPort))
+	return &egressProxy{
+		registry:      registry,
+		streamAddress: net.JoinHostPort("127.0.0.1", port),
+		kubeletPort:   port,
+		authenticate: func(r *http.Request) bool {
+			return verifyClientCertificate(r, clientCAs)
+		},
+		next: next,
+	}
+}
+
+func (p *egressProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodConnect {
+		p.next.ServeHTTP(w, r)
+		return
+	}
+	if !p.authenticate(r) {
+		http.Error(w, "egress connection requires a verified client certificate", http.StatusUnauthorized)
+		return
+	}
+
+	address := p.dialAddress(r.Host)
+	backend, err := net.DialTimeout("tcp", address, egressDialTimeout)
+	if err != nil {
+		klog.Warningf("failed to dial egress target %s: %v", r.Host, err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		backend.Close()
+		http.Error(w, "egress connection can't be hijacked", http.StatusInternalServerError)
+		return
+	}
+	conn, buffered, err := hijacker.Hijack()
+	if err != nil {
+		backend.Close()
+		klog.Errorf("failed to hijack egress connection to %s: %v", r.Host, err)
+		return
+	}
+	defer conn.Close()
+	defer backend.Close()
+	if _, err := conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n")); err != nil {
+		klog.Warningf("failed to establish egress connection to %s: %v", r.Host, err)
+		return
+	}
+
+	klog.V(4).Infof("egress connection to %s is established through %s", r.Host, address)
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(backend, buffered.Reader)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(conn, backend)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+// dialAddress returns the address to dial for the egress target, the kubelet endpoint of the edge
+// nodes is served by the local stream server
+func (p *egressProxy) dialAddress(target string) string {
+	host, port, err := net.SplitHostPort(target)
+	if err != nil || port != p.kubeletPort {
+		return target
+	}
+	if _, err := p.registry.nodeNameByIP(host); err != nil {
+		return target
+	}
+	return p.streamAddress
+}
+
+// verifyClientCertificate returns whether the client certificate of the request is issued by the CAs
+func verifyClientCertificate(r *http.Request, clientCAs *x509.CertPool) bool {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return false
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range r.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := r.TLS.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         clientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err == nil
+}
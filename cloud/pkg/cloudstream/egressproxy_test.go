@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudstream
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// connect sends a CONNECT request of the target to the proxy, then a GET request through the connection
+func connect(t *testing.T, proxyAddress, target string) (int, string) {
+	conn, err := net.Dial("tcp", proxyAddress)
+	assert.NoError(t, err)
+	defer conn.Close()
+	_, err = fmt.Fprintf(conn, "CONNECT %s HTTP/1.1\r\nHost: %s\r\n\r\n", target, target)
+	assert.NoError(t, err)
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, &http.Request{Method: http.MethodConnect})
+	assert.NoError(t, err)
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, ""
+	}
+
+	_, err = fmt.Fprintf(conn, "GET /containerLogs/default/pod/container HTTP/1.1\r\nHost: %s\r\n\r\n", target)
+	assert.NoError(t, err)
+	resp, err = http.ReadResponse(reader, nil)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestEgressProxy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "stream "+r.Host)
+	}))
+	defer stream.Close()
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "other")
+	}))
+	defer other.Close()
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: testNodeName},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "192.168.1.10"}},
+		},
+	}
+	registry := newTestTunnelRegistry(t, ctx, fake.NewSimpleClientset(), "replica-a", "10.0.0.1:10003", node)
+	var authenticated atomic.Bool
+	authenticated.Store(true)
+	proxy := &egressProxy{
+		registry:      registry,
+		streamAddress: strings.TrimPrefix(stream.URL, "http://"),
+		kubeletPort:   "10003",
+		authenticate: func(r *http.Request) bool {
+			return authenticated.Load()
+		},
+		next: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, "container")
+		}),
+	}
+	server := httptest.NewServer(proxy)
+	defer server.Close()
+	proxyAddress := strings.TrimPrefix(server.URL, "http://")
+
+	// the kubelet endpoint of the edge node is served by the local stream server
+	code, body := connect(t, proxyAddress, "192.168.1.10:10003")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "stream 192.168.1.10:10003", body)
+
+	// the other targets are dialed directly
+	code, body = connect(t, proxyAddress, strings.TrimPrefix(other.URL, "http://"))
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "other", body)
+
+	// the requests other than CONNECT are served by the container
+	resp, err := http.Get(server.URL + "/containerLogs/default/pod/container")
+	assert.NoError(t, err)
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, "container", string(data))
+
+	authenticated.Store(false)
+	code, _ = connect(t, proxyAddress, "192.168.1.10:10003")
+	assert.Equal(t, http.StatusUnauthorized, code)
+}
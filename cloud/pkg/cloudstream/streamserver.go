@@ -22,6 +22,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"net/http"
+	"net/http/httputil"
 	"os"
 	"reflect"
 	"strings"
@@ -129,6 +130,9 @@ func (s *StreamServer) getContainerLogs(r *restful.Request, w *restful.Response)
 
 	session, ok := s.tunnel.getSession(sessionKey)
 	if !ok {
+		if s.proxyToTunnelHolder(w.ResponseWriter, r.Request, sessionKey) {
+			return
+		}
 		err = fmt.Errorf("can not find %v session ", sessionKey)
 		return
 	}
@@ -189,6 +193,9 @@ func (s *StreamServer) getMetrics(r *restful.Request, w *restful.Response) {
 	}
 	session, ok := s.tunnel.getSession(sessionKey)
 	if !ok {
+		if s.proxyToTunnelHolder(w.ResponseWriter, r.Request, sessionKey) {
+			return
+		}
 		err = fmt.Errorf("can not find %v session ", sessionKey)
 		return
 	}
@@ -238,6 +245,9 @@ func (s *StreamServer) getExec(request *restful.Request, response *restful.Respo
 	}
 	session, ok := s.tunnel.getSession(sessionKey)
 	if !ok {
+		if s.proxyToTunnelHolder(response.ResponseWriter, request.Request, sessionKey) {
+			return
+		}
 		err = fmt.Errorf("exec: can not find %v session ", sessionKey)
 		return
 	}
@@ -308,6 +318,9 @@ func (s *StreamServer) getAttach(request *restful.Request, response *restful.Res
 	}
 	session, ok := s.tunnel.getSession(sessionKey)
 	if !ok {
+		if s.proxyToTunnelHolder(response.ResponseWriter, request.Request, sessionKey) {
+			return
+		}
 		err = fmt.Errorf("attach: can not find %v session ", sessionKey)
 		return
 	}
@@ -362,6 +375,41 @@ func (s *StreamServer) getAttach(request *restful.Request, response *restful.Res
 	}
 }
 
+// proxyToTunnelHolder proxies the request to the replica holding the tunnel of the node if tunnel routing
+// is enabled, it returns false if the request isn't proxied
+func (s *StreamServer) proxyToTunnelHolder(w http.ResponseWriter, r *http.Request, sessionKey string) bool {
+	registry := s.tunnel.registry
+	if registry == nil {
+		return false
+	}
+	if by := r.Header.Get(forwardedByHeader); by != "" {
+		klog.Warningf("stream request of %s proxied by replica %s can't be proxied again", sessionKey, by)
+		return false
+	}
+	address, err := registry.resolve(sessionKey)
+	if err != nil {
+		klog.Warningf("failed to find the replica holding the tunnel of %s: %v", sessionKey, err)
+		return false
+	}
+
+	klog.V(4).Infof("proxy stream request %s of %s to replica %s", r.URL.Path, sessionKey, address)
+	proxy := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = "https"
+			req.URL.Host = address
+			req.Header.Set(forwardedByHeader, registry.identity)
+		},
+		Transport:     registry.transport,
+		FlushInterval: -1,
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			klog.Errorf("failed to proxy stream request of %s to replica %s: %v", sessionKey, address, err)
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+	proxy.ServeHTTP(w, r)
+	return true
+}
+
 func (s *StreamServer) getSessionKey(urlPath string) (string, error) {
 	// extract pod namespace and pod name from request
 	meta := strings.Split(urlPath, "/")
@@ -394,9 +442,14 @@ func (s *StreamServer) Start() {
 	}
 	pool.AppendCertsFromPEM(data)
 
+	var handler http.Handler = s.container
+	if s.tunnel.registry != nil {
+		// the kube-apiserver reaches the kubelet endpoint of the edge nodes through the egress proxy
+		handler = newEgressProxy(s.tunnel.registry, config.Config.StreamPort, pool, s.container)
+	}
 	streamServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", config.Config.StreamPort),
-		Handler: s.container,
+		Handler: handler,
 		TLSConfig: &tls.Config{
 			ClientCAs: pool,
 			// Populate PeerCertificates in requests, but don't reject connections without verified certificates
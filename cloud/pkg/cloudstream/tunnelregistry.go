@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudstream
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	coordinationclientv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	coordinationlisters "k8s.io/client-go/listers/coordination/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeedge/api/apis/componentconfig/cloudcore/v1alpha1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/replicas"
+	"github.com/kubeedge/kubeedge/common/constants"
+)
+
+const (
+	// DefaultTunnelLeaseDurationSeconds is the default duration of the Leases registering the replicas
+	DefaultTunnelLeaseDurationSeconds = 40
+
+	// replicaLeasePrefix is the name prefix of the Leases registering the stream servers of the replicas
+	replicaLeasePrefix = "cloudstream-replica-"
+	// tunnelLeasePrefix is the name prefix of the Leases registering the replicas holding the tunnels of the nodes
+	tunnelLeasePrefix = "cloudstream-tunnel-"
+
+	// forwardedByHeader is set in the stream requests proxied between the replicas, a proxied request
+	// isn't proxied again even if the replica doesn't hold the tunnel of the node anymore
+	forwardedByHeader = "X-KubeEdge-Stream-Forwarded-By"
+)
+
+// tunnelRegistry records the replica holding the tunnel of every edge node in Leases, so that a stream
+// request received by any replica can be proxied to the replica holding the tunnel of the node. Every
+// replica renews a Lease holding the address of its stream server, and takes the Lease of a node when
+// the tunnel of the node connects to it.
+type tunnelRegistry struct {
+	identity      string
+	address       string
+	leaseDuration time.Duration
+	leases        coordinationclientv1.LeaseInterface
+	informer      cache.SharedIndexInformer
+	lister        coordinationlisters.LeaseNamespaceLister
+	// nodes is the store of the edge nodes, used to find the node of the stream requests addressed by IP
+	nodes     cache.Store
+	transport http.RoundTripper
+}
+
+func newTunnelRegistry(identity, address string, leaseDuration time.Duration,
+	kubeClient kubernetes.Interface, nodes cache.Store, transport http.RoundTripper) *tunnelRegistry {
+	informer, lister := replicas.NewLeaseInformer(kubeClient)
+	return &tunnelRegistry{
+		identity:      strings.ToLower(identity),
+		address:       address,
+		leaseDuration: leaseDuration,
+		leases:        kubeClient.CoordinationV1().Leases(constants.SystemNamespace),
+		informer:      informer,
+		lister:        lister,
+		nodes:         nodes,
+		transport:     transport,
+	}
+}
+
+// newStreamTransport returns the transport proxying the stream requests to the other replicas, which
+// presents the stream certificate of this replica.
+func newStreamTransport(c *v1alpha1.CloudStream) (http.RoundTripper, error) {
+	certificate, err := tls.LoadX509KeyPair(c.TLSStreamCertFile, c.TLSStreamPrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load stream certificate: %v", err)
+	}
+	ca, err := os.ReadFile(c.TLSStreamCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stream ca file: %v", err)
+	}
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(ca)
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = replicas.NewTLSConfig(pool, certificate)
+	return transport, nil
+}
+
+// run watches the Leases of the tunnels, and renews the Lease of this replica until ctx is done
+func (r *tunnelRegistry) run(ctx context.Context) {
+	go r.informer.Run(ctx.Done())
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := replicas.RenewLease(ctx, r.leases, replicaLeasePrefix+r.identity, r.address, r.leaseDuration); err != nil {
+			klog.Errorf("failed to renew the stream lease of replica %s: %v", r.identity, err)
+		}
+	}, r.leaseDuration/4)
+}
+
+// register takes the Lease of the node whose tunnel connects to this replica
+func (r *tunnelRegistry) register(ctx context.Context, nodeName string) error {
+	return replicas.RenewLease(ctx, r.leases, tunnelLeasePrefix+nodeName, r.identity, r.leaseDuration)
+}
+
+// release deletes the Lease of the node whose tunnel disconnects from this replica, the Lease
+// is kept if the tunnel has connected to another replica
+func (r *tunnelRegistry) release(ctx context.Context, nodeName string) error {
+	return replicas.ReleaseLease(ctx, r.leases, tunnelLeasePrefix+nodeName, r.identity)
+}
+
+// resolve returns the stream server address of the replica holding the tunnel of the node,
+// the node is identified by its name or IP as the session keys of the tunnel server
+func (r *tunnelRegistry) resolve(sessionKey string) (string, error) {
+	if !r.informer.HasSynced() {
+		return "", fmt.Errorf("tunnel leases are not synced")
+	}
+	nodeName := sessionKey
+	if net.ParseIP(sessionKey) != nil {
+		var err error
+		if nodeName, err = r.nodeNameByIP(sessionKey); err != nil {
+			return "", err
+		}
+	}
+
+	tunnelLease, err := r.lister.Get(tunnelLeasePrefix + nodeName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the tunnel lease of node %s: %v", nodeName, err)
+	}
+	holder := ptr.Deref(tunnelLease.Spec.HolderIdentity, "")
+	if holder == "" || holder == r.identity {
+		return "", fmt.Errorf("tunnel of node %s is not held by other replicas", nodeName)
+	}
+
+	replicaLease, err := r.lister.Get(replicaLeasePrefix + holder)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the stream lease of replica %s: %v", holder, err)
+	}
+	if replicas.LeaseExpired(replicaLease, time.Now()) {
+		return "", fmt.Errorf("replica %s holding the tunnel of node %s is gone", holder, nodeName)
+	}
+	return ptr.Deref(replicaLease.Spec.HolderIdentity, ""), nil
+}
+
+func (r *tunnelRegistry) nodeNameByIP(ip string) (string, error) {
+	for _, obj := range r.nodes.List() {
+		node, ok := obj.(*v1.Node)
+		if !ok {
+			continue
+		}
+		for _, address := range node.Status.Addresses {
+			if address.Address == ip {
+				return node.Name, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("node with IP %s is not found", ip)
+}
+
+// streamAddress returns the address the other replicas use to reach the stream server of this replica
+func streamAddress(advertiseAddress string, streamPort uint32) string {
+	return net.JoinHostPort(advertiseAddress, strconv.Itoa(int(streamPort)))
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudstream
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/replicas"
+	"github.com/kubeedge/kubeedge/common/constants"
+)
+
+// newTestTunnelRegistry returns a registry whose lease informer is synced, without renewing the Lease of the replica
+func newTestTunnelRegistry(t *testing.T, ctx context.Context, client *fake.Clientset, identity, address string,
+	nodes ...*corev1.Node) *tunnelRegistry {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, node := range nodes {
+		_ = store.Add(node)
+	}
+	registry := newTunnelRegistry(identity, address, 40*time.Second, client, store, http.DefaultTransport)
+	go registry.informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), registry.informer.HasSynced) {
+		t.Fatalf("failed to sync the lease informer")
+	}
+	return registry
+}
+
+// waitResolve waits until the informer of the registry observes the expected address of the session
+func waitResolve(t *testing.T, r *tunnelRegistry, sessionKey, expected string) {
+	var address string
+	var err error
+	_ = wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 5*time.Second, true,
+		func(context.Context) (bool, error) {
+			address, err = r.resolve(sessionKey)
+			return address == expected, nil
+		})
+	assert.Equal(t, expected, address, "resolve error: %v", err)
+}
+
+func TestTunnelRegistryResolve(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := fake.NewSimpleClientset()
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: testNodeName},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "192.168.1.10"}},
+		},
+	}
+	replicaA := newTestTunnelRegistry(t, ctx, client, "Replica-A", "10.0.0.1:10003", node)
+	replicaB := newTestTunnelRegistry(t, ctx, client, "replica-b", "10.0.0.2:10003", node)
+
+	// the tunnel of the node isn't held by any replica
+	_, err := replicaB.resolve(testNodeName)
+	assert.Error(t, err)
+
+	assert.NoError(t, replicas.RenewLease(ctx, replicaA.leases, replicaLeasePrefix+replicaA.identity, replicaA.address, time.Minute))
+	assert.NoError(t, replicaA.register(ctx, testNodeName))
+
+	waitResolve(t, replicaB, testNodeName, "10.0.0.1:10003")
+	waitResolve(t, replicaB, "192.168.1.10", "10.0.0.1:10003")
+
+	_, err = replicaB.resolve("192.168.1.11")
+	assert.Error(t, err)
+
+	// the replica holding the tunnel doesn't proxy to itself
+	_, err = replicaA.resolve(testNodeName)
+	assert.Error(t, err)
+
+	// the tunnel reconnects to the other replica
+	assert.NoError(t, replicaB.register(ctx, testNodeName))
+	lease, err := client.CoordinationV1().Leases(constants.SystemNamespace).
+		Get(ctx, tunnelLeasePrefix+testNodeName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "replica-b", ptr.Deref(lease.Spec.HolderIdentity, ""))
+	waitResolve(t, replicaB, testNodeName, "")
+
+	// replica a doesn't release the tunnel connected to replica b
+	assert.NoError(t, replicaA.release(ctx, testNodeName))
+	_, err = client.CoordinationV1().Leases(constants.SystemNamespace).
+		Get(ctx, tunnelLeasePrefix+testNodeName, metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, replicaB.release(ctx, testNodeName))
+	_, err = client.CoordinationV1().Leases(constants.SystemNamespace).
+		Get(ctx, tunnelLeasePrefix+testNodeName, metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestTunnelRegistryResolveExpiredReplica(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := fake.NewSimpleClientset()
+	replica := newTestTunnelRegistry(t, ctx, client, "replica-b", "10.0.0.2:10003")
+
+	renewTime := metav1.NewMicroTime(time.Now().Add(-time.Minute))
+	_, err := client.CoordinationV1().Leases(constants.SystemNamespace).Create(ctx, &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: replicaLeasePrefix + "replica-a", Namespace: constants.SystemNamespace},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To("10.0.0.1:10003"),
+			LeaseDurationSeconds: ptr.To(int32(40)),
+			RenewTime:            &renewTime,
+		},
+	}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	_, err = client.CoordinationV1().Leases(constants.SystemNamespace).Create(ctx, &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: tunnelLeasePrefix + testNodeName, Namespace: constants.SystemNamespace},
+		Spec:       coordinationv1.LeaseSpec{HolderIdentity: ptr.To("replica-a")},
+	}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		_, err = replica.resolve(testNodeName)
+		return err != nil && strings.Contains(err.Error(), "is gone")
+	}, 5*time.Second, 10*time.Millisecond, "resolve error: %v", err)
+}
+
+func TestProxyToTunnelHolder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var forwardedBy string
+	holder := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwardedBy = r.Header.Get(forwardedByHeader)
+		_, _ = io.WriteString(w, "logs of "+r.URL.Path)
+	}))
+	defer holder.Close()
+
+	client := fake.NewSimpleClientset()
+	holderRegistry := newTestTunnelRegistry(t, ctx, client, "replica-a", strings.TrimPrefix(holder.URL, "https://"))
+	assert.NoError(t, replicas.RenewLease(ctx, holderRegistry.leases,
+		replicaLeasePrefix+holderRegistry.identity, holderRegistry.address, time.Minute))
+	assert.NoError(t, holderRegistry.register(ctx, testNodeName))
+
+	registry := newTestTunnelRegistry(t, ctx, client, "replica-b", "10.0.0.2:10003")
+	waitResolve(t, registry, testNodeName, holderRegistry.address)
+	registry.transport = holder.Client().Transport
+	ts := newTunnelServer(testTunnelPort)
+	ts.registry = registry
+	s := newStreamServer(ts)
+
+	r := httptest.NewRequest(http.MethodGet, "/containerLogs/default/pod/container", nil)
+	w := httptest.NewRecorder()
+	assert.True(t, s.proxyToTunnelHolder(w, r, testNodeName))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "logs of /containerLogs/default/pod/container", w.Body.String())
+	assert.Equal(t, "replica-b", forwardedBy)
+
+	// a proxied request isn't proxied again
+	r.Header.Set(forwardedByHeader, "replica-c")
+	assert.False(t, s.proxyToTunnelHolder(httptest.NewRecorder(), r, testNodeName))
+
+	// the request isn't proxied without tunnel routing
+	s = newStreamServer(newTunnelServer(testTunnelPort))
+	assert.False(t, s.proxyToTunnelHolder(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil), testNodeName))
+}
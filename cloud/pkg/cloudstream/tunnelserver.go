@@ -57,6 +57,8 @@ type TunnelServer struct {
 	kubeClient    v1.CoreV1Interface
 	retrySleep    time.Duration
 	updateTimeout time.Duration
+	// registry registers the tunnels held by this replica, it's nil if tunnel routing is disabled
+	registry *tunnelRegistry
 }
 
 func newTunnelServer(tunnelPort int) *TunnelServer {
@@ -155,10 +157,24 @@ func (s *TunnelServer) connect(r *restful.Request, w *restful.Response) {
 		}
 		return
 	}
+	if s.registry != nil {
+		if err := s.registry.register(context.Background(), hostNameOverride); err != nil {
+			klog.Errorf("failed to register the tunnel of node %s: %v", hostNameOverride, err)
+		}
+	}
 	s.addSession(hostNameOverride, session)
 	s.addSession(internalIP, session)
 	s.addNodeIP(hostNameOverride, internalIP)
 	session.Serve()
+
+	// the tunnel may have reconnected to this replica when the session ends
+	if s.registry != nil {
+		if current, ok := s.getSession(hostNameOverride); ok && current == session {
+			if err := s.registry.release(context.Background(), hostNameOverride); err != nil {
+				klog.Errorf("failed to release the tunnel of node %s: %v", hostNameOverride, err)
+			}
+		}
+	}
 }
 
 func (s *TunnelServer) Start() {
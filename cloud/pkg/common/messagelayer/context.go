@@ -23,6 +23,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
 	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/pkg/tracing"
 )
 
 // MessageLayer define all functions that message layer must implement
@@ -51,6 +52,8 @@ func (cml *ContextMessageLayer) Send(message model.Message) error {
 	if len(cml.SendRouterModuleName) != 0 && isRouterMsg(message) {
 		module = cml.SendRouterModuleName
 	}
+	span := tracing.StartSpan(&message, message.GetSource())
+	defer span.End()
 	beehiveContext.Send(module, message)
 	return nil
 }
@@ -43,6 +43,9 @@ const (
 	ResourceTypeTwinEdgeUpdated  = "twin/edge_updated"
 	ResourceTypeMembershipDetail = "membership/detail"
 	ResourceDeviceStateUpdated   = "state/update"
+	ResourceDeviceAlarmUpdated   = "alarm/update"
+	ResourceDeviceDiscovered     = "discovered/report"
+	ResourceMapperStatus         = "mapper/status"
 )
 
 // BuildResource return a string as "beehive/pkg/core/model".Message.Router.Resource
@@ -146,6 +149,12 @@ func GetResourceTypeForDevice(resource string) (string, error) {
 		return ResourceTypeMembershipDetail, nil
 	} else if strings.Contains(resource, ResourceDeviceStateUpdated) {
 		return ResourceDeviceStateUpdated, nil
+	} else if strings.Contains(resource, ResourceDeviceAlarmUpdated) {
+		return ResourceDeviceAlarmUpdated, nil
+	} else if strings.Contains(resource, ResourceDeviceDiscovered) {
+		return ResourceDeviceDiscovered, nil
+	} else if strings.Contains(resource, ResourceMapperStatus) {
+		return ResourceMapperStatus, nil
 	}
 	return "", fmt.Errorf("unknown resource, found: %s", resource)
 }
@@ -188,6 +188,30 @@ func TestGetResourceTypeForDevice(t *testing.T) {
 			ResourceTypeMembershipDetail,
 			nil,
 		},
+		{
+			"GetResourceTypeForDevice() ResourceDeviceAlarmUpdated: success",
+			args{
+				resource: fmt.Sprintf("node/%s/device/%s/%s/%s", "nid", "default", "sensor", ResourceDeviceAlarmUpdated),
+			},
+			ResourceDeviceAlarmUpdated,
+			nil,
+		},
+		{
+			"GetResourceTypeForDevice() ResourceDeviceDiscovered: success",
+			args{
+				resource: fmt.Sprintf("node/%s/device/%s", "nid", ResourceDeviceDiscovered),
+			},
+			ResourceDeviceDiscovered,
+			nil,
+		},
+		{
+			"GetResourceTypeForDevice() ResourceMapperStatus: success",
+			args{
+				resource: fmt.Sprintf("node/%s/device/%s", "nid", ResourceMapperStatus),
+			},
+			ResourceMapperStatus,
+			nil,
+		},
 		{
 			"GetResourceTypeForDevice() Case 2: no resourceType",
 			args{
@@ -38,6 +38,18 @@ const (
 	CloudHubSubsystem = "CloudHub"
 )
 
+const (
+	// QueueAck is the queue of the messages that require acknowledgement from edge node
+	QueueAck = "ack"
+	// QueueNoAck is the queue of the messages that do not require acknowledgement from edge node
+	QueueNoAck = "noack"
+
+	// DropReasonOutdated means the message is older than the message that has been sent or queued
+	DropReasonOutdated = "outdated"
+	// DropReasonSendFailed means the message that does not require acknowledgement failed to be sent
+	DropReasonSendFailed = "send_failed"
+)
+
 var (
 	ConnectedNodes = prometheus.NewGauge(
 		prometheus.GaugeOpts{
@@ -47,8 +59,65 @@ var (
 			Help:      "Number of nodes that connected to the cloudHub instance",
 		},
 	)
+	MessageAckDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "message_ack_duration_seconds",
+			Help:      "Latency between the first sending of a message and its acknowledgement by the edge node",
+			Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 25},
+		},
+		[]string{"node"},
+	)
+	MessageRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "message_retries_total",
+			Help:      "Number of times a message was sent again to the edge node because no acknowledgement was received",
+		},
+		[]string{"node"},
+	)
+	MessageDrops = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "message_drops_total",
+			Help:      "Number of messages to the edge node that were dropped by reason",
+		},
+		[]string{"node", "reason"},
+	)
+	KeepaliveMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "keepalive_misses_total",
+			Help:      "Number of times no keepalive message was received from the edge node within the keepalive interval",
+		},
+		[]string{"node"},
+	)
+
+	NodeQueueLength = prometheus.NewDesc(
+		prometheus.BuildFQName(metricNamespace, CloudHubSubsystem, "node_queue_length"),
+		"Number of messages queued to be sent to the edge node by queue",
+		[]string{"node", "queue"}, nil,
+	)
+	NodePendingAcks = prometheus.NewDesc(
+		prometheus.BuildFQName(metricNamespace, CloudHubSubsystem, "node_pending_acks"),
+		"Number of messages sent to the edge node that are waiting for acknowledgement",
+		[]string{"node"}, nil,
+	)
 )
 
+// DeleteNodeMetrics deletes the metrics labeled with the node when its session is closed
+func DeleteNodeMetrics(nodeID string) {
+	labels := prometheus.Labels{"node": nodeID}
+	MessageAckDuration.DeletePartialMatch(labels)
+	MessageRetries.DeletePartialMatch(labels)
+	MessageDrops.DeletePartialMatch(labels)
+	KeepaliveMisses.DeletePartialMatch(labels)
+}
+
 var registerOnce sync.Once
 
 // registerMetrics register all metrics.
@@ -56,6 +125,10 @@ func registerMetrics() {
 	registerOnce.Do(func() {
 		prometheus.MustRegister(
 			ConnectedNodes,
+			MessageAckDuration,
+			MessageRetries,
+			MessageDrops,
+			KeepaliveMisses,
 		)
 	})
 }
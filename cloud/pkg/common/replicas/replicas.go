@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replicas provides the helpers shared by the modules routing requests between the
+// CloudCore replicas. The replicas register themselves and the edge nodes connected to them
+// in Leases of the KubeEdge system namespace.
+package replicas
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationinformers "k8s.io/client-go/informers/coordination/v1"
+	"k8s.io/client-go/kubernetes"
+	coordinationclientv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	coordinationlisters "k8s.io/client-go/listers/coordination/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeedge/kubeedge/common/constants"
+)
+
+// NewLeaseInformer returns the informer and the lister of the Leases in the KubeEdge system namespace
+func NewLeaseInformer(kubeClient kubernetes.Interface) (cache.SharedIndexInformer, coordinationlisters.LeaseNamespaceLister) {
+	informer := coordinationinformers.NewLeaseInformer(kubeClient, constants.SystemNamespace, 0,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	return informer, coordinationlisters.NewLeaseLister(informer.GetIndexer()).Leases(constants.SystemNamespace)
+}
+
+// RenewLease renews the Lease held by holder, the Lease is created or taken over if necessary
+func RenewLease(ctx context.Context, leases coordinationclientv1.LeaseInterface, name, holder string, duration time.Duration) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		now := metav1.NewMicroTime(time.Now())
+		lease, err := leases.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err = leases.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: constants.SystemNamespace,
+				},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To(holder),
+					LeaseDurationSeconds: ptr.To(int32(duration.Seconds())),
+					AcquireTime:          &now,
+					RenewTime:            &now,
+				},
+			}, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if ptr.Deref(lease.Spec.HolderIdentity, "") != holder {
+			lease.Spec.HolderIdentity = ptr.To(holder)
+			lease.Spec.AcquireTime = &now
+		}
+		lease.Spec.LeaseDurationSeconds = ptr.To(int32(duration.Seconds()))
+		lease.Spec.RenewTime = &now
+		_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// ReleaseLease deletes the Lease if it's still held by holder. The Lease is kept if
+// it has been taken over by another holder.
+func ReleaseLease(ctx context.Context, leases coordinationclientv1.LeaseInterface, name, holder string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		lease, err := leases.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if ptr.Deref(lease.Spec.HolderIdentity, "") != holder {
+			return nil
+		}
+		return DeleteLease(ctx, leases, lease)
+	})
+}
+
+// DeleteLease deletes the Lease if it isn't changed since it was got, a Conflict error
+// is returned if it's changed
+func DeleteLease(ctx context.Context, leases coordinationclientv1.LeaseInterface, lease *coordinationv1.Lease) error {
+	err := leases.Delete(ctx, lease.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &lease.UID, ResourceVersion: &lease.ResourceVersion},
+	})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// LeaseExpired returns whether the Lease isn't renewed in its duration
+func LeaseExpired(lease *coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	duration := time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	return lease.Spec.RenewTime.Add(duration).Before(now)
+}
+
+// NewTLSConfig returns the TLS config of the clients connecting to the other replicas. The replicas are
+// addressed by the advertised IPs which may not be in the SANs of their certificates, so only the
+// certificate chain is verified against roots.
+func NewTLSConfig(roots *x509.CertPool, certificates ...tls.Certificate) *tls.Config {
+	return &tls.Config{
+		Certificates: certificates,
+		MinVersion:   tls.VersionTLS12,
+		// the host name isn't verified, VerifyConnection verifies the certificate chain
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return fmt.Errorf("the replica presents no certificate")
+			}
+			intermediates := x509.NewCertPool()
+			for _, cert := range state.PeerCertificates[1:] {
+				intermediates.AddCert(cert)
+			}
+			_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+				Roots:         roots,
+				Intermediates: intermediates,
+			})
+			return err
+		},
+	}
+}
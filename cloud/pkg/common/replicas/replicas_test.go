@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replicas
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeedge/kubeedge/common/constants"
+)
+
+func TestRenewAndReleaseLease(t *testing.T) {
+	ctx := context.Background()
+	leases := fake.NewSimpleClientset().CoordinationV1().Leases(constants.SystemNamespace)
+
+	assert.NoError(t, RenewLease(ctx, leases, "lease", "replica-a", 40*time.Second))
+	lease, err := leases.Get(ctx, "lease", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "replica-a", ptr.Deref(lease.Spec.HolderIdentity, ""))
+	assert.Equal(t, int32(40), ptr.Deref(lease.Spec.LeaseDurationSeconds, 0))
+
+	// the lease is taken over by another holder
+	assert.NoError(t, RenewLease(ctx, leases, "lease", "replica-b", 40*time.Second))
+	lease, err = leases.Get(ctx, "lease", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "replica-b", ptr.Deref(lease.Spec.HolderIdentity, ""))
+
+	// the lease taken over isn't released by the previous holder
+	assert.NoError(t, ReleaseLease(ctx, leases, "lease", "replica-a"))
+	_, err = leases.Get(ctx, "lease", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, ReleaseLease(ctx, leases, "lease", "replica-b"))
+	_, err = leases.Get(ctx, "lease", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// releasing a deleted lease succeeds
+	assert.NoError(t, ReleaseLease(ctx, leases, "lease", "replica-b"))
+}
+
+func TestLeaseExpired(t *testing.T) {
+	now := time.Now()
+	renewTime := metav1.NewMicroTime(now.Add(-30 * time.Second))
+
+	assert.True(t, LeaseExpired(&coordinationv1.Lease{}, now))
+	assert.False(t, LeaseExpired(&coordinationv1.Lease{
+		Spec: coordinationv1.LeaseSpec{RenewTime: &renewTime, LeaseDurationSeconds: ptr.To(int32(40))},
+	}, now))
+	assert.True(t, LeaseExpired(&coordinationv1.Lease{
+		Spec: coordinationv1.LeaseSpec{RenewTime: &renewTime, LeaseDurationSeconds: ptr.To(int32(20))},
+	}, now))
+}
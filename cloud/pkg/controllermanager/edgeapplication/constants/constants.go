@@ -5,6 +5,7 @@ import "k8s.io/apimachinery/pkg/runtime/schema"
 const (
 	LastAppliedTemplateAnnotationKey    = "apps.kubeedge.io/last-applied-template"
 	LastContainedResourcesAnnotationKey = "apps.kubeedge.io/last-contained-resources"
+	StableWorkloadTemplateAnnotationKey = "apps.kubeedge.io/stable-workload-template"
 )
 
 var OverriderTargetGVK = map[schema.GroupVersionKind]struct{}{
@@ -17,6 +17,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/pointer"
 	controllerruntime "sigs.k8s.io/controller-runtime"
@@ -40,25 +42,46 @@ type Controller struct {
 	runtime.Serializer
 	overridemanager.Overrider
 	statusmanager.StatusManager
+	// GenericOverrider applies overrides to manifests whose kinds are not
+	// overridden by default but are selected by JSONPatchOverriders.
+	GenericOverrider     overridemanager.Overrider
 	UseServerSideApply   bool
 	ReconcileTriggerChan chan event.GenericEvent
+	// Recorder records events of EdgeApplications, such as failover of node groups.
+	Recorder record.EventRecorder
 }
 
 func NewController(ctx context.Context, cli client.Client, serializer runtime.Serializer, mgr manager.Manager) *Controller {
 	return &Controller{
 		Client:        cli,
 		Serializer:    serializer,
+		Recorder:      mgr.GetEventRecorderFor("edgeapplication-controller"),
 		StatusManager: statusmanager.NewStatusManager(ctx, mgr, cli, serializer),
 		Overrider: &overridemanager.OverrideManager{
 			Overriders: []overridemanager.Overrider{
 				&overridemanager.NameOverrider{},
 				&overridemanager.ReplicasOverrider{},
 				&overridemanager.ImageOverrider{},
+				&overridemanager.AffinityOverrider{},
 				&overridemanager.NodeSelectorOverrider{},
 				&overridemanager.CommandOverrider{},
 				&overridemanager.ArgsOverrider{},
 				&overridemanager.EnvOverrider{},
 				&overridemanager.ResourcesOverrider{},
+				&overridemanager.VolumeOverrider{},
+				&overridemanager.TolerationOverrider{},
+				&overridemanager.LabelsOverrider{},
+				&overridemanager.AnnotationsOverrider{},
+				&overridemanager.ReferenceOverrider{},
+				&overridemanager.JSONPatchOverrider{},
+			},
+		},
+		GenericOverrider: &overridemanager.OverrideManager{
+			Overriders: []overridemanager.Overrider{
+				&overridemanager.NameOverrider{},
+				&overridemanager.LabelsOverrider{},
+				&overridemanager.AnnotationsOverrider{},
+				&overridemanager.JSONPatchOverrider{},
 			},
 		},
 	}
@@ -104,6 +127,9 @@ func (c *Controller) SetupWithManager(_ctx context.Context, mgr controllerruntim
 	if c.Overrider == nil {
 		return fmt.Errorf("overrider of edgeapplication controller cannot be nil")
 	}
+	if c.GenericOverrider == nil {
+		return fmt.Errorf("generic overrider of edgeapplication controller cannot be nil")
+	}
 	c.ReconcileTriggerChan = make(chan event.GenericEvent)
 	c.StatusManager.SetReconcileTriggerChan(c.ReconcileTriggerChan)
 	// start the StatusManager
@@ -129,6 +155,26 @@ func (c *Controller) syncEdgeApplication(ctx context.Context, edgeApp *appsv1alp
 		klog.Errorf("failed to get all templates from edgeapp %s/%s, %v, continue with what got", edgeApp.Namespace, edgeApp.Name, err)
 		errs = append(errs, err)
 	}
+	failover, err := c.planFailover(ctx, edgeApp)
+	if err != nil {
+		klog.Errorf("failed to plan failover of edgeapp %s/%s, %v, keep the workloads in current placements",
+			edgeApp.Namespace, edgeApp.Name, err)
+		errs = append(errs, err)
+		failover = currentPlacements(edgeApp)
+	}
+	failover.applyTo(overriderInfos)
+	for i := range overriderInfos {
+		overriderInfos[i].RenamedManifests = renamedManifests(tmplInfos, overriderInfos[i])
+	}
+	plan, err := c.planRollout(ctx, edgeApp, tmplInfos, overriderInfos)
+	if err != nil {
+		// Without a plan, the node groups cannot be told which revision to render, rendering them
+		// would update the groups not rolled out yet, so keep the workloads, their placements and
+		// the stable revision as they are until the rollout can be planned.
+		klog.Errorf("failed to plan rollout of edgeapp %s/%s, %v, keep the node groups in current revisions",
+			edgeApp.Namespace, edgeApp.Name, err)
+		return controllerruntime.Result{}, errors.NewAggregate(append(errs, err))
+	}
 	for _, tmplInfo := range tmplInfos {
 		tmpl := tmplInfo.Template
 		setOwnerReference(tmpl, edgeApp)
@@ -142,6 +188,20 @@ func (c *Controller) syncEdgeApplication(ctx context.Context, edgeApp *appsv1alp
 			klog.V(4).Infof("obj %s/%s of gvk %s does not need override, skip override",
 				tmpl.GetNamespace(), tmpl.GetName(), tmpl.GroupVersionKind())
 			modifiedTmplInfos = append(modifiedTmplInfos, tmplInfo)
+			// render a copy of the obj for each nodegroup which patches it
+			for _, info := range overriderInfos {
+				if !overridemanager.SelectedByJSONPatchOverriders(tmpl, info) {
+					continue
+				}
+				tmplCopy := tmpl.DeepCopy()
+				if err := c.GenericOverrider.ApplyOverrides(tmplCopy, info); err != nil {
+					klog.Errorf("failed to apply json patches of nodegroup %s to obj %s/%s of gvk %s, %v",
+						info.TargetNodeGroup, tmplCopy.GetNamespace(), tmplCopy.GetName(), tmplCopy.GroupVersionKind(), err)
+					errs = append(errs, err)
+					continue
+				}
+				modifiedTmplInfos = append(modifiedTmplInfos, &utils.TemplateInfo{Ordinal: tmplInfo.Ordinal, Template: tmplCopy})
+			}
 			continue
 		}
 
@@ -151,6 +211,10 @@ func (c *Controller) syncEdgeApplication(ctx context.Context, edgeApp *appsv1alp
 		// If one succeeded and another failed, the status of edgeApp will only contain the successful
 		// one, and have no status about the failed one.
 		for _, info := range overriderInfos {
+			if plan.rendersStable(info.TargetNodeGroup) {
+				// this node group has not been updated yet, it will be rendered with the stable revision
+				continue
+			}
 			tmplCopy := tmpl.DeepCopy()
 			klog.V(4).Infof("override obj %s/%s of gvk %s, for nodegroup %s", tmplCopy.GetNamespace(), tmplCopy.GetName(), tmplCopy.GroupVersionKind(), info.TargetNodeGroup)
 			if err := c.Overrider.ApplyOverrides(tmplCopy, info); err != nil {
@@ -162,9 +226,12 @@ func (c *Controller) syncEdgeApplication(ctx context.Context, edgeApp *appsv1alp
 			modifiedTmplInfos = append(modifiedTmplInfos, &utils.TemplateInfo{Ordinal: tmplInfo.Ordinal, Template: tmplCopy})
 		}
 	}
+	stableTmplInfos, stableErrs := c.renderStableRevision(edgeApp, plan, overriderInfos)
+	modifiedTmplInfos = append(modifiedTmplInfos, stableTmplInfos...)
+	errs = append(errs, stableErrs...)
 
 	// 2. remove status that do not need
-	if err := c.updateStatus(ctx, edgeApp, modifiedTmplInfos); err != nil {
+	if err := c.updateStatus(ctx, edgeApp, modifiedTmplInfos, plan.rolloutStatus(), failover.placementStatus()); err != nil {
 		klog.Errorf("failed to update status for EdgeApplication %s/%s, %v", edgeApp.Namespace, edgeApp.Name, err)
 		errs = append(errs, err)
 	}
@@ -194,7 +261,17 @@ func (c *Controller) syncEdgeApplication(ctx context.Context, edgeApp *appsv1alp
 		errs = append(errs, err)
 	}
 
-	return controllerruntime.Result{}, errors.NewAggregate(errs)
+	// 6. record the stable revision of the workload template once it has been rolled out
+	if err := c.updateStableTemplateAnnotation(ctx, edgeApp, plan, len(errs) == 0); err != nil {
+		klog.Errorf("failed to update stable workload template of EdgeApplication %s/%s, %v", edgeApp.Namespace, edgeApp.Name, err)
+		errs = append(errs, err)
+	}
+
+	requeueAfter := plan.requeueInterval()
+	if d := failover.requeueInterval(); d != 0 && (requeueAfter == 0 || d < requeueAfter) {
+		requeueAfter = d
+	}
+	return controllerruntime.Result{RequeueAfter: requeueAfter}, errors.NewAggregate(errs)
 }
 
 func (c *Controller) deleteRedundantResources(ctx context.Context, edgeApp *appsv1alpha1.EdgeApplication, currentTmplInfos []*utils.TemplateInfo) error {
@@ -221,7 +298,8 @@ func (c *Controller) deleteRedundantResources(ctx context.Context, edgeApp *apps
 	return nil
 }
 
-func (c *Controller) updateStatus(ctx context.Context, edgeApp *appsv1alpha1.EdgeApplication, tmplInfos []*utils.TemplateInfo) error {
+func (c *Controller) updateStatus(ctx context.Context, edgeApp *appsv1alpha1.EdgeApplication, tmplInfos []*utils.TemplateInfo,
+	rolloutStatus *appsv1alpha1.RolloutStatus, placements []appsv1alpha1.NodeGroupPlacement) error {
 	newStatus := []appsv1alpha1.ManifestStatus{}
 	tmplMap := map[int][]*utils.TemplateInfo{}
 	for _, tmplInfo := range tmplInfos {
@@ -296,7 +374,10 @@ func (c *Controller) updateStatus(ctx context.Context, edgeApp *appsv1alpha1.Edg
 		return newStatus[i].Identifier.Name < newStatus[j].Identifier.Name
 	})
 
-	if equality.Semantic.DeepEqual(newStatus, edgeApp.Status.WorkloadStatus) {
+	if equality.Semantic.DeepEqual(newStatus, edgeApp.Status.WorkloadStatus) &&
+		equality.Semantic.DeepEqual(rolloutStatus, edgeApp.Status.RolloutStatus) &&
+		equality.Semantic.DeepEqual(placements, edgeApp.Status.Placements) &&
+		edgeApp.Status.ObservedGeneration == edgeApp.Generation {
 		klog.V(4).Infof("newStatus is same as the current status in edgeApp %s/%s, skip update status",
 			edgeApp.Namespace, edgeApp.Name)
 		return nil
@@ -304,6 +385,9 @@ func (c *Controller) updateStatus(ctx context.Context, edgeApp *appsv1alpha1.Edg
 
 	newEdgeApp := edgeApp.DeepCopy()
 	newEdgeApp.Status.WorkloadStatus = newStatus
+	newEdgeApp.Status.RolloutStatus = rolloutStatus
+	newEdgeApp.Status.Placements = placements
+	newEdgeApp.Status.ObservedGeneration = edgeApp.Generation
 	return c.Client.Status().Patch(ctx, newEdgeApp, client.MergeFrom(edgeApp))
 }
 
@@ -606,6 +690,20 @@ func isSameAsLastApplied(objInEdgeApp *unstructured.Unstructured, curObj runtime
 	return false, fmt.Errorf("cannot find last applied template in annotation, %v, possibly it is not created by EdgeApplication Controller", err)
 }
 
+// renamedManifests returns the manifests which are not overridden by default but are rendered
+// as copies for the node group since they are selected by its JSONPatchOverriders.
+func renamedManifests(tmplInfos []*utils.TemplateInfo, info overridemanager.OverriderInfo) sets.Set[overridemanager.ManifestRef] {
+	renamed := sets.New[overridemanager.ManifestRef]()
+	for _, tmplInfo := range tmplInfos {
+		tmpl := tmplInfo.Template
+		if needOverride(tmpl) || !overridemanager.SelectedByJSONPatchOverriders(tmpl, info) {
+			continue
+		}
+		renamed.Insert(overridemanager.ManifestRef{Namespace: tmpl.GetNamespace(), Kind: tmpl.GetKind(), Name: tmpl.GetName()})
+	}
+	return renamed
+}
+
 // needOverride determines if a obj needs override, according to its gvk.
 func needOverride(obj runtime.Object) bool {
 	gvk := obj.GetObjectKind().GroupVersionKind()
@@ -0,0 +1,232 @@
+package edgeapplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/kubeedge/api/apis/apps/v1alpha1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/overridemanager"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/nodegroup"
+)
+
+const (
+	defaultFailoverGracePeriodSeconds = 300
+	defaultFailbackDelaySeconds       = 60
+
+	reasonNodeGroupAvailable   = "NodeGroupAvailable"
+	reasonNodeGroupUnavailable = "NodeGroupUnavailable"
+	reasonFailedOver           = "FailedOver"
+	reasonFailedBack           = "FailedBack"
+	reasonBackupUnavailable    = "BackupNodeGroupUnavailable"
+)
+
+// failoverPlan describes the node groups the workloads of the target node groups are placed in.
+type failoverPlan struct {
+	// activeGroups maps the target node group to the node group its workload is placed in.
+	activeGroups map[string]string
+	placements   []appsv1alpha1.NodeGroupPlacement
+	requeueAfter time.Duration
+}
+
+// applyTo sets the placement of the overrider infos according to the plan.
+func (p *failoverPlan) applyTo(infos []overridemanager.OverriderInfo) {
+	if p == nil {
+		return
+	}
+	for i := range infos {
+		if active, ok := p.activeGroups[infos[i].TargetNodeGroup]; ok {
+			infos[i].PlacementNodeGroup = active
+		}
+	}
+}
+
+func (p *failoverPlan) placementStatus() []appsv1alpha1.NodeGroupPlacement {
+	if p == nil {
+		return nil
+	}
+	return p.placements
+}
+
+func (p *failoverPlan) requeueInterval() time.Duration {
+	if p == nil {
+		return 0
+	}
+	return p.requeueAfter
+}
+
+// nodeGroupHealth is the availability of the nodes in a node group.
+type nodeGroupHealth struct {
+	// hasNodes is false if there's no node in the node group.
+	hasNodes bool
+	// available is true if at least one node in the node group is ready.
+	available bool
+	// since is the time the node group has been in the current availability.
+	since time.Time
+}
+
+// planFailover decides the node group that the workload of each target node group with
+// a failover policy is placed in. It returns nil if no target node group has a failover policy.
+func (c *Controller) planFailover(ctx context.Context, edgeApp *appsv1alpha1.EdgeApplication) (*failoverPlan, error) {
+	var plan *failoverPlan
+	now := metav1.Now()
+	for _, target := range edgeApp.Spec.WorkloadScope.TargetNodeGroups {
+		policy := target.Failover
+		if policy == nil {
+			continue
+		}
+		if plan == nil {
+			plan = &failoverPlan{activeGroups: map[string]string{}}
+		}
+
+		placement := appsv1alpha1.NodeGroupPlacement{NodeGroup: target.Name, ActiveNodeGroup: target.Name}
+		for _, existing := range edgeApp.Status.Placements {
+			if existing.NodeGroup == target.Name && existing.ActiveNodeGroup == policy.BackupNodeGroup {
+				placement = existing
+				break
+			}
+			if existing.NodeGroup == target.Name {
+				placement.LastTransitionTime = existing.LastTransitionTime
+			}
+		}
+
+		primary, err := c.getNodeGroupHealth(ctx, target.Name)
+		if err != nil {
+			return nil, err
+		}
+		if placement.ActiveNodeGroup == target.Name {
+			placement.Reason, placement.Message = reasonNodeGroupAvailable, ""
+			if !primary.hasNodes || primary.available {
+				plan.addPlacement(placement)
+				continue
+			}
+			placement.Reason = reasonNodeGroupUnavailable
+			gracePeriod := time.Duration(defaultFailoverGracePeriodSeconds) * time.Second
+			if policy.GracePeriodSeconds != nil {
+				gracePeriod = time.Duration(*policy.GracePeriodSeconds) * time.Second
+			}
+			if remaining := gracePeriod - now.Sub(primary.since); remaining > 0 {
+				placement.Message = fmt.Sprintf("all the nodes in node group %s are unavailable, fail over to node group %s in %s",
+					target.Name, policy.BackupNodeGroup, remaining.Round(time.Second))
+				plan.addPlacement(placement)
+				plan.requeue(remaining)
+				continue
+			}
+			backup, err := c.getNodeGroupHealth(ctx, policy.BackupNodeGroup)
+			if err != nil {
+				return nil, err
+			}
+			if !backup.available {
+				placement.Reason = reasonBackupUnavailable
+				placement.Message = fmt.Sprintf("all the nodes in node group %s are unavailable, but backup node group %s is not available either",
+					target.Name, policy.BackupNodeGroup)
+				plan.addPlacement(placement)
+				plan.requeue(rolloutRequeueInterval)
+				continue
+			}
+			placement.ActiveNodeGroup = policy.BackupNodeGroup
+			placement.Reason = reasonFailedOver
+			placement.Message = fmt.Sprintf("all the nodes in node group %s have been unavailable since %s, workload is moved to node group %s",
+				target.Name, primary.since.Format(time.RFC3339), policy.BackupNodeGroup)
+			placement.LastTransitionTime = now
+			c.recordEvent(edgeApp, corev1.EventTypeWarning, reasonFailedOver, placement.Message)
+			plan.addPlacement(placement)
+			continue
+		}
+
+		// the workload has been moved to the backup node group
+		if !primary.available {
+			plan.addPlacement(placement)
+			continue
+		}
+		failbackDelay := time.Duration(defaultFailbackDelaySeconds) * time.Second
+		if policy.FailbackDelaySeconds != nil {
+			failbackDelay = time.Duration(*policy.FailbackDelaySeconds) * time.Second
+		}
+		if remaining := failbackDelay - now.Sub(primary.since); remaining > 0 {
+			placement.Message = fmt.Sprintf("node group %s is available again, fail back in %s", target.Name, remaining.Round(time.Second))
+			plan.addPlacement(placement)
+			plan.requeue(remaining)
+			continue
+		}
+		placement.ActiveNodeGroup = target.Name
+		placement.Reason = reasonFailedBack
+		placement.Message = fmt.Sprintf("node group %s has been available since %s, workload is moved back from node group %s",
+			target.Name, primary.since.Format(time.RFC3339), policy.BackupNodeGroup)
+		placement.LastTransitionTime = now
+		c.recordEvent(edgeApp, corev1.EventTypeNormal, reasonFailedBack, placement.Message)
+		plan.addPlacement(placement)
+	}
+	return plan, nil
+}
+
+// currentPlacements returns the plan that keeps the workloads in the node groups recorded in the status.
+func currentPlacements(edgeApp *appsv1alpha1.EdgeApplication) *failoverPlan {
+	if len(edgeApp.Status.Placements) == 0 {
+		return nil
+	}
+	plan := &failoverPlan{activeGroups: map[string]string{}, requeueAfter: rolloutRequeueInterval}
+	for _, placement := range edgeApp.Status.Placements {
+		plan.addPlacement(placement)
+	}
+	return plan
+}
+
+func (p *failoverPlan) addPlacement(placement appsv1alpha1.NodeGroupPlacement) {
+	p.activeGroups[placement.NodeGroup] = placement.ActiveNodeGroup
+	p.placements = append(p.placements, placement)
+}
+
+// requeue makes the EdgeApplication be reconciled again after d at the latest.
+func (p *failoverPlan) requeue(d time.Duration) {
+	if p.requeueAfter == 0 || d < p.requeueAfter {
+		p.requeueAfter = d
+	}
+}
+
+// getNodeGroupHealth checks whether any node in the node group is ready. The time since when
+// the node group is available is the earliest time a ready node became ready, and the time since
+// when it is unavailable is the latest time a node became not ready.
+func (c *Controller) getNodeGroupHealth(ctx context.Context, nodeGroupName string) (nodeGroupHealth, error) {
+	nodes := &corev1.NodeList{}
+	if err := c.Client.List(ctx, nodes, client.MatchingLabels{nodegroup.LabelBelongingTo: nodeGroupName}); err != nil {
+		return nodeGroupHealth{}, fmt.Errorf("failed to list nodes in node group %s, %v", nodeGroupName, err)
+	}
+
+	health := nodeGroupHealth{hasNodes: len(nodes.Items) != 0}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		ready, transitionTime := node.CreationTimestamp.Time, node.CreationTimestamp.Time
+		isReady := false
+		for _, cond := range node.Status.Conditions {
+			if cond.Type == corev1.NodeReady {
+				isReady = cond.Status == corev1.ConditionTrue
+				transitionTime = cond.LastTransitionTime.Time
+				ready = transitionTime
+				break
+			}
+		}
+		switch {
+		case isReady && !health.available:
+			health.available, health.since = true, ready
+		case isReady && ready.Before(health.since):
+			health.since = ready
+		case !isReady && !health.available && transitionTime.After(health.since):
+			health.since = transitionTime
+		}
+	}
+	klog.V(4).Infof("node group %s has nodes: %v, available: %v, since: %s", nodeGroupName, health.hasNodes, health.available, health.since)
+	return health, nil
+}
+
+func (c *Controller) recordEvent(edgeApp *appsv1alpha1.EdgeApplication, eventType, reason, message string) {
+	if c.Recorder == nil {
+		return
+	}
+	c.Recorder.Event(edgeApp, eventType, reason, message)
+}
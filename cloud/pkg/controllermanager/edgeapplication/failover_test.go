@@ -0,0 +1,158 @@
+package edgeapplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	appsv1alpha1 "github.com/kubeedge/api/apis/apps/v1alpha1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/nodegroup"
+)
+
+func newTestNode(t *testing.T, c *Controller, name, nodeGroup string, ready bool, since time.Time) {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{nodegroup.LabelBelongingTo: nodeGroup}},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+			Type: corev1.NodeReady, Status: status, LastTransitionTime: metav1.NewTime(since),
+		}}},
+	}
+	if err := c.Client.Create(context.TODO(), node); err != nil {
+		t.Fatalf("failed to create node %s, %v", name, err)
+	}
+}
+
+func newTestFailoverEdgeApp(placements ...appsv1alpha1.NodeGroupPlacement) *appsv1alpha1.EdgeApplication {
+	return &appsv1alpha1.EdgeApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: appsv1alpha1.EdgeApplicationSpec{
+			WorkloadTemplate: newTestTemplate("nginx:1.25"),
+			WorkloadScope: appsv1alpha1.WorkloadScope{
+				TargetNodeGroups: []appsv1alpha1.TargetNodeGroup{
+					{
+						Name: "hangzhou",
+						Failover: &appsv1alpha1.FailoverPolicy{
+							BackupNodeGroup:      "shanghai",
+							GracePeriodSeconds:   ptr.To[int32](60),
+							FailbackDelaySeconds: ptr.To[int32](30),
+						},
+					},
+					{Name: "beijing"},
+				},
+			},
+		},
+		Status: appsv1alpha1.EdgeApplicationStatus{Placements: placements},
+	}
+}
+
+func TestPlanFailover(t *testing.T) {
+	now := time.Now()
+	failedOver := appsv1alpha1.NodeGroupPlacement{
+		NodeGroup:          "hangzhou",
+		ActiveNodeGroup:    "shanghai",
+		Reason:             reasonFailedOver,
+		LastTransitionTime: metav1.NewTime(now.Add(-time.Hour)),
+	}
+	cases := map[string]struct {
+		primaryReady   bool
+		primarySince   time.Duration
+		backupReady    bool
+		placements     []appsv1alpha1.NodeGroupPlacement
+		wantActive     string
+		wantReason     string
+		wantRequeue    bool
+		wantEventCount int
+	}{
+		"primary available": {
+			primaryReady: true, primarySince: time.Hour, backupReady: true,
+			wantActive: "hangzhou", wantReason: reasonNodeGroupAvailable,
+		},
+		"primary unavailable within grace period": {
+			primarySince: 10 * time.Second, backupReady: true,
+			wantActive: "hangzhou", wantReason: reasonNodeGroupUnavailable, wantRequeue: true,
+		},
+		"primary unavailable after grace period": {
+			primarySince: 2 * time.Minute, backupReady: true,
+			wantActive: "shanghai", wantReason: reasonFailedOver, wantEventCount: 1,
+		},
+		"backup unavailable": {
+			primarySince: 2 * time.Minute,
+			wantActive:   "hangzhou", wantReason: reasonBackupUnavailable, wantRequeue: true,
+		},
+		"primary still unavailable after failover": {
+			primarySince: 2 * time.Minute, backupReady: true, placements: []appsv1alpha1.NodeGroupPlacement{failedOver},
+			wantActive: "shanghai", wantReason: reasonFailedOver,
+		},
+		"primary recovered within failback delay": {
+			primaryReady: true, primarySince: 10 * time.Second, backupReady: true,
+			placements: []appsv1alpha1.NodeGroupPlacement{failedOver},
+			wantActive: "shanghai", wantReason: reasonFailedOver, wantRequeue: true,
+		},
+		"primary recovered after failback delay": {
+			primaryReady: true, primarySince: time.Minute, backupReady: true,
+			placements: []appsv1alpha1.NodeGroupPlacement{failedOver},
+			wantActive: "hangzhou", wantReason: reasonFailedBack, wantEventCount: 1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newTestController(t)
+			recorder := record.NewFakeRecorder(10)
+			c.Recorder = recorder
+			newTestNode(t, c, "node-hz", "hangzhou", tc.primaryReady, now.Add(-tc.primarySince))
+			newTestNode(t, c, "node-sh", "shanghai", tc.backupReady, now.Add(-time.Hour))
+
+			plan, err := c.planFailover(context.TODO(), newTestFailoverEdgeApp(tc.placements...))
+			if err != nil {
+				t.Fatalf("failed to plan failover, %v", err)
+			}
+			placements := plan.placementStatus()
+			if len(placements) != 1 {
+				t.Fatalf("expected 1 placement, got %d", len(placements))
+			}
+			if placements[0].ActiveNodeGroup != tc.wantActive || placements[0].Reason != tc.wantReason {
+				t.Errorf("expected placement in %s with reason %s, got %s with reason %s",
+					tc.wantActive, tc.wantReason, placements[0].ActiveNodeGroup, placements[0].Reason)
+			}
+			if (plan.requeueInterval() != 0) != tc.wantRequeue {
+				t.Errorf("expected requeue %v, got requeue after %s", tc.wantRequeue, plan.requeueInterval())
+			}
+			if len(recorder.Events) != tc.wantEventCount {
+				t.Errorf("expected %d events, got %d", tc.wantEventCount, len(recorder.Events))
+			}
+		})
+	}
+}
+
+func TestPlanFailoverWithoutPolicy(t *testing.T) {
+	c := newTestController(t)
+	edgeApp := newTestFailoverEdgeApp()
+	edgeApp.Spec.WorkloadScope.TargetNodeGroups[0].Failover = nil
+	plan, err := c.planFailover(context.TODO(), edgeApp)
+	if err != nil {
+		t.Fatalf("failed to plan failover, %v", err)
+	}
+	if plan != nil {
+		t.Errorf("expected no failover plan, got %v", plan)
+	}
+}
+
+func TestPlanFailoverEmptyNodeGroup(t *testing.T) {
+	c := newTestController(t)
+	plan, err := c.planFailover(context.TODO(), newTestFailoverEdgeApp())
+	if err != nil {
+		t.Fatalf("failed to plan failover, %v", err)
+	}
+	placements := plan.placementStatus()
+	if len(placements) != 1 || placements[0].ActiveNodeGroup != "hangzhou" {
+		t.Errorf("expected workload to stay in node group without nodes, got %v", placements)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/apps/v1alpha1"
+)
+
+type AffinityOverrider struct{}
+
+func (o *AffinityOverrider) ApplyOverrides(rawObj *unstructured.Unstructured, overriders OverriderInfo) error {
+	affinityOverrider := overriders.Overriders.AffinityOverrider
+	if affinityOverrider == nil {
+		return nil
+	}
+	podSpecPath, ok := podSpecPathOf(rawObj.GetKind())
+	if !ok {
+		return nil
+	}
+
+	affinityPath := fmt.Sprintf("%s/affinity", podSpecPath)
+	affinity := &corev1.Affinity{}
+	if _, err := getNestedField(rawObj, affinityPath, affinity); err != nil {
+		return err
+	}
+	if affinityOverrider.Value.NodeAffinity != nil {
+		affinity.NodeAffinity = affinityOverrider.Value.NodeAffinity
+	}
+	if affinityOverrider.Value.PodAffinity != nil {
+		affinity.PodAffinity = affinityOverrider.Value.PodAffinity
+	}
+	if affinityOverrider.Value.PodAntiAffinity != nil {
+		affinity.PodAntiAffinity = affinityOverrider.Value.PodAntiAffinity
+	}
+
+	patch := overrideOption{
+		Op:    string(v1alpha1.OverriderOpAdd),
+		Path:  affinityPath,
+		Value: affinity,
+	}
+	klog.V(4).Infof("Parsed JSON patches by AffinityOverrider(%+v): %+v", affinityOverrider, patch)
+	if err := applyJSONPatch(rawObj, []overrideOption{patch}); err != nil {
+		return fmt.Errorf("failed to apply affinity override on obj %s/%s, %v", rawObj.GetNamespace(), rawObj.GetName(), err)
+	}
+	return nil
+}
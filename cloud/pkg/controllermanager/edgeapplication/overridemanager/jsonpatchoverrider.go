@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/apps/v1alpha1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/constants"
+)
+
+// JSONPatchOverrider applies JSON patches to manifests selected by the JSONPatchOverriders.
+// It can be applied to manifests of any kind, and should be applied after NameOverrider
+// and all the other overriders.
+type JSONPatchOverrider struct{}
+
+func (o *JSONPatchOverrider) ApplyOverrides(rawObj *unstructured.Unstructured, overriders OverriderInfo) error {
+	jsonPatchOverriders := overriders.Overriders.JSONPatchOverriders
+	for index := range jsonPatchOverriders {
+		if !isSelectedByJSONPatchOverrider(rawObj, &jsonPatchOverriders[index], overriders) {
+			continue
+		}
+		patches := make([]overrideOption, 0, len(jsonPatchOverriders[index].Patch))
+		for _, operation := range jsonPatchOverriders[index].Patch {
+			patch := overrideOption{
+				Op:   operation.Op,
+				Path: operation.Path,
+				From: operation.From,
+			}
+			if operation.Value != nil {
+				patch.Value = json.RawMessage(operation.Value.Raw)
+			}
+			patches = append(patches, patch)
+		}
+
+		klog.V(4).Infof("Parsed JSON patches by JSONPatchOverrider(%+v): %+v", jsonPatchOverriders[index], patches)
+		if err := applyJSONPatch(rawObj, patches); err != nil {
+			return fmt.Errorf("failed to apply json patches on obj %s/%s of gvk %s, %v",
+				rawObj.GetNamespace(), rawObj.GetName(), rawObj.GroupVersionKind(), err)
+		}
+	}
+	return nil
+}
+
+// SelectedByJSONPatchOverriders checks if the manifest, which has not been overridden, is selected
+// by the Target of any JSONPatchOverrider of the node group. Manifests whose kinds are not overridden
+// by default should be rendered for the node group if they are selected.
+func SelectedByJSONPatchOverriders(tmpl *unstructured.Unstructured, overriders OverriderInfo) bool {
+	if overriders.Overriders == nil || tmpl.GroupVersionKind() == constants.ServiceGVK {
+		return false
+	}
+	for index := range overriders.Overriders.JSONPatchOverriders {
+		target := overriders.Overriders.JSONPatchOverriders[index].Target
+		if target != nil && matchesManifestSelector(tmpl, target, target.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSelectedByJSONPatchOverrider checks if the manifest, which may have been renamed by
+// NameOverrider, is selected by the JSONPatchOverrider.
+func isSelectedByJSONPatchOverrider(rawObj *unstructured.Unstructured, overrider *v1alpha1.JSONPatchOverrider, overriders OverriderInfo) bool {
+	if overrider.Target == nil {
+		_, ok := constants.OverriderTargetGVK[rawObj.GroupVersionKind()]
+		return ok
+	}
+	if rawObj.GroupVersionKind() == constants.ServiceGVK {
+		return false
+	}
+	return matchesManifestSelector(rawObj, overrider.Target, overriddenName(overrider.Target.Name, overriders))
+}
+
+// matchesManifestSelector checks if the manifest is of the kind of the selector and has the name,
+// the name is ignored if the selector does not specify one.
+func matchesManifestSelector(rawObj *unstructured.Unstructured, selector *v1alpha1.ManifestSelector, name string) bool {
+	if selector.APIVersion != "" && selector.APIVersion != rawObj.GetAPIVersion() {
+		return false
+	}
+	if selector.Kind != rawObj.GetKind() {
+		return false
+	}
+	return selector.Name == "" || rawObj.GetName() == name
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeedge/api/apis/apps/v1alpha1"
+)
+
+func newConfigMap(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "default",
+			},
+			"data": map[string]interface{}{
+				"site": "default",
+			},
+		},
+	}
+}
+
+func TestJSONPatchOverrider_ApplyOverrides(t *testing.T) {
+	sidecar := &runtime.RawExtension{Raw: []byte(`{"name":"sidecar","image":"busybox"}`)}
+	site := &runtime.RawExtension{Raw: []byte(`"hangzhou"`)}
+
+	tests := []struct {
+		name          string
+		rawObj        *unstructured.Unstructured
+		overriders    []v1alpha1.JSONPatchOverrider
+		path          []string
+		expected      interface{}
+		expectedError bool
+	}{
+		{
+			name: "Patch deployment without target",
+			rawObj: newDeploymentWithPodSpec(map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{"name": "nginx", "image": "nginx"}},
+			}),
+			overriders: []v1alpha1.JSONPatchOverrider{
+				{Patch: []v1alpha1.JSONPatchOperation{{Op: "add", Path: "/spec/template/spec/containers/-", Value: sidecar}}},
+			},
+			path: []string{"spec", "template", "spec", "containers"},
+			expected: []interface{}{
+				map[string]interface{}{"name": "nginx", "image": "nginx"},
+				map[string]interface{}{"name": "sidecar", "image": "busybox"},
+			},
+		},
+		{
+			name:   "Patch renamed configmap selected by target",
+			rawObj: newConfigMap("config-hangzhou"),
+			overriders: []v1alpha1.JSONPatchOverrider{
+				{
+					Target: &v1alpha1.ManifestSelector{Kind: "ConfigMap", Name: "config"},
+					Patch:  []v1alpha1.JSONPatchOperation{{Op: "replace", Path: "/data/site", Value: site}},
+				},
+			},
+			path:     []string{"data", "site"},
+			expected: "hangzhou",
+		},
+		{
+			name:   "Skip configmap not selected by target",
+			rawObj: newConfigMap("other-hangzhou"),
+			overriders: []v1alpha1.JSONPatchOverrider{
+				{
+					Target: &v1alpha1.ManifestSelector{Kind: "ConfigMap", Name: "config"},
+					Patch:  []v1alpha1.JSONPatchOperation{{Op: "replace", Path: "/data/site", Value: site}},
+				},
+			},
+			path:     []string{"data", "site"},
+			expected: "default",
+		},
+		{
+			name:   "Skip configmap without target",
+			rawObj: newConfigMap("config-hangzhou"),
+			overriders: []v1alpha1.JSONPatchOverrider{
+				{Patch: []v1alpha1.JSONPatchOperation{{Op: "replace", Path: "/data/site", Value: site}}},
+			},
+			path:     []string{"data", "site"},
+			expected: "default",
+		},
+		{
+			name:   "Move field",
+			rawObj: newConfigMap("config-hangzhou"),
+			overriders: []v1alpha1.JSONPatchOverrider{
+				{
+					Target: &v1alpha1.ManifestSelector{APIVersion: "v1", Kind: "ConfigMap"},
+					Patch:  []v1alpha1.JSONPatchOperation{{Op: "move", From: "/data/site", Path: "/data/region"}},
+				},
+			},
+			path:     []string{"data", "region"},
+			expected: "default",
+		},
+		{
+			name:   "Failed test operation",
+			rawObj: newConfigMap("config-hangzhou"),
+			overriders: []v1alpha1.JSONPatchOverrider{
+				{
+					Target: &v1alpha1.ManifestSelector{Kind: "ConfigMap", Name: "config"},
+					Patch:  []v1alpha1.JSONPatchOperation{{Op: "test", Path: "/data/site", Value: site}},
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overriders := OverriderInfo{
+				TargetNodeGroup: "hangzhou",
+				Overriders:      &v1alpha1.Overriders{JSONPatchOverriders: tt.overriders},
+			}
+			err := (&JSONPatchOverrider{}).ApplyOverrides(tt.rawObj, overriders)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			value, found, err := unstructured.NestedFieldNoCopy(tt.rawObj.Object, tt.path...)
+			assert.NoError(t, err)
+			assert.True(t, found)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestSelectedByJSONPatchOverriders(t *testing.T) {
+	overriders := OverriderInfo{
+		TargetNodeGroup: "hangzhou",
+		Overriders: &v1alpha1.Overriders{
+			JSONPatchOverriders: []v1alpha1.JSONPatchOverrider{
+				{Target: &v1alpha1.ManifestSelector{Kind: "ConfigMap", Name: "config"}},
+				{Target: &v1alpha1.ManifestSelector{Kind: "Service"}},
+			},
+		},
+	}
+	service := newConfigMap("svc")
+	service.SetKind("Service")
+
+	assert.True(t, SelectedByJSONPatchOverriders(newConfigMap("config"), overriders))
+	assert.False(t, SelectedByJSONPatchOverriders(newConfigMap("other"), overriders))
+	assert.False(t, SelectedByJSONPatchOverriders(service, overriders))
+	assert.False(t, SelectedByJSONPatchOverriders(newConfigMap("config"), OverriderInfo{}))
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/apps/v1alpha1"
+)
+
+const (
+	labelsField      = "labels"
+	annotationsField = "annotations"
+)
+
+// LabelsOverrider overrides labels of the resource and its pod template.
+// It can be applied to manifests of any kind.
+type LabelsOverrider struct{}
+
+func (o *LabelsOverrider) ApplyOverrides(rawObj *unstructured.Unstructured, overriders OverriderInfo) error {
+	return applyLabelAnnotationOverriders(rawObj, labelsField, overriders.Overriders.LabelsOverriders)
+}
+
+// AnnotationsOverrider overrides annotations of the resource and its pod template.
+// It can be applied to manifests of any kind.
+type AnnotationsOverrider struct{}
+
+func (o *AnnotationsOverrider) ApplyOverrides(rawObj *unstructured.Unstructured, overriders OverriderInfo) error {
+	return applyLabelAnnotationOverriders(rawObj, annotationsField, overriders.Overriders.AnnotationsOverriders)
+}
+
+func applyLabelAnnotationOverriders(rawObj *unstructured.Unstructured, field string, overriders []v1alpha1.LabelAnnotationOverrider) error {
+	if len(overriders) == 0 {
+		return nil
+	}
+	paths := [][]string{{"metadata", field}}
+	if podSpecPath, ok := podSpecPathOf(rawObj.GetKind()); ok && podSpecPath == podTemplatePrefix {
+		paths = append(paths, []string{"spec", "template", "metadata", field})
+	}
+
+	for _, path := range paths {
+		values, _, err := unstructured.NestedStringMap(rawObj.Object, path...)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve %v from obj %s/%s, %v", path, rawObj.GetNamespace(), rawObj.GetName(), err)
+		}
+		if values == nil {
+			values = make(map[string]string)
+		}
+		for index := range overriders {
+			overrideLabelsAnnotations(values, &overriders[index])
+		}
+		klog.V(4).Infof("Override %v of obj %s/%s with %v", path, rawObj.GetNamespace(), rawObj.GetName(), values)
+		if len(values) == 0 {
+			unstructured.RemoveNestedField(rawObj.Object, path...)
+			continue
+		}
+		if err := unstructured.SetNestedStringMap(rawObj.Object, values, path...); err != nil {
+			return fmt.Errorf("failed to set %v of obj %s/%s, %v", path, rawObj.GetNamespace(), rawObj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func overrideLabelsAnnotations(values map[string]string, overrider *v1alpha1.LabelAnnotationOverrider) {
+	switch overrider.Operator {
+	case v1alpha1.OverriderOpAdd, v1alpha1.OverriderOpReplace:
+		for key, value := range overrider.Value {
+			values[key] = value
+		}
+	case v1alpha1.OverriderOpRemove:
+		for key := range overrider.Value {
+			delete(values, key)
+		}
+	default:
+		klog.V(4).Infof("[overrideLabelsAnnotations], op: %s , op not supported, ignored.", overrider.Operator)
+	}
+}
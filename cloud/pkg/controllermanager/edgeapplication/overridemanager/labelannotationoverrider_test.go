@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeedge/api/apis/apps/v1alpha1"
+)
+
+func TestLabelsOverrider_ApplyOverrides(t *testing.T) {
+	rawObj := newDeploymentWithPodSpec(map[string]interface{}{})
+	rawObj.SetLabels(map[string]string{"app": "nginx", "site": "default"})
+	overriders := OverriderInfo{
+		Overriders: &v1alpha1.Overriders{
+			LabelsOverriders: []v1alpha1.LabelAnnotationOverrider{
+				{
+					Operator: v1alpha1.OverriderOpReplace,
+					Value:    map[string]string{"site": "hangzhou"},
+				},
+				{
+					Operator: v1alpha1.OverriderOpRemove,
+					Value:    map[string]string{"app": ""},
+				},
+			},
+		},
+	}
+
+	err := (&LabelsOverrider{}).ApplyOverrides(rawObj, overriders)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"site": "hangzhou"}, rawObj.GetLabels())
+	templateLabels, _, err := unstructured.NestedStringMap(rawObj.Object, "spec", "template", "metadata", "labels")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"site": "hangzhou"}, templateLabels)
+}
+
+func TestAnnotationsOverrider_ApplyOverrides(t *testing.T) {
+	rawObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":        "config",
+				"annotations": map[string]interface{}{"owner": "ops"},
+			},
+		},
+	}
+	overriders := OverriderInfo{
+		Overriders: &v1alpha1.Overriders{
+			AnnotationsOverriders: []v1alpha1.LabelAnnotationOverrider{
+				{
+					Operator: v1alpha1.OverriderOpRemove,
+					Value:    map[string]string{"owner": ""},
+				},
+			},
+		},
+	}
+
+	err := (&AnnotationsOverrider{}).ApplyOverrides(rawObj, overriders)
+	assert.NoError(t, err)
+	assert.Empty(t, rawObj.GetAnnotations())
+	_, found, err := unstructured.NestedFieldNoCopy(rawObj.Object, "spec")
+	assert.NoError(t, err)
+	assert.False(t, found, "pod template should not be added to obj without pod template")
+}
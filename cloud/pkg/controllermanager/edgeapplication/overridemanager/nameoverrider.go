@@ -11,19 +11,32 @@ import (
 type NameOverrider struct{}
 
 func (o *NameOverrider) ApplyOverrides(rawObj *unstructured.Unstructured, overriders OverriderInfo) error {
-	oldName := rawObj.GetName()
+	rawObj.SetName(overriddenName(rawObj.GetName(), overriders))
+	return nil
+}
+
+// overriddenName returns the name of the object overridden for the target.
+func overriddenName(name string, overriders OverriderInfo) string {
+	newName := name
 	if overriders.TargetNodeGroup != "" {
-		newName := fmt.Sprintf("%s-%s", oldName, overriders.TargetNodeGroup)
-		rawObj.SetName(newName)
+		newName = fmt.Sprintf("%s-%s", name, overriders.TargetNodeGroup)
 	}
 	if len(overriders.TargetNodeLabelSelector.MatchLabels) > 0 {
 		suffix := CreateSuffixFromLabels(overriders.TargetNodeLabelSelector.MatchLabels)
-		newName := fmt.Sprintf("%s-%s", oldName, suffix)
-		rawObj.SetName(newName)
+		newName = fmt.Sprintf("%s-%s", name, suffix)
 	}
+	return newName
+}
 
-	return nil
+// TargetName returns the name of the target, which is the name of the node group
+// or the suffix generated from the node labels.
+func TargetName(overriders OverriderInfo) string {
+	if overriders.TargetNodeGroup != "" {
+		return overriders.TargetNodeGroup
+	}
+	return CreateSuffixFromLabels(overriders.TargetNodeLabelSelector.MatchLabels)
 }
+
 func CreateSuffixFromLabels(labels map[string]string) string {
 	// Sort keys for deterministic hash
 	keys := make([]string, 0, len(labels))
@@ -20,8 +20,12 @@ func (o *NodeSelectorOverrider) ApplyOverrides(rawObj *unstructured.Unstructured
 	switch rawObj.GetKind() {
 	case "Deployment":
 		if overriders.TargetNodeGroup != "" {
+			placement := overriders.TargetNodeGroup
+			if overriders.PlacementNodeGroup != "" {
+				placement = overriders.PlacementNodeGroup
+			}
 			nodeGroupLabel := map[string]string{
-				nodegroup.LabelBelongingTo: overriders.TargetNodeGroup,
+				nodegroup.LabelBelongingTo: placement,
 			}
 			deploymentObj.Spec.Template.Spec.NodeSelector = nodeGroupLabel
 		}
@@ -3,11 +3,13 @@ package overridemanager
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	errorutil "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	appsv1alpha1 "github.com/kubeedge/api/apis/apps/v1alpha1"
 )
@@ -16,6 +18,7 @@ import (
 type overrideOption struct {
 	Op    string      `json:"op"`
 	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
 	Value interface{} `json:"value,omitempty"`
 }
 
@@ -25,9 +28,15 @@ type Overrider interface {
 }
 
 type OverriderInfo struct {
-	TargetNodeGroup         string
+	TargetNodeGroup string
+	// PlacementNodeGroup is the node group the workload is scheduled to,
+	// it defaults to TargetNodeGroup and is set to the backup node group on failover.
+	PlacementNodeGroup      string
 	TargetNodeLabelSelector v1.LabelSelector
 	Overriders              *appsv1alpha1.Overriders
+	// RenamedManifests are the manifests rendered as copies for the target since they are
+	// selected by JSONPatchOverriders, references of the workloads to them are rewritten.
+	RenamedManifests sets.Set[ManifestRef]
 }
 
 type OverrideManager struct {
@@ -69,3 +78,35 @@ func applyJSONPatch(obj *unstructured.Unstructured, overrides []overrideOption)
 	err = obj.UnmarshalJSON(patchedObjectJSONBytes)
 	return err
 }
+
+// podSpecPathOf returns the path of the pod spec in the object of the kind.
+func podSpecPathOf(kind string) (string, bool) {
+	switch kind {
+	case PodKind:
+		return podSpecPrefix, true
+	case ReplicaSetKind, DeploymentKind, DaemonSetKind, JobKind, StatefulSetKind:
+		return podTemplatePrefix, true
+	}
+	return "", false
+}
+
+// getNestedField converts the field at the path of the given object into out.
+// It returns false if the field does not exist.
+func getNestedField(obj *unstructured.Unstructured, path string, out interface{}) (bool, error) {
+	fields := strings.Split(strings.TrimPrefix(path, pathSplit), pathSplit)
+	value, ok, err := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve path(%s) from obj, %v", path, err)
+	}
+	if !ok || value == nil {
+		return false, nil
+	}
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(valueJSON, out); err != nil {
+		return false, fmt.Errorf("failed to convert path(%s) of obj, %v", path, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	configMapKind             = "ConfigMap"
+	secretKind                = "Secret"
+	persistentVolumeClaimKind = "PersistentVolumeClaim"
+	serviceAccountKind        = "ServiceAccount"
+)
+
+// ManifestRef identifies a manifest in the workload template of an EdgeApplication.
+type ManifestRef struct {
+	Namespace string
+	Kind      string
+	Name      string
+}
+
+// ReferenceOverrider rewrites the references of the pod template to the manifests which are
+// rendered as copies for the target, e.g. a ConfigMap selected by JSONPatchOverriders, so that
+// the workload of the target uses the copies named '<name>-<nodegroup>'.
+type ReferenceOverrider struct{}
+
+func (o *ReferenceOverrider) ApplyOverrides(rawObj *unstructured.Unstructured, overriders OverriderInfo) error {
+	if len(overriders.RenamedManifests) == 0 {
+		return nil
+	}
+	podSpecPath, ok := podSpecPathOf(rawObj.GetKind())
+	if !ok {
+		return nil
+	}
+	podSpec, ok, err := unstructured.NestedFieldNoCopy(rawObj.Object, strings.Split(strings.TrimPrefix(podSpecPath, pathSplit), pathSplit)...)
+	if err != nil || !ok {
+		return err
+	}
+	spec, ok := podSpec.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	rename := func(kind string, obj interface{}, fields ...string) {
+		m, ok := obj.(map[string]interface{})
+		if !ok {
+			return
+		}
+		name, ok, err := unstructured.NestedString(m, fields...)
+		if err != nil || !ok {
+			return
+		}
+		ref := ManifestRef{Namespace: rawObj.GetNamespace(), Kind: kind, Name: name}
+		if !overriders.RenamedManifests.Has(ref) {
+			return
+		}
+		// the fields have been checked, setting them does not fail
+		_ = unstructured.SetNestedField(m, overriddenName(name, overriders), fields...)
+	}
+
+	rename(serviceAccountKind, spec, "serviceAccountName")
+	for _, secret := range nestedSlice(spec, "imagePullSecrets") {
+		rename(secretKind, secret, "name")
+	}
+	for _, volume := range nestedSlice(spec, "volumes") {
+		rename(configMapKind, volume, "configMap", "name")
+		rename(secretKind, volume, "secret", "secretName")
+		rename(persistentVolumeClaimKind, volume, "persistentVolumeClaim", "claimName")
+		if m, ok := volume.(map[string]interface{}); ok {
+			for _, source := range nestedSlice(m, "projected", "sources") {
+				rename(configMapKind, source, "configMap", "name")
+				rename(secretKind, source, "secret", "name")
+			}
+		}
+	}
+	for _, containersField := range []string{"initContainers", "containers"} {
+		for _, container := range nestedSlice(spec, containersField) {
+			m, ok := container.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, env := range nestedSlice(m, "env") {
+				rename(configMapKind, env, "valueFrom", "configMapKeyRef", "name")
+				rename(secretKind, env, "valueFrom", "secretKeyRef", "name")
+			}
+			for _, envFrom := range nestedSlice(m, "envFrom") {
+				rename(configMapKind, envFrom, "configMapRef", "name")
+				rename(secretKind, envFrom, "secretRef", "name")
+			}
+		}
+	}
+	return nil
+}
+
+// nestedSlice returns the slice at the fields of obj without copying it, nil is returned
+// if the fields do not exist or are not a slice.
+func nestedSlice(obj map[string]interface{}, fields ...string) []interface{} {
+	value, ok, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !ok {
+		return nil
+	}
+	slice, _ := value.([]interface{})
+	return slice
+}
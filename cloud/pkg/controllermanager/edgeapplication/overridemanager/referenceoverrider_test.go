@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestReferenceOverrider_ApplyOverrides(t *testing.T) {
+	rawObj := newDeploymentWithPodSpec(map[string]interface{}{
+		"serviceAccountName": "app",
+		"volumes": []interface{}{
+			map[string]interface{}{"name": "config", "configMap": map[string]interface{}{"name": "config"}},
+			map[string]interface{}{"name": "shared", "configMap": map[string]interface{}{"name": "shared"}},
+			map[string]interface{}{"name": "cert", "secret": map[string]interface{}{"secretName": "cert"}},
+			map[string]interface{}{"name": "projected", "projected": map[string]interface{}{
+				"sources": []interface{}{
+					map[string]interface{}{"configMap": map[string]interface{}{"name": "config"}},
+				},
+			}},
+		},
+		"containers": []interface{}{
+			map[string]interface{}{
+				"name":  "nginx",
+				"image": "nginx",
+				"env": []interface{}{
+					map[string]interface{}{"name": "SITE", "valueFrom": map[string]interface{}{
+						"configMapKeyRef": map[string]interface{}{"name": "config", "key": "site"},
+					}},
+				},
+				"envFrom": []interface{}{
+					map[string]interface{}{"secretRef": map[string]interface{}{"name": "cert"}},
+				},
+			},
+		},
+	})
+	overriders := OverriderInfo{
+		TargetNodeGroup: "hangzhou",
+		RenamedManifests: sets.New(
+			ManifestRef{Namespace: "default", Kind: "ConfigMap", Name: "config"},
+			ManifestRef{Namespace: "default", Kind: "Secret", Name: "cert"},
+			// a copy in another namespace is not referenced by the deployment
+			ManifestRef{Namespace: "other", Kind: "ConfigMap", Name: "shared"},
+		),
+	}
+
+	assert.NoError(t, (&ReferenceOverrider{}).ApplyOverrides(rawObj, overriders))
+
+	spec := rawObj.Object["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})
+	assert.Equal(t, "app", spec["serviceAccountName"])
+	volumes := spec["volumes"].([]interface{})
+	name := func(obj interface{}, fields ...string) string {
+		value, _, _ := unstructured.NestedString(obj.(map[string]interface{}), fields...)
+		return value
+	}
+	assert.Equal(t, "config-hangzhou", name(volumes[0], "configMap", "name"))
+	assert.Equal(t, "shared", name(volumes[1], "configMap", "name"))
+	assert.Equal(t, "cert-hangzhou", name(volumes[2], "secret", "secretName"))
+	sources, _, _ := unstructured.NestedSlice(volumes[3].(map[string]interface{}), "projected", "sources")
+	assert.Equal(t, "config-hangzhou", name(sources[0], "configMap", "name"))
+	container := spec["containers"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "config-hangzhou", name(container["env"].([]interface{})[0], "valueFrom", "configMapKeyRef", "name"))
+	assert.Equal(t, "cert-hangzhou", name(container["envFrom"].([]interface{})[0], "secretRef", "name"))
+}
+
+func TestReferenceOverrider_ApplyOverridesWithoutRenamedManifests(t *testing.T) {
+	rawObj := newDeploymentWithPodSpec(map[string]interface{}{
+		"volumes": []interface{}{
+			map[string]interface{}{"name": "config", "configMap": map[string]interface{}{"name": "config"}},
+		},
+	})
+	expected := rawObj.DeepCopy()
+
+	assert.NoError(t, (&ReferenceOverrider{}).ApplyOverrides(rawObj, OverriderInfo{TargetNodeGroup: "hangzhou"}))
+	assert.Equal(t, expected, rawObj)
+}
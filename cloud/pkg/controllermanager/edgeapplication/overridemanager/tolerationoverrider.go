@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/apps/v1alpha1"
+)
+
+type TolerationOverrider struct{}
+
+func (o *TolerationOverrider) ApplyOverrides(rawObj *unstructured.Unstructured, overriders OverriderInfo) error {
+	tolerationOverriders := overriders.Overriders.TolerationOverriders
+	if len(tolerationOverriders) == 0 {
+		return nil
+	}
+	podSpecPath, ok := podSpecPathOf(rawObj.GetKind())
+	if !ok {
+		return nil
+	}
+
+	tolerationsPath := fmt.Sprintf("%s/tolerations", podSpecPath)
+	tolerations := make([]corev1.Toleration, 0)
+	if _, err := getNestedField(rawObj, tolerationsPath, &tolerations); err != nil {
+		return err
+	}
+	for index := range tolerationOverriders {
+		tolerations = overrideTolerations(tolerations, &tolerationOverriders[index])
+	}
+
+	patch := overrideOption{
+		Op:    string(v1alpha1.OverriderOpAdd),
+		Path:  tolerationsPath,
+		Value: tolerations,
+	}
+	klog.V(4).Infof("Parsed JSON patches by TolerationOverriders(%+v): %+v", tolerationOverriders, patch)
+	if err := applyJSONPatch(rawObj, []overrideOption{patch}); err != nil {
+		return fmt.Errorf("failed to apply tolerations override on obj %s/%s, %v", rawObj.GetNamespace(), rawObj.GetName(), err)
+	}
+	return nil
+}
+
+func overrideTolerations(curTolerations []corev1.Toleration, tolerationOverrider *v1alpha1.TolerationOverrider) []corev1.Toleration {
+	switch tolerationOverrider.Operator {
+	case v1alpha1.OverriderOpAdd:
+		return append(curTolerations, tolerationOverrider.Value...)
+	case v1alpha1.OverriderOpRemove:
+		return removeTolerations(curTolerations, tolerationOverrider.Value)
+	case v1alpha1.OverriderOpReplace:
+		return append([]corev1.Toleration{}, tolerationOverrider.Value...)
+	default:
+		klog.V(4).Infof("[overrideTolerations], op: %s , op not supported, ignored.", tolerationOverrider.Operator)
+		return curTolerations
+	}
+}
+
+func removeTolerations(curTolerations []corev1.Toleration, removeValues []corev1.Toleration) []corev1.Toleration {
+	newTolerations := make([]corev1.Toleration, 0, len(curTolerations))
+	for _, toleration := range curTolerations {
+		removed := false
+		for _, remove := range removeValues {
+			if toleration.Key == remove.Key && (remove.Effect == "" || toleration.Effect == remove.Effect) {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			newTolerations = append(newTolerations, toleration)
+		}
+	}
+	return newTolerations
+}
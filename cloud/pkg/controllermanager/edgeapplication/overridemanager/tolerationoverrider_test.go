@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeedge/api/apis/apps/v1alpha1"
+)
+
+func TestOverrideTolerations(t *testing.T) {
+	edgeToleration := corev1.Toleration{Key: "node-role.kubernetes.io/edge", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+	siteNoSchedule := corev1.Toleration{Key: "site", Operator: corev1.TolerationOpEqual, Value: "a", Effect: corev1.TaintEffectNoSchedule}
+	siteNoExecute := corev1.Toleration{Key: "site", Operator: corev1.TolerationOpEqual, Value: "a", Effect: corev1.TaintEffectNoExecute}
+
+	tests := []struct {
+		name           string
+		curTolerations []corev1.Toleration
+		overrider      *v1alpha1.TolerationOverrider
+		expected       []corev1.Toleration
+	}{
+		{
+			name:           "Add toleration",
+			curTolerations: []corev1.Toleration{edgeToleration},
+			overrider: &v1alpha1.TolerationOverrider{
+				Operator: v1alpha1.OverriderOpAdd,
+				Value:    []corev1.Toleration{siteNoSchedule},
+			},
+			expected: []corev1.Toleration{edgeToleration, siteNoSchedule},
+		},
+		{
+			name:           "Remove toleration with effect",
+			curTolerations: []corev1.Toleration{edgeToleration, siteNoSchedule, siteNoExecute},
+			overrider: &v1alpha1.TolerationOverrider{
+				Operator: v1alpha1.OverriderOpRemove,
+				Value:    []corev1.Toleration{{Key: "site", Effect: corev1.TaintEffectNoExecute}},
+			},
+			expected: []corev1.Toleration{edgeToleration, siteNoSchedule},
+		},
+		{
+			name:           "Remove toleration of all effects",
+			curTolerations: []corev1.Toleration{edgeToleration, siteNoSchedule, siteNoExecute},
+			overrider: &v1alpha1.TolerationOverrider{
+				Operator: v1alpha1.OverriderOpRemove,
+				Value:    []corev1.Toleration{{Key: "site"}},
+			},
+			expected: []corev1.Toleration{edgeToleration},
+		},
+		{
+			name:           "Replace tolerations",
+			curTolerations: []corev1.Toleration{edgeToleration, siteNoSchedule},
+			overrider: &v1alpha1.TolerationOverrider{
+				Operator: v1alpha1.OverriderOpReplace,
+				Value:    []corev1.Toleration{siteNoExecute},
+			},
+			expected: []corev1.Toleration{siteNoExecute},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, overrideTolerations(tt.curTolerations, tt.overrider))
+		})
+	}
+}
+
+func TestTolerationOverrider_ApplyOverrides(t *testing.T) {
+	rawObj := newDeploymentWithPodSpec(map[string]interface{}{})
+	toleration := corev1.Toleration{Key: "site", Operator: corev1.TolerationOpExists}
+	overriders := OverriderInfo{
+		Overriders: &v1alpha1.Overriders{
+			TolerationOverriders: []v1alpha1.TolerationOverrider{
+				{
+					Operator: v1alpha1.OverriderOpAdd,
+					Value:    []corev1.Toleration{toleration},
+				},
+			},
+		},
+	}
+
+	err := (&TolerationOverrider{}).ApplyOverrides(rawObj, overriders)
+	assert.NoError(t, err)
+	deploy, err := ConvertToDeployment(rawObj)
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.Toleration{toleration}, deploy.Spec.Template.Spec.Tolerations)
+}
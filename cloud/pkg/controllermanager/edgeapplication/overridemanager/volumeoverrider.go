@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/apps/v1alpha1"
+)
+
+type VolumeOverrider struct{}
+
+func (o *VolumeOverrider) ApplyOverrides(rawObj *unstructured.Unstructured, overriders OverriderInfo) error {
+	volumeOverriders := overriders.Overriders.VolumeOverriders
+	if len(volumeOverriders) == 0 {
+		return nil
+	}
+	podSpecPath, ok := podSpecPathOf(rawObj.GetKind())
+	if !ok {
+		return nil
+	}
+
+	volumesPath := fmt.Sprintf("%s/volumes", podSpecPath)
+	volumes := make([]corev1.Volume, 0)
+	if _, err := getNestedField(rawObj, volumesPath, &volumes); err != nil {
+		return err
+	}
+	for index := range volumeOverriders {
+		volumes = overrideVolumes(volumes, &volumeOverriders[index])
+	}
+
+	// the 'add' operation replaces the value of the member if it already exists
+	patch := overrideOption{
+		Op:    string(v1alpha1.OverriderOpAdd),
+		Path:  volumesPath,
+		Value: volumes,
+	}
+	klog.V(4).Infof("Parsed JSON patches by VolumeOverriders(%+v): %+v", volumeOverriders, patch)
+	if err := applyJSONPatch(rawObj, []overrideOption{patch}); err != nil {
+		return fmt.Errorf("failed to apply volumes override on obj %s/%s, %v", rawObj.GetNamespace(), rawObj.GetName(), err)
+	}
+	return nil
+}
+
+func overrideVolumes(curVolumes []corev1.Volume, volumeOverrider *v1alpha1.VolumeOverrider) []corev1.Volume {
+	switch volumeOverrider.Operator {
+	case v1alpha1.OverriderOpAdd:
+		return append(curVolumes, volumeOverrider.Value...)
+	case v1alpha1.OverriderOpRemove:
+		return removeVolumes(curVolumes, volumeOverrider.Value)
+	case v1alpha1.OverriderOpReplace:
+		return replaceVolumes(curVolumes, volumeOverrider.Value)
+	default:
+		klog.V(4).Infof("[overrideVolumes], op: %s , op not supported, ignored.", volumeOverrider.Operator)
+		return curVolumes
+	}
+}
+
+func removeVolumes(curVolumes []corev1.Volume, removeValues []corev1.Volume) []corev1.Volume {
+	newVolumes := make([]corev1.Volume, 0, len(curVolumes))
+	removeSet := sets.New[string]()
+	for _, volume := range removeValues {
+		removeSet.Insert(volume.Name)
+	}
+	for _, volume := range curVolumes {
+		if !removeSet.Has(volume.Name) {
+			newVolumes = append(newVolumes, volume)
+		}
+	}
+	return newVolumes
+}
+
+func replaceVolumes(curVolumes []corev1.Volume, replaceValues []corev1.Volume) []corev1.Volume {
+	newVolumes := make([]corev1.Volume, 0, len(curVolumes)+len(replaceValues))
+	replaceMap := make(map[string]corev1.Volume, len(replaceValues))
+	for _, volume := range replaceValues {
+		replaceMap[volume.Name] = volume
+	}
+
+	// replace volumes in place to keep their order
+	for _, volume := range curVolumes {
+		if replaced, ok := replaceMap[volume.Name]; ok {
+			newVolumes = append(newVolumes, replaced)
+			delete(replaceMap, volume.Name)
+			continue
+		}
+		newVolumes = append(newVolumes, volume)
+	}
+	// append volumes that do not exist yet
+	for _, volume := range replaceValues {
+		if _, ok := replaceMap[volume.Name]; ok {
+			newVolumes = append(newVolumes, volume)
+		}
+	}
+	return newVolumes
+}
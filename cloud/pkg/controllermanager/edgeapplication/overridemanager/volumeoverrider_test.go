@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeedge/api/apis/apps/v1alpha1"
+)
+
+func newDeploymentWithPodSpec(podSpec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       DeploymentKind,
+			"metadata": map[string]interface{}{
+				"name":      "test-deployment",
+				"namespace": "default",
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": podSpec,
+				},
+			},
+		},
+	}
+}
+
+func configMapVolume(name, configMap string) corev1.Volume {
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMap},
+			},
+		},
+	}
+}
+
+func TestOverrideVolumes(t *testing.T) {
+	tests := []struct {
+		name       string
+		curVolumes []corev1.Volume
+		overrider  *v1alpha1.VolumeOverrider
+		expected   []corev1.Volume
+	}{
+		{
+			name:       "Add volume",
+			curVolumes: []corev1.Volume{configMapVolume("config", "site-a")},
+			overrider: &v1alpha1.VolumeOverrider{
+				Operator: v1alpha1.OverriderOpAdd,
+				Value:    []corev1.Volume{configMapVolume("extra", "extra")},
+			},
+			expected: []corev1.Volume{configMapVolume("config", "site-a"), configMapVolume("extra", "extra")},
+		},
+		{
+			name:       "Remove volume",
+			curVolumes: []corev1.Volume{configMapVolume("config", "site-a"), configMapVolume("extra", "extra")},
+			overrider: &v1alpha1.VolumeOverrider{
+				Operator: v1alpha1.OverriderOpRemove,
+				Value:    []corev1.Volume{{Name: "config"}},
+			},
+			expected: []corev1.Volume{configMapVolume("extra", "extra")},
+		},
+		{
+			name:       "Replace existing volume and add missing volume",
+			curVolumes: []corev1.Volume{configMapVolume("config", "site-a"), configMapVolume("extra", "extra")},
+			overrider: &v1alpha1.VolumeOverrider{
+				Operator: v1alpha1.OverriderOpReplace,
+				Value:    []corev1.Volume{configMapVolume("new", "new"), configMapVolume("config", "site-b")},
+			},
+			expected: []corev1.Volume{configMapVolume("config", "site-b"), configMapVolume("extra", "extra"), configMapVolume("new", "new")},
+		},
+		{
+			name:       "Unsupported operator",
+			curVolumes: []corev1.Volume{configMapVolume("config", "site-a")},
+			overrider: &v1alpha1.VolumeOverrider{
+				Operator: "unknown",
+				Value:    []corev1.Volume{configMapVolume("config", "site-b")},
+			},
+			expected: []corev1.Volume{configMapVolume("config", "site-a")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, overrideVolumes(tt.curVolumes, tt.overrider))
+		})
+	}
+}
+
+func TestVolumeOverrider_ApplyOverrides(t *testing.T) {
+	rawObj := newDeploymentWithPodSpec(map[string]interface{}{
+		"volumes": []interface{}{
+			map[string]interface{}{
+				"name":      "config",
+				"configMap": map[string]interface{}{"name": "site-a"},
+			},
+		},
+	})
+	overriders := OverriderInfo{
+		TargetNodeGroup: "site-b",
+		Overriders: &v1alpha1.Overriders{
+			VolumeOverriders: []v1alpha1.VolumeOverrider{
+				{
+					Operator: v1alpha1.OverriderOpReplace,
+					Value:    []corev1.Volume{configMapVolume("config", "site-b")},
+				},
+			},
+		},
+	}
+
+	err := (&VolumeOverrider{}).ApplyOverrides(rawObj, overriders)
+	assert.NoError(t, err)
+	deploy, err := ConvertToDeployment(rawObj)
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.Volume{configMapVolume("config", "site-b")}, deploy.Spec.Template.Spec.Volumes)
+}
+
+func TestVolumeOverrider_ApplyOverridesWithoutVolumes(t *testing.T) {
+	rawObj := newDeploymentWithPodSpec(map[string]interface{}{})
+	overriders := OverriderInfo{
+		Overriders: &v1alpha1.Overriders{
+			VolumeOverriders: []v1alpha1.VolumeOverrider{
+				{
+					Operator: v1alpha1.OverriderOpAdd,
+					Value:    []corev1.Volume{configMapVolume("config", "site-b")},
+				},
+			},
+		},
+	}
+
+	err := (&VolumeOverrider{}).ApplyOverrides(rawObj, overriders)
+	assert.NoError(t, err)
+	deploy, err := ConvertToDeployment(rawObj)
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.Volume{configMapVolume("config", "site-b")}, deploy.Spec.Template.Spec.Volumes)
+}
@@ -0,0 +1,382 @@
+package edgeapplication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/kubeedge/api/apis/apps/v1alpha1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/constants"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/overridemanager"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/statusmanager"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/utils"
+)
+
+const (
+	defaultMaxUnavailableGroups    = 1
+	defaultProgressDeadlineSeconds = 600
+	// rolloutRequeueInterval is the interval to check the progress of an unfinished rollout,
+	// so that the progress deadline of node groups can be detected without other events.
+	rolloutRequeueInterval = 15 * time.Second
+)
+
+// rolloutPlan describes which revision of the workload template each target node group
+// should be rendered with during a progressive rollout.
+type rolloutPlan struct {
+	// stableTmplInfos contains templates of the stable revision.
+	stableTmplInfos []*utils.TemplateInfo
+	// stableGroups contains node groups which should be rendered with the stable revision.
+	stableGroups sets.Set[string]
+	// newStableTemplate is the workload template to be recorded as the stable revision,
+	// it's nil if the stable revision does not change.
+	newStableTemplate *appsv1alpha1.ResourceTemplate
+	status            *appsv1alpha1.RolloutStatus
+	requeueAfter      time.Duration
+}
+
+// rendersStable returns true if the node group should be rendered with the stable revision.
+func (p *rolloutPlan) rendersStable(nodeGroup string) bool {
+	if p == nil || nodeGroup == "" {
+		return false
+	}
+	return p.stableGroups.Has(nodeGroup)
+}
+
+func (p *rolloutPlan) rolloutStatus() *appsv1alpha1.RolloutStatus {
+	if p == nil {
+		return nil
+	}
+	return p.status
+}
+
+func (p *rolloutPlan) requeueInterval() time.Duration {
+	if p == nil {
+		return 0
+	}
+	return p.requeueAfter
+}
+
+func isProgressiveRollout(edgeApp *appsv1alpha1.EdgeApplication) bool {
+	strategy := edgeApp.Spec.RolloutStrategy
+	return strategy != nil && strategy.Type == appsv1alpha1.ProgressiveRolloutStrategyType
+}
+
+// planRollout decides which node groups should be rendered with the update revision
+// of the workload template and which should keep the stable one. It returns nil if the
+// rollout strategy of the EdgeApplication is not Progressive.
+func (c *Controller) planRollout(ctx context.Context, edgeApp *appsv1alpha1.EdgeApplication,
+	tmplInfos []*utils.TemplateInfo, overriderInfos []overridemanager.OverriderInfo) (*rolloutPlan, error) {
+	if !isProgressiveRollout(edgeApp) {
+		return nil, nil
+	}
+	updateRevision, err := computeRevision(&edgeApp.Spec.WorkloadTemplate)
+	if err != nil {
+		return nil, err
+	}
+	stableTemplate, err := getStableTemplate(edgeApp)
+	if err != nil {
+		return nil, err
+	}
+	stableRevision := ""
+	if stableTemplate != nil {
+		if stableRevision, err = computeRevision(stableTemplate); err != nil {
+			return nil, err
+		}
+	}
+
+	progressive := edgeApp.Spec.RolloutStrategy.Progressive
+	if progressive == nil {
+		progressive = &appsv1alpha1.ProgressiveRollout{}
+	}
+	maxUnavailable := defaultMaxUnavailableGroups
+	if progressive.MaxUnavailableGroups != nil && *progressive.MaxUnavailableGroups > 0 {
+		maxUnavailable = int(*progressive.MaxUnavailableGroups)
+	}
+	deadline := time.Duration(defaultProgressDeadlineSeconds) * time.Second
+	if progressive.ProgressDeadlineSeconds != nil && *progressive.ProgressDeadlineSeconds > 0 {
+		deadline = time.Duration(*progressive.ProgressDeadlineSeconds) * time.Second
+	}
+	if stableTemplate == nil {
+		// Nothing has been rolled out successfully before, e.g. the EdgeApplication is
+		// newly created, so render all the node groups with the update revision at once.
+		maxUnavailable = len(edgeApp.Spec.WorkloadScope.TargetNodeGroups)
+	}
+
+	plan := &rolloutPlan{
+		stableGroups: sets.New[string](),
+		status: &appsv1alpha1.RolloutStatus{
+			StableRevision: stableRevision,
+			UpdateRevision: updateRevision,
+		},
+	}
+	prev := edgeApp.Status.RolloutStatus
+	if prev != nil && prev.UpdateRevision != updateRevision {
+		// the workload template has changed, start a new rollout
+		prev = nil
+	}
+	if prev != nil && prev.Phase == appsv1alpha1.RolloutRolledBack && stableTemplate != nil {
+		// Keep all the node groups on the stable revision until the workload template is changed.
+		plan.status = prev.DeepCopy()
+		plan.stableTmplInfos, err = c.templateInfosOf(edgeApp, stableTemplate)
+		for _, group := range edgeApp.Spec.WorkloadScope.TargetNodeGroups {
+			plan.stableGroups.Insert(group.Name)
+		}
+		return plan, err
+	}
+
+	now := metav1.Now()
+	prevGroups := map[string]appsv1alpha1.NodeGroupRolloutStatus{}
+	if prev != nil {
+		for _, group := range prev.NodeGroups {
+			prevGroups[group.Name] = group
+		}
+	}
+	infos := map[string]overridemanager.OverriderInfo{}
+	for _, info := range overriderInfos {
+		if info.TargetNodeGroup != "" {
+			infos[info.TargetNodeGroup] = info
+		}
+	}
+
+	unavailable, failed := 0, []string{}
+	groups := make([]appsv1alpha1.NodeGroupRolloutStatus, 0, len(edgeApp.Spec.WorkloadScope.TargetNodeGroups))
+	for _, target := range edgeApp.Spec.WorkloadScope.TargetNodeGroups {
+		group, ok := prevGroups[target.Name]
+		if !ok {
+			group = appsv1alpha1.NodeGroupRolloutStatus{
+				Name:               target.Name,
+				Revision:           stableRevision,
+				Phase:              appsv1alpha1.NodeGroupRolloutPending,
+				LastTransitionTime: now,
+			}
+			if stableTemplate == nil || stableRevision == updateRevision {
+				// there is no other revision to render this node group with
+				group.Revision = updateRevision
+				group.Phase = appsv1alpha1.NodeGroupRolloutUpdating
+			}
+		}
+		if group.Phase != appsv1alpha1.NodeGroupRolloutPending {
+			available, err := c.isNodeGroupAvailable(ctx, edgeApp, tmplInfos, infos[target.Name])
+			if err != nil {
+				klog.Errorf("failed to check availability of node group %s for edgeapp %s/%s, %v",
+					target.Name, edgeApp.Namespace, edgeApp.Name, err)
+			}
+			switch {
+			case available:
+				setGroupPhase(&group, appsv1alpha1.NodeGroupRolloutAvailable, now)
+			case group.Phase == appsv1alpha1.NodeGroupRolloutAvailable:
+				// the node group became unavailable again, wait for it with a new deadline
+				setGroupPhase(&group, appsv1alpha1.NodeGroupRolloutUpdating, now)
+			case group.Phase == appsv1alpha1.NodeGroupRolloutUpdating && now.Sub(group.LastTransitionTime.Time) > deadline:
+				setGroupPhase(&group, appsv1alpha1.NodeGroupRolloutFailed, now)
+			}
+			if group.Phase != appsv1alpha1.NodeGroupRolloutAvailable {
+				unavailable++
+			}
+			if group.Phase == appsv1alpha1.NodeGroupRolloutFailed {
+				failed = append(failed, group.Name)
+			}
+		}
+		groups = append(groups, group)
+	}
+
+	switch {
+	case len(failed) != 0 && progressive.FailurePolicy == appsv1alpha1.RolloutFailurePolicyRollback && stableTemplate != nil:
+		plan.status.Phase = appsv1alpha1.RolloutRolledBack
+		plan.status.Message = fmt.Sprintf("node groups %v failed to become available within %s, rolled back to revision %s",
+			failed, deadline, stableRevision)
+		for i := range groups {
+			groups[i].Revision = stableRevision
+			if groups[i].Phase != appsv1alpha1.NodeGroupRolloutFailed {
+				setGroupPhase(&groups[i], appsv1alpha1.NodeGroupRolloutPending, now)
+			}
+			plan.stableGroups.Insert(groups[i].Name)
+		}
+	case len(failed) != 0:
+		plan.status.Phase = appsv1alpha1.RolloutPaused
+		plan.status.Message = fmt.Sprintf("node groups %v failed to become available within %s, rollout of revision %s is paused",
+			failed, deadline, updateRevision)
+	default:
+		for i := range groups {
+			if groups[i].Phase != appsv1alpha1.NodeGroupRolloutPending || unavailable >= maxUnavailable {
+				continue
+			}
+			groups[i].Revision = updateRevision
+			setGroupPhase(&groups[i], appsv1alpha1.NodeGroupRolloutUpdating, now)
+			unavailable++
+		}
+		plan.status.Phase = appsv1alpha1.RolloutProgressing
+		if unavailable == 0 {
+			plan.status.Phase = appsv1alpha1.RolloutCompleted
+			plan.status.StableRevision = updateRevision
+			if stableRevision != updateRevision {
+				plan.newStableTemplate = edgeApp.Spec.WorkloadTemplate.DeepCopy()
+			}
+		}
+	}
+
+	for _, group := range groups {
+		if group.Phase == appsv1alpha1.NodeGroupRolloutPending {
+			plan.stableGroups.Insert(group.Name)
+		}
+	}
+	if plan.stableGroups.Len() != 0 {
+		if plan.stableTmplInfos, err = c.templateInfosOf(edgeApp, stableTemplate); err != nil {
+			return nil, err
+		}
+	}
+	if plan.status.Phase != appsv1alpha1.RolloutCompleted && plan.status.Phase != appsv1alpha1.RolloutRolledBack {
+		plan.requeueAfter = rolloutRequeueInterval
+	}
+	plan.status.NodeGroups = groups
+	return plan, nil
+}
+
+// isNodeGroupAvailable checks whether all the workloads rendered into the node group with
+// the update revision have been applied and become available.
+func (c *Controller) isNodeGroupAvailable(ctx context.Context, edgeApp *appsv1alpha1.EdgeApplication,
+	tmplInfos []*utils.TemplateInfo, info overridemanager.OverriderInfo) (bool, error) {
+	if info.Overriders == nil {
+		return false, fmt.Errorf("cannot find overriders of node group")
+	}
+	for _, tmplInfo := range tmplInfos {
+		if !needOverride(tmplInfo.Template) {
+			continue
+		}
+		tmpl := tmplInfo.Template.DeepCopy()
+		setOwnerReference(tmpl, edgeApp)
+		if err := c.Overrider.ApplyOverrides(tmpl, info); err != nil {
+			return false, err
+		}
+		exists, curObj, err := c.ifObjExists(ctx, tmpl)
+		if err != nil || !exists {
+			return false, err
+		}
+		// The workload in the cluster may still be rendered with an old revision,
+		// whose status cannot tell whether the update revision is available.
+		if same, err := isSameAsLastApplied(tmpl, curObj); err != nil || !same {
+			return false, err
+		}
+		resourceInfo := utils.GetResourceInfoOfTemplateInfo(&utils.TemplateInfo{Ordinal: tmplInfo.Ordinal, Template: tmpl})
+		available, err := statusmanager.IsWorkloadAvailable(ctx, c.Client, resourceInfo)
+		if err != nil || !available {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// renderStableRevision applies overriders of node groups which have not been updated
+// to templates of the stable revision.
+func (c *Controller) renderStableRevision(edgeApp *appsv1alpha1.EdgeApplication, plan *rolloutPlan,
+	overriderInfos []overridemanager.OverriderInfo) ([]*utils.TemplateInfo, []error) {
+	if plan == nil {
+		return nil, nil
+	}
+	modifiedTmplInfos := []*utils.TemplateInfo{}
+	errs := []error{}
+	for _, tmplInfo := range plan.stableTmplInfos {
+		tmpl := tmplInfo.Template
+		if !needOverride(tmpl) {
+			continue
+		}
+		setOwnerReference(tmpl, edgeApp)
+		for _, info := range overriderInfos {
+			if !plan.rendersStable(info.TargetNodeGroup) {
+				continue
+			}
+			tmplCopy := tmpl.DeepCopy()
+			if err := c.Overrider.ApplyOverrides(tmplCopy, info); err != nil {
+				klog.Errorf("failed to apply override of nodegroup %s to stable obj %s/%s of gvk %s, %v",
+					info.TargetNodeGroup, tmplCopy.GetNamespace(), tmplCopy.GetName(), tmplCopy.GroupVersionKind(), err)
+				errs = append(errs, err)
+				continue
+			}
+			modifiedTmplInfos = append(modifiedTmplInfos, &utils.TemplateInfo{Ordinal: tmplInfo.Ordinal, Template: tmplCopy})
+		}
+	}
+	return modifiedTmplInfos, errs
+}
+
+// updateStableTemplateAnnotation records the workload template which has been rolled out to all
+// the node groups as the stable revision. If the EdgeApplication does not use the progressive
+// rollout, the workload template is recorded once it has been applied, so that a progressive
+// rollout enabled later starts from the revision running in the node groups.
+func (c *Controller) updateStableTemplateAnnotation(ctx context.Context, edgeApp *appsv1alpha1.EdgeApplication,
+	plan *rolloutPlan, applied bool) error {
+	var stableTemplate *appsv1alpha1.ResourceTemplate
+	switch {
+	case plan == nil && applied && !isProgressiveRollout(edgeApp):
+		stableTemplate = &edgeApp.Spec.WorkloadTemplate
+	case plan != nil:
+		stableTemplate = plan.newStableTemplate
+	}
+	if stableTemplate == nil {
+		return nil
+	}
+	tmplJSON, err := json.Marshal(stableTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to marshal stable workload template, %v", err)
+	}
+	if edgeApp.Annotations[constants.StableWorkloadTemplateAnnotationKey] == string(tmplJSON) {
+		return nil
+	}
+	newEdgeApp := edgeApp.DeepCopy()
+	if newEdgeApp.Annotations == nil {
+		newEdgeApp.Annotations = make(map[string]string)
+	}
+	newEdgeApp.Annotations[constants.StableWorkloadTemplateAnnotationKey] = string(tmplJSON)
+	return c.Client.Patch(ctx, newEdgeApp, client.MergeFrom(edgeApp))
+}
+
+func getStableTemplate(edgeApp *appsv1alpha1.EdgeApplication) (*appsv1alpha1.ResourceTemplate, error) {
+	anno, ok := edgeApp.Annotations[constants.StableWorkloadTemplateAnnotationKey]
+	if !ok || anno == "" {
+		return nil, nil
+	}
+	tmpl := &appsv1alpha1.ResourceTemplate{}
+	if err := json.Unmarshal([]byte(anno), tmpl); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal StableWorkloadTemplateAnnotation on edgeapp %s/%s, %v",
+			edgeApp.Namespace, edgeApp.Name, err)
+	}
+	return tmpl, nil
+}
+
+// templateInfosOf parses the manifests of the workload template as the manifests of the EdgeApplication.
+func (c *Controller) templateInfosOf(edgeApp *appsv1alpha1.EdgeApplication, tmpl *appsv1alpha1.ResourceTemplate) ([]*utils.TemplateInfo, error) {
+	if tmpl == nil {
+		return nil, nil
+	}
+	copied := edgeApp.DeepCopy()
+	copied.Spec.WorkloadTemplate = *tmpl.DeepCopy()
+	return utils.GetTemplatesInfosOfEdgeApp(copied, c.Serializer)
+}
+
+// computeRevision returns a hash of the workload template, which is used as its revision.
+func computeRevision(tmpl *appsv1alpha1.ResourceTemplate) (string, error) {
+	tmplJSON, err := json.Marshal(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal workload template, %v", err)
+	}
+	hasher := fnv.New32a()
+	if _, err := hasher.Write(tmplJSON); err != nil {
+		return "", err
+	}
+	return rand.SafeEncodeString(fmt.Sprint(hasher.Sum32())), nil
+}
+
+func setGroupPhase(group *appsv1alpha1.NodeGroupRolloutStatus, phase appsv1alpha1.NodeGroupRolloutPhase, now metav1.Time) {
+	if group.Phase == phase {
+		return
+	}
+	group.Phase = phase
+	group.LastTransitionTime = now
+}
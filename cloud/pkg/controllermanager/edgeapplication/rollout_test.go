@@ -0,0 +1,311 @@
+package edgeapplication
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appsv1alpha1 "github.com/kubeedge/api/apis/apps/v1alpha1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/constants"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/overridemanager"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/utils"
+)
+
+func newTestController(t *testing.T) *Controller {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme, %v", err)
+	}
+	if err := appsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add apps scheme, %v", err)
+	}
+	return &Controller{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Serializer: jsonserializer.NewSerializerWithOptions(jsonserializer.DefaultMetaFactory,
+			scheme, scheme, jsonserializer.SerializerOptions{Yaml: true}),
+		Overrider: &overridemanager.OverrideManager{
+			Overriders: []overridemanager.Overrider{
+				&overridemanager.NameOverrider{},
+				&overridemanager.NodeSelectorOverrider{},
+			},
+		},
+	}
+}
+
+func newTestTemplate(image string) appsv1alpha1.ResourceTemplate {
+	manifest := []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"nginx","namespace":"default"},` +
+		`"spec":{"replicas":1,"template":{"spec":{"containers":[{"name":"nginx","image":"` + image + `"}]}}}}`)
+	return appsv1alpha1.ResourceTemplate{
+		Manifests: []appsv1alpha1.Manifest{{RawExtension: runtime.RawExtension{Raw: manifest}}},
+	}
+}
+
+func newTestEdgeApp(t *testing.T, policy appsv1alpha1.RolloutFailurePolicy, stable *appsv1alpha1.ResourceTemplate) *appsv1alpha1.EdgeApplication {
+	edgeApp := &appsv1alpha1.EdgeApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: appsv1alpha1.EdgeApplicationSpec{
+			WorkloadTemplate: newTestTemplate("nginx:1.25"),
+			WorkloadScope: appsv1alpha1.WorkloadScope{
+				TargetNodeGroups: []appsv1alpha1.TargetNodeGroup{{Name: "hangzhou"}, {Name: "beijing"}, {Name: "shanghai"}},
+			},
+			RolloutStrategy: &appsv1alpha1.RolloutStrategy{
+				Type:        appsv1alpha1.ProgressiveRolloutStrategyType,
+				Progressive: &appsv1alpha1.ProgressiveRollout{FailurePolicy: policy},
+			},
+		},
+	}
+	if stable != nil {
+		stableJSON, err := json.Marshal(stable)
+		if err != nil {
+			t.Fatalf("failed to marshal stable template, %v", err)
+		}
+		edgeApp.Annotations = map[string]string{constants.StableWorkloadTemplateAnnotationKey: string(stableJSON)}
+	}
+	return edgeApp
+}
+
+func planTestRollout(t *testing.T, c *Controller, edgeApp *appsv1alpha1.EdgeApplication) *rolloutPlan {
+	tmplInfos, err := utils.GetTemplatesInfosOfEdgeApp(edgeApp, c.Serializer)
+	if err != nil {
+		t.Fatalf("failed to get templates, %v", err)
+	}
+	plan, err := c.planRollout(context.TODO(), edgeApp, tmplInfos, utils.GetAllOverriders(edgeApp))
+	if err != nil {
+		t.Fatalf("failed to plan rollout, %v", err)
+	}
+	return plan
+}
+
+func groupPhases(status *appsv1alpha1.RolloutStatus) map[string]appsv1alpha1.NodeGroupRolloutPhase {
+	phases := map[string]appsv1alpha1.NodeGroupRolloutPhase{}
+	for _, group := range status.NodeGroups {
+		phases[group.Name] = group.Phase
+	}
+	return phases
+}
+
+func TestPlanRolloutNotProgressive(t *testing.T) {
+	c := newTestController(t)
+	edgeApp := newTestEdgeApp(t, "", nil)
+	edgeApp.Spec.RolloutStrategy = nil
+	if plan := planTestRollout(t, c, edgeApp); plan != nil {
+		t.Errorf("expected no rollout plan, got %v", plan)
+	}
+}
+
+func TestPlanRolloutWithoutStableRevision(t *testing.T) {
+	c := newTestController(t)
+	plan := planTestRollout(t, c, newTestEdgeApp(t, "", nil))
+
+	if plan.stableGroups.Len() != 0 {
+		t.Errorf("expected all node groups rendered with update revision, got stable groups %v", plan.stableGroups)
+	}
+	for name, phase := range groupPhases(plan.status) {
+		if phase != appsv1alpha1.NodeGroupRolloutUpdating {
+			t.Errorf("expected node group %s in phase Updating, got %s", name, phase)
+		}
+	}
+	if plan.status.Phase != appsv1alpha1.RolloutProgressing {
+		t.Errorf("expected rollout phase Progressing, got %s", plan.status.Phase)
+	}
+}
+
+func TestPlanRolloutUpdatesGroupsInOrder(t *testing.T) {
+	c := newTestController(t)
+	stable := newTestTemplate("nginx:1.24")
+	plan := planTestRollout(t, c, newTestEdgeApp(t, "", &stable))
+
+	want := map[string]appsv1alpha1.NodeGroupRolloutPhase{
+		"hangzhou": appsv1alpha1.NodeGroupRolloutUpdating,
+		"beijing":  appsv1alpha1.NodeGroupRolloutPending,
+		"shanghai": appsv1alpha1.NodeGroupRolloutPending,
+	}
+	got := groupPhases(plan.status)
+	for name, phase := range want {
+		if got[name] != phase {
+			t.Errorf("expected node group %s in phase %s, got %s", name, phase, got[name])
+		}
+	}
+	if plan.rendersStable("hangzhou") || !plan.rendersStable("beijing") || !plan.rendersStable("shanghai") {
+		t.Errorf("unexpected stable groups %v", plan.stableGroups)
+	}
+	if len(plan.stableTmplInfos) != 1 {
+		t.Errorf("expected 1 stable template, got %d", len(plan.stableTmplInfos))
+	}
+	if plan.requeueAfter != rolloutRequeueInterval {
+		t.Errorf("expected requeue after %s, got %s", rolloutRequeueInterval, plan.requeueAfter)
+	}
+}
+
+func TestPlanRolloutFailedGroup(t *testing.T) {
+	cases := map[string]struct {
+		policy          appsv1alpha1.RolloutFailurePolicy
+		wantPhase       appsv1alpha1.RolloutPhase
+		wantStableGroup []string
+	}{
+		"pause": {
+			policy:          appsv1alpha1.RolloutFailurePolicyPause,
+			wantPhase:       appsv1alpha1.RolloutPaused,
+			wantStableGroup: []string{"beijing", "shanghai"},
+		},
+		"rollback": {
+			policy:          appsv1alpha1.RolloutFailurePolicyRollback,
+			wantPhase:       appsv1alpha1.RolloutRolledBack,
+			wantStableGroup: []string{"hangzhou", "beijing", "shanghai"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newTestController(t)
+			stable := newTestTemplate("nginx:1.24")
+			edgeApp := newTestEdgeApp(t, tc.policy, &stable)
+			first := planTestRollout(t, c, edgeApp)
+
+			// the first node group has been updating longer than the progress deadline
+			edgeApp.Status.RolloutStatus = first.status
+			edgeApp.Status.RolloutStatus.NodeGroups[0].LastTransitionTime =
+				metav1.NewTime(time.Now().Add(-2 * defaultProgressDeadlineSeconds * time.Second))
+			plan := planTestRollout(t, c, edgeApp)
+
+			if plan.status.Phase != tc.wantPhase {
+				t.Errorf("expected rollout phase %s, got %s", tc.wantPhase, plan.status.Phase)
+			}
+			if phase := groupPhases(plan.status)["hangzhou"]; phase != appsv1alpha1.NodeGroupRolloutFailed {
+				t.Errorf("expected node group hangzhou in phase Failed, got %s", phase)
+			}
+			if plan.stableGroups.Len() != len(tc.wantStableGroup) || !plan.stableGroups.HasAll(tc.wantStableGroup...) {
+				t.Errorf("expected stable groups %v, got %v", tc.wantStableGroup, plan.stableGroups)
+			}
+		})
+	}
+}
+
+func TestSyncEdgeApplicationRolloutPlanFailed(t *testing.T) {
+	c := newTestController(t)
+	stable := newTestTemplate("nginx:1.24")
+	edgeApp := newTestEdgeApp(t, appsv1alpha1.RolloutFailurePolicyPause, &stable)
+	// the stable revision cannot be parsed, so the rollout cannot be planned
+	edgeApp.Annotations[constants.StableWorkloadTemplateAnnotationKey] = "{"
+	if err := c.Client.Create(context.TODO(), edgeApp); err != nil {
+		t.Fatalf("failed to create edgeapp, %v", err)
+	}
+
+	if _, err := c.syncEdgeApplication(context.TODO(), edgeApp); err == nil {
+		t.Errorf("expected error when the rollout cannot be planned")
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := c.Client.List(context.TODO(), deployments); err != nil {
+		t.Fatalf("failed to list deployments, %v", err)
+	}
+	if len(deployments.Items) != 0 {
+		t.Errorf("expected no node group rendered with the update revision, got %d deployments", len(deployments.Items))
+	}
+	got := &appsv1alpha1.EdgeApplication{}
+	if err := c.Client.Get(context.TODO(), client.ObjectKeyFromObject(edgeApp), got); err != nil {
+		t.Fatalf("failed to get edgeapp, %v", err)
+	}
+	if anno, ok := got.Annotations[constants.StableWorkloadTemplateAnnotationKey]; !ok || anno != "{" {
+		t.Errorf("expected the stable template annotation unchanged, got %q", anno)
+	}
+}
+
+func TestUpdateStableTemplateAnnotationWithoutPlan(t *testing.T) {
+	c := newTestController(t)
+	stable := newTestTemplate("nginx:1.24")
+	edgeApp := newTestEdgeApp(t, appsv1alpha1.RolloutFailurePolicyPause, &stable)
+	if err := c.Client.Create(context.TODO(), edgeApp); err != nil {
+		t.Fatalf("failed to create edgeapp, %v", err)
+	}
+
+	if err := c.updateStableTemplateAnnotation(context.TODO(), edgeApp, nil, true); err != nil {
+		t.Fatalf("failed to update stable template annotation, %v", err)
+	}
+	got := &appsv1alpha1.EdgeApplication{}
+	if err := c.Client.Get(context.TODO(), client.ObjectKeyFromObject(edgeApp), got); err != nil {
+		t.Fatalf("failed to get edgeapp, %v", err)
+	}
+	if _, ok := got.Annotations[constants.StableWorkloadTemplateAnnotationKey]; !ok {
+		t.Errorf("expected the stable template annotation of a progressive rollout kept")
+	}
+}
+
+func TestPlanRolloutAfterEnablingProgressive(t *testing.T) {
+	c := newTestController(t)
+	edgeApp := newTestEdgeApp(t, "", nil)
+	edgeApp.Spec.WorkloadTemplate = newTestTemplate("nginx:1.24")
+	edgeApp.Spec.RolloutStrategy = nil
+	if err := c.Client.Create(context.TODO(), edgeApp); err != nil {
+		t.Fatalf("failed to create edgeapp, %v", err)
+	}
+	// the workload template applied without the progressive rollout is recorded as the stable one
+	if err := c.updateStableTemplateAnnotation(context.TODO(), edgeApp, nil, true); err != nil {
+		t.Fatalf("failed to update stable template annotation, %v", err)
+	}
+	got := &appsv1alpha1.EdgeApplication{}
+	if err := c.Client.Get(context.TODO(), client.ObjectKeyFromObject(edgeApp), got); err != nil {
+		t.Fatalf("failed to get edgeapp, %v", err)
+	}
+
+	// enable the progressive rollout and update the workload template at the same time
+	got.Spec.WorkloadTemplate = newTestTemplate("nginx:1.25")
+	got.Spec.RolloutStrategy = newTestEdgeApp(t, "", nil).Spec.RolloutStrategy
+	plan := planTestRollout(t, c, got)
+
+	stable := newTestTemplate("nginx:1.24")
+	stableRevision, err := computeRevision(&stable)
+	if err != nil {
+		t.Fatalf("failed to compute revision, %v", err)
+	}
+	if plan.status.StableRevision != stableRevision {
+		t.Errorf("expected stable revision %s, got %s", stableRevision, plan.status.StableRevision)
+	}
+	if plan.stableGroups.Len() != 2 {
+		t.Errorf("expected 2 node groups kept on the stable revision, got %v", plan.stableGroups)
+	}
+}
+
+func TestUpdateStableTemplateAnnotationNotApplied(t *testing.T) {
+	c := newTestController(t)
+	edgeApp := newTestEdgeApp(t, "", nil)
+	edgeApp.Spec.RolloutStrategy = nil
+	if err := c.Client.Create(context.TODO(), edgeApp); err != nil {
+		t.Fatalf("failed to create edgeapp, %v", err)
+	}
+
+	if err := c.updateStableTemplateAnnotation(context.TODO(), edgeApp, nil, false); err != nil {
+		t.Fatalf("failed to update stable template annotation, %v", err)
+	}
+	got := &appsv1alpha1.EdgeApplication{}
+	if err := c.Client.Get(context.TODO(), client.ObjectKeyFromObject(edgeApp), got); err != nil {
+		t.Fatalf("failed to get edgeapp, %v", err)
+	}
+	if _, ok := got.Annotations[constants.StableWorkloadTemplateAnnotationKey]; ok {
+		t.Errorf("expected the workload template not recorded before it has been applied")
+	}
+}
+
+func TestComputeRevision(t *testing.T) {
+	tmpl1, tmpl2 := newTestTemplate("nginx:1.24"), newTestTemplate("nginx:1.25")
+	rev1, err := computeRevision(&tmpl1)
+	if err != nil {
+		t.Fatalf("failed to compute revision, %v", err)
+	}
+	rev2, err := computeRevision(&tmpl2)
+	if err != nil {
+		t.Fatalf("failed to compute revision, %v", err)
+	}
+	if rev1 == rev2 {
+		t.Errorf("expected different revisions for different templates, got %s", rev1)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"sort"
 
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -48,6 +50,7 @@ func (r *statusReconciler) Reconcile(ctx context.Context, request controllerrunt
 }
 
 func (r *statusReconciler) sync(ctx context.Context, edgeApp *appsv1alpha1.EdgeApplication) (controllerruntime.Result, error) {
+	oldStatus := edgeApp.Status.DeepCopy()
 	tmplInfos, err := utils.GetTemplatesInfosOfEdgeApp(edgeApp, r.Serializer)
 	if err != nil {
 		klog.Errorf("failed to get infos of templates in edgeApp %s/%s, continue with what has been got, err: %v", edgeApp.Namespace, edgeApp.Name, err)
@@ -65,6 +68,23 @@ func (r *statusReconciler) sync(ctx context.Context, edgeApp *appsv1alpha1.EdgeA
 				klog.Errorf("failed to update status for edgeApp %s/%s, %v", edgeApp.Namespace, edgeApp.Name, err)
 				return controllerruntime.Result{Requeue: true}, err
 			}
+			// The obj is also rendered as a copy for each nodegroup which patches it.
+			for _, overrideInfo := range utils.GetAllOverriders(edgeApp) {
+				if !overridemanager.SelectedByJSONPatchOverriders(tmpl, overrideInfo) {
+					continue
+				}
+				tmplCopy := tmpl.DeepCopy()
+				if err := r.Overrider.ApplyOverrides(tmplCopy, overrideInfo); err != nil {
+					klog.Errorf("failed to apply overrides to template %s/%s of gvk %s when updating status of edgeapp %s/%s, %v",
+						tmplCopy.GetNamespace(), tmplCopy.GetName(), gvk, edgeApp.Namespace, edgeApp.Name, err)
+					continue
+				}
+				newTmplInfo := &utils.TemplateInfo{Ordinal: tmplInfo.Ordinal, Template: tmplCopy}
+				if err := r.updateStatus(ctx, edgeApp, newTmplInfo, availableIfExists{}); err != nil {
+					klog.Errorf("failed to update status for edgeApp %s/%s, %v", edgeApp.Namespace, edgeApp.Name, err)
+					return controllerruntime.Result{Requeue: true}, err
+				}
+			}
 		} else {
 			// Apply overriders to get the actual template that applied to
 			// each nodegroup. Currently, only NameOverrider is applied.
@@ -88,11 +108,23 @@ func (r *statusReconciler) sync(ctx context.Context, edgeApp *appsv1alpha1.EdgeA
 			}
 		}
 	}
-	// Trigger reconciliation of edgeapplication controller
+	if err := r.updateAggregatedStatus(ctx, edgeApp, tmplInfos); err != nil {
+		klog.Errorf("failed to update aggregated status for edgeApp %s/%s, %v", edgeApp.Namespace, edgeApp.Name, err)
+		return controllerruntime.Result{Requeue: true}, err
+	}
+	// Trigger reconciliation of edgeapplication controller if the status has changed.
 	// Considering that if an object created by edgeapplication controller is deleted by others,
-	// only this controller watches its event, thus if it's status in edgeapplication resource is unchanged,
-	// the deleted object will not be created until the next resync.
-	r.ReoncileTriggerChan <- event.GenericEvent{Object: edgeApp.DeepCopy()}
+	// only this controller watches its event, and the deletion changes the status of the object
+	// in edgeapplication resource, otherwise the deleted object will not be created until the next resync.
+	if !equality.Semantic.DeepEqual(oldStatus, &edgeApp.Status) {
+		r.ReoncileTriggerChan <- event.GenericEvent{Object: edgeApp.DeepCopy()}
+	}
+	if !meta.IsStatusConditionTrue(edgeApp.Status.Conditions, appsv1alpha1.EdgeAppConditionAvailable) {
+		// failures of pods do not always change the status of the workloads, check them
+		// again with the backoff of the rate limiter until all the workloads are available,
+		// the edgeapplication controller is not triggered unless the status changes
+		return controllerruntime.Result{Requeue: true}, nil
+	}
 	return controllerruntime.Result{}, nil
 }
 
@@ -200,7 +232,33 @@ func (d deploymentAvailable) IsAvailable(ctx context.Context, client client.Clie
 		return false, fmt.Errorf("failed to convert unstructured to deployment for %s/%s, %v", info.Namespace, info.Name, err)
 	}
 
-	return deploy.Status.ReadyReplicas == *deploy.Spec.Replicas, nil
+	return isDeploymentAvailable(deploy), nil
+}
+
+// isDeploymentAvailable checks whether the deployment controller has observed the latest
+// spec of the deployment and all the replicas have been updated and are ready.
+func isDeploymentAvailable(deploy *appsv1.Deployment) bool {
+	replicas := desiredReplicas(deploy)
+	return deploy.Status.ObservedGeneration >= deploy.Generation &&
+		deploy.Status.UpdatedReplicas == replicas &&
+		deploy.Status.ReadyReplicas == replicas
+}
+
+func desiredReplicas(deploy *appsv1.Deployment) int32 {
+	if deploy.Spec.Replicas != nil {
+		return *deploy.Spec.Replicas
+	}
+	return 1
+}
+
+// IsWorkloadAvailable checks whether the resource described by info is available.
+// Resources of kinds that will be overridden are checked by their workload status,
+// others are considered available once they exist.
+func IsWorkloadAvailable(ctx context.Context, client client.Client, info utils.ResourceInfo) (bool, error) {
+	if _, ok := constants.OverriderTargetGVK[infoToGVK(info)]; ok {
+		return deploymentAvailable{}.IsAvailable(ctx, client, info)
+	}
+	return availableIfExists{}.IsAvailable(ctx, client, info)
 }
 
 func getObjAccordingToResourceInfo(ctx context.Context, client client.Client, info utils.ResourceInfo) (*unstructured.Unstructured, error) {
@@ -0,0 +1,290 @@
+package statusmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/kubeedge/api/apis/apps/v1alpha1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/constants"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/overridemanager"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/utils"
+)
+
+const (
+	reasonAsExpected           = "AsExpected"
+	reasonWorkloadsAvailable   = "WorkloadsAvailable"
+	reasonWorkloadsUnavailable = "WorkloadsUnavailable"
+	reasonWorkloadsUpdating    = "WorkloadsUpdating"
+	reasonWorkloadsUpToDate    = "WorkloadsUpToDate"
+	reasonWorkloadNotFound     = "WorkloadNotFound"
+	reasonTargetsUnavailable   = "TargetsUnavailable"
+	reasonTargetsUpdating      = "TargetsUpdating"
+	reasonPodFailed            = "PodFailed"
+)
+
+// podFailureWaitingReasons are the reasons of waiting containers which indicate
+// that the containers cannot run without intervention.
+var podFailureWaitingReasons = sets.New[string](
+	"CrashLoopBackOff",
+	"ImagePullBackOff",
+	"ErrImagePull",
+	"InvalidImageName",
+	"CreateContainerConfigError",
+	"CreateContainerError",
+	"RunContainerError",
+)
+
+// updateAggregatedStatus rolls up the status of the workloads rendered for each target
+// into the TargetStatus and Conditions of the edgeApp.
+func (r *statusReconciler) updateAggregatedStatus(ctx context.Context, edgeApp *appsv1alpha1.EdgeApplication, tmplInfos []*utils.TemplateInfo) error {
+	targetStatus := []appsv1alpha1.TargetStatus{}
+	for _, info := range utils.GetAllOverriders(edgeApp) {
+		status, err := r.targetStatusOf(ctx, edgeApp, tmplInfos, info)
+		if err != nil {
+			return err
+		}
+		targetStatus = append(targetStatus, status)
+	}
+	conditions := aggregateConditions(edgeApp.Status.Conditions, targetStatus, edgeApp.Generation)
+
+	if equality.Semantic.DeepEqual(targetStatus, edgeApp.Status.TargetStatus) &&
+		equality.Semantic.DeepEqual(conditions, edgeApp.Status.Conditions) {
+		klog.V(4).Infof("aggregated status of edgeapp %s/%s is unchanged, skip update status", edgeApp.Namespace, edgeApp.Name)
+		return nil
+	}
+
+	// patch the aggregated status only, so that it does not conflict with the status
+	// written by the edgeapplication controller
+	newEdgeApp := edgeApp.DeepCopy()
+	newEdgeApp.Status.TargetStatus = targetStatus
+	newEdgeApp.Status.Conditions = conditions
+	if err := r.Client.Status().Patch(ctx, newEdgeApp, client.MergeFrom(edgeApp)); err != nil {
+		return fmt.Errorf("failed to patch aggregated status of EdgeApplication %s/%s, %v", edgeApp.Namespace, edgeApp.Name, err)
+	}
+	newEdgeApp.DeepCopyInto(edgeApp)
+	return nil
+}
+
+// targetStatusOf sums up the replicas of the workloads rendered for the target and
+// computes the conditions of the target.
+func (r *statusReconciler) targetStatusOf(
+	ctx context.Context,
+	edgeApp *appsv1alpha1.EdgeApplication,
+	tmplInfos []*utils.TemplateInfo,
+	info overridemanager.OverriderInfo) (appsv1alpha1.TargetStatus, error) {
+	status := appsv1alpha1.TargetStatus{Name: overridemanager.TargetName(info)}
+	for _, existing := range edgeApp.Status.TargetStatus {
+		if existing.Name == status.Name {
+			status.Conditions = append(status.Conditions, existing.Conditions...)
+			break
+		}
+	}
+
+	var missing, unavailable, updating []string
+	var failureReason, failureMessage string
+	for _, tmplInfo := range tmplInfos {
+		tmpl := tmplInfo.Template
+		if _, ok := constants.OverriderTargetGVK[tmpl.GroupVersionKind()]; !ok {
+			continue
+		}
+		tmplCopy := tmpl.DeepCopy()
+		if err := r.Overrider.ApplyOverrides(tmplCopy, info); err != nil {
+			return status, fmt.Errorf("failed to apply overrides to template %s/%s for target %s, %v",
+				tmpl.GetNamespace(), tmpl.GetName(), status.Name, err)
+		}
+		resourceInfo := utils.GetResourceInfoOfTemplateInfo(&utils.TemplateInfo{Ordinal: tmplInfo.Ordinal, Template: tmplCopy})
+		obj, err := getObjAccordingToResourceInfo(ctx, r.Client, resourceInfo)
+		if err != nil {
+			return status, err
+		}
+		if obj == nil {
+			missing = append(missing, resourceInfo.Name)
+			continue
+		}
+		deploy := &appsv1.Deployment{}
+		if err := r.Client.Scheme().Convert(obj, deploy, nil); err != nil {
+			return status, fmt.Errorf("failed to convert unstructured to deployment for %s/%s, %v",
+				resourceInfo.Namespace, resourceInfo.Name, err)
+		}
+
+		status.DesiredReplicas += desiredReplicas(deploy)
+		status.UpdatedReplicas += deploy.Status.UpdatedReplicas
+		status.ReadyReplicas += deploy.Status.ReadyReplicas
+		status.AvailableReplicas += deploy.Status.AvailableReplicas
+		if isDeploymentAvailable(deploy) {
+			continue
+		}
+		unavailable = append(unavailable, deploy.Name)
+		if deploy.Status.ObservedGeneration < deploy.Generation || deploy.Status.UpdatedReplicas < desiredReplicas(deploy) {
+			updating = append(updating, deploy.Name)
+		}
+		if failureReason == "" {
+			failureReason, failureMessage, err = r.failureOf(ctx, deploy)
+			if err != nil {
+				return status, err
+			}
+		}
+	}
+
+	switch {
+	case len(missing) != 0:
+		setCondition(&status.Conditions, appsv1alpha1.EdgeAppConditionAvailable, metav1.ConditionFalse, reasonWorkloadNotFound,
+			fmt.Sprintf("workloads %s are not found", strings.Join(missing, ", ")), edgeApp.Generation)
+	case len(unavailable) != 0:
+		setCondition(&status.Conditions, appsv1alpha1.EdgeAppConditionAvailable, metav1.ConditionFalse, reasonWorkloadsUnavailable,
+			fmt.Sprintf("%d/%d replicas are available", status.AvailableReplicas, status.DesiredReplicas), edgeApp.Generation)
+	default:
+		setCondition(&status.Conditions, appsv1alpha1.EdgeAppConditionAvailable, metav1.ConditionTrue, reasonWorkloadsAvailable,
+			fmt.Sprintf("%d/%d replicas are available", status.AvailableReplicas, status.DesiredReplicas), edgeApp.Generation)
+	}
+
+	if len(missing) != 0 || len(updating) != 0 {
+		setCondition(&status.Conditions, appsv1alpha1.EdgeAppConditionProgressing, metav1.ConditionTrue, reasonWorkloadsUpdating,
+			fmt.Sprintf("%d/%d replicas are updated", status.UpdatedReplicas, status.DesiredReplicas), edgeApp.Generation)
+	} else {
+		setCondition(&status.Conditions, appsv1alpha1.EdgeAppConditionProgressing, metav1.ConditionFalse, reasonWorkloadsUpToDate,
+			fmt.Sprintf("%d/%d replicas are updated", status.UpdatedReplicas, status.DesiredReplicas), edgeApp.Generation)
+	}
+
+	if failureReason != "" {
+		setCondition(&status.Conditions, appsv1alpha1.EdgeAppConditionDegraded, metav1.ConditionTrue,
+			failureReason, failureMessage, edgeApp.Generation)
+	} else {
+		setCondition(&status.Conditions, appsv1alpha1.EdgeAppConditionDegraded, metav1.ConditionFalse,
+			reasonAsExpected, "", edgeApp.Generation)
+	}
+	return status, nil
+}
+
+// failureOf returns the reason why the deployment fails to run. The failures of its pods
+// are preferred since they are more specific than the conditions of the deployment.
+func (r *statusReconciler) failureOf(ctx context.Context, deploy *appsv1.Deployment) (string, string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid selector of deployment %s/%s, %v", deploy.Namespace, deploy.Name, err)
+	}
+	pods := &corev1.PodList{}
+	if err := r.Client.List(ctx, pods, client.InNamespace(deploy.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return "", "", fmt.Errorf("failed to list pods of deployment %s/%s, %v", deploy.Namespace, deploy.Name, err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !isPodOfDeployment(pod, deploy) {
+			continue
+		}
+		if reason, message, failed := podFailure(pod); failed {
+			return reason, fmt.Sprintf("pod %s/%s: %s", pod.Namespace, pod.Name, message), nil
+		}
+	}
+
+	for _, cond := range deploy.Status.Conditions {
+		if cond.Type == appsv1.DeploymentReplicaFailure && cond.Status == corev1.ConditionTrue {
+			return cond.Reason, fmt.Sprintf("deployment %s/%s: %s", deploy.Namespace, deploy.Name, cond.Message), nil
+		}
+		if cond.Type == appsv1.DeploymentProgressing && cond.Status == corev1.ConditionFalse {
+			return cond.Reason, fmt.Sprintf("deployment %s/%s: %s", deploy.Namespace, deploy.Name, cond.Message), nil
+		}
+	}
+	return "", "", nil
+}
+
+// isPodOfDeployment checks whether the pod is created by a ReplicaSet of the deployment.
+// Deployments rendered for different targets may have the same selector, so the pods
+// cannot be distinguished by labels.
+func isPodOfDeployment(pod *corev1.Pod, deploy *appsv1.Deployment) bool {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "ReplicaSet" {
+		return false
+	}
+	hash, ok := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]
+	return ok && owner.Name == fmt.Sprintf("%s-%s", deploy.Name, hash)
+}
+
+// podFailure returns the reason and the message of the failure of the pod.
+func podFailure(pod *corev1.Pod) (string, string, bool) {
+	if pod.Status.Phase == corev1.PodFailed {
+		reason := pod.Status.Reason
+		if reason == "" {
+			reason = reasonPodFailed
+		}
+		return reason, pod.Status.Message, true
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+			return cond.Reason, cond.Message, true
+		}
+	}
+	containerStatuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+	containerStatuses = append(containerStatuses, pod.Status.ContainerStatuses...)
+	for _, status := range containerStatuses {
+		if waiting := status.State.Waiting; waiting != nil && podFailureWaitingReasons.Has(waiting.Reason) {
+			return waiting.Reason, fmt.Sprintf("container %s: %s", status.Name, waiting.Message), true
+		}
+	}
+	return "", "", false
+}
+
+// aggregateConditions computes the conditions of the edgeApp from the conditions of all the targets.
+func aggregateConditions(existing []metav1.Condition, targetStatus []appsv1alpha1.TargetStatus, generation int64) []metav1.Condition {
+	conditions := append([]metav1.Condition{}, existing...)
+
+	var unavailable, progressing, degraded []string
+	var degradedReason string
+	for _, status := range targetStatus {
+		if !meta.IsStatusConditionTrue(status.Conditions, appsv1alpha1.EdgeAppConditionAvailable) {
+			unavailable = append(unavailable, status.Name)
+		}
+		if meta.IsStatusConditionTrue(status.Conditions, appsv1alpha1.EdgeAppConditionProgressing) {
+			progressing = append(progressing, status.Name)
+		}
+		if cond := meta.FindStatusCondition(status.Conditions, appsv1alpha1.EdgeAppConditionDegraded); cond != nil && cond.Status == metav1.ConditionTrue {
+			if degradedReason == "" {
+				degradedReason = cond.Reason
+			}
+			degraded = append(degraded, fmt.Sprintf("%s: %s", status.Name, cond.Message))
+		}
+	}
+
+	if len(unavailable) != 0 {
+		setCondition(&conditions, appsv1alpha1.EdgeAppConditionAvailable, metav1.ConditionFalse, reasonTargetsUnavailable,
+			fmt.Sprintf("workloads in targets %s are not available", strings.Join(unavailable, ", ")), generation)
+	} else {
+		setCondition(&conditions, appsv1alpha1.EdgeAppConditionAvailable, metav1.ConditionTrue, reasonWorkloadsAvailable,
+			"workloads in all the targets are available", generation)
+	}
+	if len(progressing) != 0 {
+		setCondition(&conditions, appsv1alpha1.EdgeAppConditionProgressing, metav1.ConditionTrue, reasonTargetsUpdating,
+			fmt.Sprintf("workloads in targets %s are being updated", strings.Join(progressing, ", ")), generation)
+	} else {
+		setCondition(&conditions, appsv1alpha1.EdgeAppConditionProgressing, metav1.ConditionFalse, reasonWorkloadsUpToDate,
+			"workloads in all the targets are up to date", generation)
+	}
+	if len(degraded) != 0 {
+		setCondition(&conditions, appsv1alpha1.EdgeAppConditionDegraded, metav1.ConditionTrue, degradedReason,
+			strings.Join(degraded, "; "), generation)
+	} else {
+		setCondition(&conditions, appsv1alpha1.EdgeAppConditionDegraded, metav1.ConditionFalse, reasonAsExpected, "", generation)
+	}
+	return conditions
+}
+
+// setCondition sets the condition, LastTransitionTime is only changed when the status changes.
+func setCondition(conditions *[]metav1.Condition, condType string, status metav1.ConditionStatus, reason, message string, generation int64) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               condType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
@@ -0,0 +1,283 @@
+package statusmanager
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	appsv1alpha1 "github.com/kubeedge/api/apis/apps/v1alpha1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/overridemanager"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/utils"
+)
+
+func newTestDeployment(name string, replicas, ready int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: 1},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(replicas),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+			Replicas:           replicas,
+			UpdatedReplicas:    replicas,
+			ReadyReplicas:      ready,
+			AvailableReplicas:  ready,
+		},
+	}
+}
+
+func newTestPod(name, deployName string, waitingReason string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{"app": "nginx", appsv1.DefaultDeploymentUniqueLabelKey: "abc"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "ReplicaSet",
+				Name:       deployName + "-abc",
+				UID:        types.UID(deployName),
+				Controller: ptr.To(true),
+			}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: "nginx",
+				State: corev1.ContainerState{
+					Waiting: &corev1.ContainerStateWaiting{Reason: waitingReason, Message: "back-off pulling image"},
+				},
+			}},
+		},
+	}
+}
+
+func TestUpdateAggregatedStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme, %v", err)
+	}
+	if err := appsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add apps scheme, %v", err)
+	}
+
+	manifest := []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"nginx","namespace":"default"},` +
+		`"spec":{"replicas":2,"selector":{"matchLabels":{"app":"nginx"}},"template":{"spec":{"containers":[{"name":"nginx","image":"nginx"}]}}}}`)
+	edgeApp := &appsv1alpha1.EdgeApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Generation: 2},
+		Spec: appsv1alpha1.EdgeApplicationSpec{
+			WorkloadTemplate: appsv1alpha1.ResourceTemplate{
+				Manifests: []appsv1alpha1.Manifest{{RawExtension: runtime.RawExtension{Raw: manifest}}},
+			},
+			WorkloadScope: appsv1alpha1.WorkloadScope{
+				TargetNodeGroups: []appsv1alpha1.TargetNodeGroup{{Name: "hangzhou"}, {Name: "beijing"}, {Name: "shanghai"}},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&appsv1alpha1.EdgeApplication{}).
+		WithObjects(edgeApp,
+			newTestDeployment("nginx-hangzhou", 2, 2),
+			newTestDeployment("nginx-beijing", 2, 1),
+			newTestPod("nginx-beijing-abc-1", "nginx-beijing", "ImagePullBackOff"),
+			// pods of other deployments with the same labels should be ignored
+			newTestPod("nginx-hangzhou-abc-1", "nginx-hangzhou", "CrashLoopBackOff"),
+		).Build()
+
+	r := &statusReconciler{
+		Client: cli,
+		Serializer: jsonserializer.NewSerializerWithOptions(jsonserializer.DefaultMetaFactory,
+			scheme, scheme, jsonserializer.SerializerOptions{Yaml: true}),
+		Overrider: &overridemanager.NameOverrider{},
+	}
+	current := &appsv1alpha1.EdgeApplication{}
+	if err := cli.Get(context.TODO(), client.ObjectKeyFromObject(edgeApp), current); err != nil {
+		t.Fatalf("failed to get edgeapp, %v", err)
+	}
+	tmplInfos, err := utils.GetTemplatesInfosOfEdgeApp(current, r.Serializer)
+	if err != nil {
+		t.Fatalf("failed to get templates, %v", err)
+	}
+	// the edgeapplication controller writes the workload status after current is got
+	concurrent := current.DeepCopy()
+	concurrent.Status.WorkloadStatus = []appsv1alpha1.ManifestStatus{{Condition: appsv1alpha1.EdgeAppAvailable}}
+	if err := cli.Status().Update(context.TODO(), concurrent); err != nil {
+		t.Fatalf("failed to update workload status, %v", err)
+	}
+	if err := r.updateAggregatedStatus(context.TODO(), current, tmplInfos); err != nil {
+		t.Fatalf("failed to update aggregated status, %v", err)
+	}
+
+	got := &appsv1alpha1.EdgeApplication{}
+	if err := cli.Get(context.TODO(), client.ObjectKeyFromObject(edgeApp), got); err != nil {
+		t.Fatalf("failed to get edgeapp, %v", err)
+	}
+	if len(got.Status.WorkloadStatus) != 1 {
+		t.Errorf("expected the workload status to be kept, got %v", got.Status.WorkloadStatus)
+	}
+	if len(got.Status.TargetStatus) != 3 {
+		t.Fatalf("expected status of 3 targets, got %d", len(got.Status.TargetStatus))
+	}
+
+	cases := []struct {
+		name         string
+		ready        int32
+		available    metav1.ConditionStatus
+		availReason  string
+		degraded     metav1.ConditionStatus
+		degradReason string
+	}{
+		{name: "hangzhou", ready: 2, available: metav1.ConditionTrue, availReason: reasonWorkloadsAvailable,
+			degraded: metav1.ConditionFalse, degradReason: reasonAsExpected},
+		{name: "beijing", ready: 1, available: metav1.ConditionFalse, availReason: reasonWorkloadsUnavailable,
+			degraded: metav1.ConditionTrue, degradReason: "ImagePullBackOff"},
+		{name: "shanghai", ready: 0, available: metav1.ConditionFalse, availReason: reasonWorkloadNotFound,
+			degraded: metav1.ConditionFalse, degradReason: reasonAsExpected},
+	}
+	for i, tc := range cases {
+		status := got.Status.TargetStatus[i]
+		if status.Name != tc.name {
+			t.Errorf("expected target %s at %d, got %s", tc.name, i, status.Name)
+			continue
+		}
+		if status.ReadyReplicas != tc.ready {
+			t.Errorf("expected %d ready replicas in target %s, got %d", tc.ready, tc.name, status.ReadyReplicas)
+		}
+		available := meta.FindStatusCondition(status.Conditions, appsv1alpha1.EdgeAppConditionAvailable)
+		if available == nil || available.Status != tc.available || available.Reason != tc.availReason {
+			t.Errorf("unexpected Available condition of target %s, %v", tc.name, available)
+		}
+		degraded := meta.FindStatusCondition(status.Conditions, appsv1alpha1.EdgeAppConditionDegraded)
+		if degraded == nil || degraded.Status != tc.degraded || degraded.Reason != tc.degradReason {
+			t.Errorf("unexpected Degraded condition of target %s, %v", tc.name, degraded)
+		}
+	}
+
+	if meta.IsStatusConditionTrue(got.Status.Conditions, appsv1alpha1.EdgeAppConditionAvailable) {
+		t.Errorf("expected edgeapp to be unavailable")
+	}
+	degraded := meta.FindStatusCondition(got.Status.Conditions, appsv1alpha1.EdgeAppConditionDegraded)
+	if degraded == nil || degraded.Status != metav1.ConditionTrue || degraded.Reason != "ImagePullBackOff" {
+		t.Errorf("unexpected Degraded condition of edgeapp, %v", degraded)
+	}
+	if progressing := meta.FindStatusCondition(got.Status.Conditions, appsv1alpha1.EdgeAppConditionProgressing); progressing == nil ||
+		progressing.Status != metav1.ConditionTrue || progressing.ObservedGeneration != 2 {
+		t.Errorf("unexpected Progressing condition of edgeapp, %v", progressing)
+	}
+}
+
+func TestPodFailure(t *testing.T) {
+	cases := map[string]struct {
+		pod        *corev1.Pod
+		wantReason string
+		wantFailed bool
+	}{
+		"crash loop": {
+			pod:        newTestPod("pod", "nginx", "CrashLoopBackOff"),
+			wantReason: "CrashLoopBackOff",
+			wantFailed: true,
+		},
+		"creating": {
+			pod: newTestPod("pod", "nginx", "ContainerCreating"),
+		},
+		"unschedulable": {
+			pod: &corev1.Pod{Status: corev1.PodStatus{Conditions: []corev1.PodCondition{{
+				Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable,
+			}}}},
+			wantReason: corev1.PodReasonUnschedulable,
+			wantFailed: true,
+		},
+		"evicted": {
+			pod:        &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted"}},
+			wantReason: "Evicted",
+			wantFailed: true,
+		},
+		"failed without reason": {
+			pod:        &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed}},
+			wantReason: reasonPodFailed,
+			wantFailed: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reason, _, failed := podFailure(tc.pod)
+			if failed != tc.wantFailed || reason != tc.wantReason {
+				t.Errorf("expected failure %v with reason %q, got %v with reason %q", tc.wantFailed, tc.wantReason, failed, reason)
+			}
+		})
+	}
+}
+
+func TestSyncTriggersReconcileOnStatusChange(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme, %v", err)
+	}
+	if err := appsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add apps scheme, %v", err)
+	}
+
+	manifest := []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"nginx","namespace":"default"},` +
+		`"spec":{"replicas":2,"selector":{"matchLabels":{"app":"nginx"}},"template":{"spec":{"containers":[{"name":"nginx","image":"nginx"}]}}}}`)
+	edgeApp := &appsv1alpha1.EdgeApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Generation: 1},
+		Spec: appsv1alpha1.EdgeApplicationSpec{
+			WorkloadTemplate: appsv1alpha1.ResourceTemplate{
+				Manifests: []appsv1alpha1.Manifest{{RawExtension: runtime.RawExtension{Raw: manifest}}},
+			},
+			WorkloadScope: appsv1alpha1.WorkloadScope{
+				TargetNodeGroups: []appsv1alpha1.TargetNodeGroup{{Name: "hangzhou"}},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&appsv1alpha1.EdgeApplication{}).
+		WithObjects(edgeApp, newTestDeployment("nginx-hangzhou", 2, 1)).Build()
+
+	triggerChan := make(chan event.GenericEvent, 1)
+	r := &statusReconciler{
+		GroupVersionKind: appsv1.SchemeGroupVersion.WithKind("Deployment"),
+		Client:           cli,
+		Serializer: jsonserializer.NewSerializerWithOptions(jsonserializer.DefaultMetaFactory,
+			scheme, scheme, jsonserializer.SerializerOptions{Yaml: true}),
+		Overrider:           &overridemanager.NameOverrider{},
+		ReoncileTriggerChan: triggerChan,
+	}
+
+	for i, wantTrigger := range []bool{true, false} {
+		current := &appsv1alpha1.EdgeApplication{}
+		if err := cli.Get(context.TODO(), client.ObjectKeyFromObject(edgeApp), current); err != nil {
+			t.Fatalf("failed to get edgeapp, %v", err)
+		}
+		result, err := r.sync(context.TODO(), current)
+		if err != nil {
+			t.Fatalf("sync %d: unexpected error, %v", i, err)
+		}
+		if !result.Requeue {
+			t.Errorf("sync %d: expected the unavailable edgeapp to be requeued", i)
+		}
+		select {
+		case <-triggerChan:
+			if !wantTrigger {
+				t.Errorf("sync %d: expected no reconciliation to be triggered for unchanged status", i)
+			}
+		default:
+			if wantTrigger {
+				t.Errorf("sync %d: expected reconciliation to be triggered for changed status", i)
+			}
+		}
+	}
+}
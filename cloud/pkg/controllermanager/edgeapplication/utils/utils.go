@@ -11,6 +11,7 @@ import (
 
 	appsv1alpha1 "github.com/kubeedge/api/apis/apps/v1alpha1"
 	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/overridemanager"
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/nodegroup"
 )
 
 type ResourceInfo struct {
@@ -34,6 +35,18 @@ type TemplateInfo struct {
 }
 
 func IsNodeSelected(edgeapp appsv1alpha1.EdgeApplication, node core.Node) bool {
+	// The availability of nodes in the node groups with failover policy decides
+	// where the workloads are placed.
+	if belongingTo, ok := node.Labels[nodegroup.LabelBelongingTo]; ok {
+		for _, target := range edgeapp.Spec.WorkloadScope.TargetNodeGroups {
+			if target.Failover == nil {
+				continue
+			}
+			if target.Name == belongingTo || target.Failover.BackupNodeGroup == belongingTo {
+				return true
+			}
+		}
+	}
 	for _, selector := range edgeapp.Spec.WorkloadScope.TargetNodeLabels {
 		if selector.LabelSelector.MatchLabels != nil {
 			selected := true
@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -34,6 +35,9 @@ const (
 )
 
 var (
+	// summaryResourceNames are the resources whose allocatable amounts are summed up in the status of nodegroup.
+	summaryResourceNames = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourcePods}
+
 	conditionStatusReadyStatusMap = map[corev1.ConditionStatus]appsv1alpha1.ReadyStatus{
 		corev1.ConditionTrue:    appsv1alpha1.NodeReady,
 		corev1.ConditionFalse:   appsv1alpha1.NodeNotReady,
@@ -134,8 +138,10 @@ func (c *Controller) syncNodeGroup(ctx context.Context, nodeGroup *appsv1alpha1.
 	// This loop will
 	// 1. add or update belonging label for nodes
 	// 2. prepare NodeStatus for NodeGroup
+	// 3. sum up the capacity of ready member nodes
 	nodeStatusList := []appsv1alpha1.NodeStatus{}
 	existingNodes := sets.NewString()
+	newStatus := appsv1alpha1.NodeGroupStatus{}
 	for _, node := range newNodes {
 		existingNodes = existingNodes.Insert(node.Name)
 		nodeStatus := appsv1alpha1.NodeStatus{
@@ -153,6 +159,7 @@ func (c *Controller) syncNodeGroup(ctx context.Context, nodeGroup *appsv1alpha1.
 			nodeStatus.SelectionStatusReason = err.Error()
 		} else {
 			nodeStatus.SelectionStatus = appsv1alpha1.SucceededSelection
+			addNodeToSummary(&newStatus, &node, nodeStatus.ReadyStatus)
 		}
 		nodeStatusList = append(nodeStatusList, nodeStatus)
 	}
@@ -169,12 +176,13 @@ func (c *Controller) syncNodeGroup(ctx context.Context, nodeGroup *appsv1alpha1.
 	sort.Slice(nodeStatusList, func(i, j int) bool {
 		return nodeStatusList[i].NodeName < nodeStatusList[j].NodeName
 	})
-	if equality.Semantic.DeepEqual(nodeGroup.Status.NodeStatuses, nodeStatusList) {
+	newStatus.NodeStatuses = nodeStatusList
+	if equality.Semantic.DeepEqual(nodeGroup.Status, newStatus) {
 		klog.V(4).Infof("status of nodegroup is unchanged, skip update")
 		return controllerruntime.Result{}, nil
 	}
-	klog.V(4).Infof("status of nodegroup has changed, old: %v, new: %v", nodeGroup.Status.NodeStatuses, nodeStatusList)
-	nodeGroup.Status.NodeStatuses = nodeStatusList
+	klog.V(4).Infof("status of nodegroup has changed, old: %v, new: %v", nodeGroup.Status, newStatus)
+	nodeGroup.Status = newStatus
 	if err := c.Status().Update(ctx, nodeGroup); err != nil {
 		klog.Errorf("failed to update status for nodegroup %s, %s", nodeGroup.Name, err)
 		return controllerruntime.Result{Requeue: true}, nil
@@ -257,9 +265,10 @@ func (c *Controller) removeFinalizer(ctx context.Context, nodeGroup *appsv1alpha
 
 func (c *Controller) getNodesSelectedBy(ctx context.Context, nodeGroup *appsv1alpha1.NodeGroup) ([]corev1.Node, error) {
 	errs := []error{}
-	nodesByLabel, err := c.getNodesByLabels(ctx, nodeGroup.Spec.MatchLabels)
+	nodesByLabel, err := c.getNodesBySelector(ctx, nodeGroup)
 	if err != nil {
-		klog.Errorf("failed to get nodes by MatchLabels %v, %s", nodeGroup.Spec.MatchLabels, err)
+		klog.Errorf("failed to get nodes by MatchLabels %v and LabelSelector %v, %s",
+			nodeGroup.Spec.MatchLabels, nodeGroup.Spec.LabelSelector, err)
 		errs = append(errs, err)
 	}
 	klog.V(4).Infof("get %d nodes that match labels in nodegroup %s", len(nodesByLabel), nodeGroup.Name)
@@ -338,6 +347,24 @@ func (c *Controller) getNodesByLabels(ctx context.Context, matchLabels map[strin
 	return nodeList.Items, nil
 }
 
+// getNodesBySelector can get all nodes matching the MatchLabels and LabelSelector of the nodegroup.
+func (c *Controller) getNodesBySelector(ctx context.Context, nodeGroup *appsv1alpha1.NodeGroup) ([]corev1.Node, error) {
+	if !selectsByLabels(nodeGroup) {
+		// Return empty when no selector is specified, including an empty LabelSelector
+		// Otherwise, it will select all nodes, it's not what we want
+		return []corev1.Node{}, nil
+	}
+	selector, err := NodeGroupSelector(nodeGroup)
+	if err != nil {
+		return nil, err
+	}
+	nodeList := &corev1.NodeList{}
+	if err := c.Client.List(ctx, nodeList, &client.ListOptions{LabelSelector: selector}); err != nil {
+		return nil, err
+	}
+	return nodeList.Items, nil
+}
+
 // getNodesByNodeName can get all nodes specified by node names.
 func (c *Controller) getNodesByNodeName(ctx context.Context, nodeNames []string) ([]corev1.Node, error) {
 	errs := []error{}
@@ -408,11 +435,64 @@ func IfMatchNodeGroup(node *corev1.Node, nodegroup *appsv1alpha1.NodeGroup) bool
 			return true
 		}
 	}
-	// check if labels of this node selected by nodegroup.Spec.MatchLabels
-	selector := labels.SelectorFromSet(nodegroup.Spec.MatchLabels)
+	if !selectsByLabels(nodegroup) {
+		return false
+	}
+	// check if labels of this node selected by nodegroup.Spec.MatchLabels and nodegroup.Spec.LabelSelector
+	selector, err := NodeGroupSelector(nodegroup)
+	if err != nil {
+		klog.Errorf("invalid label selector of nodegroup %s, %v", nodegroup.Name, err)
+		return false
+	}
 	return selector.Matches(labels.Set(node.Labels))
 }
 
+// selectsByLabels checks whether the nodegroup selects nodes by labels. An empty LabelSelector
+// has no requirement and would match all the nodes, so it's taken as not specified.
+func selectsByLabels(nodegroup *appsv1alpha1.NodeGroup) bool {
+	if nodegroup.Spec.MatchLabels != nil {
+		return true
+	}
+	labelSelector := nodegroup.Spec.LabelSelector
+	return labelSelector != nil && (len(labelSelector.MatchLabels) != 0 || len(labelSelector.MatchExpressions) != 0)
+}
+
+// NodeGroupSelector returns the selector that combines the MatchLabels and LabelSelector of the nodegroup.
+func NodeGroupSelector(nodegroup *appsv1alpha1.NodeGroup) (labels.Selector, error) {
+	selector := labels.SelectorFromSet(nodegroup.Spec.MatchLabels)
+	if nodegroup.Spec.LabelSelector == nil {
+		return selector, nil
+	}
+	labelSelector, err := metav1.LabelSelectorAsSelector(nodegroup.Spec.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	requirements, _ := labelSelector.Requirements()
+	return selector.Add(requirements...), nil
+}
+
+// addNodeToSummary counts the member node in the status of nodegroup, and adds its
+// allocatable resources to the capacity of the nodegroup if it is ready.
+func addNodeToSummary(status *appsv1alpha1.NodeGroupStatus, node *corev1.Node, readyStatus appsv1alpha1.ReadyStatus) {
+	status.TotalNodes++
+	if readyStatus != appsv1alpha1.NodeReady {
+		return
+	}
+	status.ReadyNodes++
+	if status.Allocatable == nil {
+		status.Allocatable = corev1.ResourceList{}
+	}
+	for _, name := range summaryResourceNames {
+		quantity, ok := node.Status.Allocatable[name]
+		if !ok {
+			continue
+		}
+		sum := status.Allocatable[name]
+		sum.Add(quantity)
+		status.Allocatable[name] = sum
+	}
+}
+
 func getNodeReadyConditionFromNode(node *corev1.Node) (corev1.ConditionStatus, bool) {
 	for _, condition := range node.Status.Conditions {
 		if condition.Type == corev1.NodeReady {
@@ -6,7 +6,10 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsv1alpha1 "github.com/kubeedge/api/apis/apps/v1alpha1"
 )
 
 func TestNodesUnion(t *testing.T) {
@@ -124,3 +127,104 @@ func TestNodesUnion(t *testing.T) {
 		}
 	}
 }
+
+func TestIfMatchNodeGroup(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   "node1",
+			Labels: map[string]string{"region": "hangzhou", "arch": "arm64"},
+		},
+	}
+	cases := map[string]struct {
+		spec appsv1alpha1.NodeGroupSpec
+		want bool
+	}{
+		"no selector": {
+			spec: appsv1alpha1.NodeGroupSpec{Nodes: []string{"node2"}},
+			want: false,
+		},
+		"node name": {
+			spec: appsv1alpha1.NodeGroupSpec{Nodes: []string{"node1"}},
+			want: true,
+		},
+		"match labels": {
+			spec: appsv1alpha1.NodeGroupSpec{MatchLabels: map[string]string{"region": "hangzhou"}},
+			want: true,
+		},
+		"in expression": {
+			spec: appsv1alpha1.NodeGroupSpec{LabelSelector: &v1.LabelSelector{
+				MatchExpressions: []v1.LabelSelectorRequirement{
+					{Key: "region", Operator: v1.LabelSelectorOpIn, Values: []string{"hangzhou", "beijing"}},
+				},
+			}},
+			want: true,
+		},
+		"empty label selector": {
+			spec: appsv1alpha1.NodeGroupSpec{LabelSelector: &v1.LabelSelector{}},
+			want: false,
+		},
+		"match labels with empty label selector": {
+			spec: appsv1alpha1.NodeGroupSpec{
+				MatchLabels:   map[string]string{"region": "hangzhou"},
+				LabelSelector: &v1.LabelSelector{},
+			},
+			want: true,
+		},
+		"not in expression": {
+			spec: appsv1alpha1.NodeGroupSpec{LabelSelector: &v1.LabelSelector{
+				MatchExpressions: []v1.LabelSelectorRequirement{
+					{Key: "arch", Operator: v1.LabelSelectorOpNotIn, Values: []string{"arm64"}},
+				},
+			}},
+			want: false,
+		},
+		"match labels ANDed with expressions": {
+			spec: appsv1alpha1.NodeGroupSpec{
+				MatchLabels: map[string]string{"region": "hangzhou"},
+				LabelSelector: &v1.LabelSelector{
+					MatchExpressions: []v1.LabelSelectorRequirement{
+						{Key: "gpu", Operator: v1.LabelSelectorOpExists},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+	for n, c := range cases {
+		nodegroup := &appsv1alpha1.NodeGroup{ObjectMeta: v1.ObjectMeta{Name: "ng"}, Spec: c.spec}
+		if got := IfMatchNodeGroup(node, nodegroup); got != c.want {
+			t.Errorf("failed at case: %s, want: %v, got: %v", n, c.want, got)
+		}
+	}
+}
+
+func TestAddNodeToSummary(t *testing.T) {
+	newNode := func(cpu, memory string) *corev1.Node {
+		return &corev1.Node{
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:              resource.MustParse(cpu),
+					corev1.ResourceMemory:           resource.MustParse(memory),
+					corev1.ResourcePods:             resource.MustParse("110"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+				},
+			},
+		}
+	}
+	status := &appsv1alpha1.NodeGroupStatus{}
+	addNodeToSummary(status, newNode("2", "4Gi"), appsv1alpha1.NodeReady)
+	addNodeToSummary(status, newNode("500m", "1Gi"), appsv1alpha1.NodeReady)
+	addNodeToSummary(status, newNode("4", "8Gi"), appsv1alpha1.NodeNotReady)
+
+	if status.TotalNodes != 3 || status.ReadyNodes != 2 {
+		t.Errorf("want 2/3 ready nodes, got %d/%d", status.ReadyNodes, status.TotalNodes)
+	}
+	want := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2500m"),
+		corev1.ResourceMemory: resource.MustParse("5Gi"),
+		corev1.ResourcePods:   resource.MustParse("220"),
+	}
+	if !equality.Semantic.DeepEqual(status.Allocatable, want) {
+		t.Errorf("want allocatable %v, got %v", want, status.Allocatable)
+	}
+}
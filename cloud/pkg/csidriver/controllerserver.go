@@ -24,6 +24,7 @@ import (
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
 	"github.com/google/uuid"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
@@ -47,6 +48,9 @@ func newControllerServer(nodeID, kubeEdgeEndpoint string) *controllerServer {
 			[]csi.ControllerServiceCapability_RPC_Type{
 				csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME,
 				csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
+				csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
+				csi.ControllerServiceCapability_RPC_GET_CAPACITY,
+				csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
 			}),
 		nodeID:           nodeID,
 		kubeEdgeEndpoint: kubeEdgeEndpoint,
@@ -437,16 +441,116 @@ func getControllerServiceCapabilities(cl []csi.ControllerServiceCapability_RPC_T
 	return csc
 }
 
-func (cs *controllerServer) GetCapacity(context.Context, *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+// GetCapacity issues get capacity func
+func (cs *controllerServer) GetCapacity(_ context.Context, req *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
+	// the capacity isn't of any volume, so a random id is used to route the request like CreateVolume does
+	getCapacityResponse := &csi.GetCapacityResponse{}
+	if err := cs.forwardToKubeEdge(uuid.New().String(), constants.CSIOperationTypeGetCapacity, req, getCapacityResponse); err != nil {
+		return nil, err
+	}
+	klog.V(4).Infof("get capacity response: %v", getCapacityResponse)
+	return getCapacityResponse, nil
+}
+
+// ListVolumes issues list volumes func
+func (cs *controllerServer) ListVolumes(_ context.Context, req *csi.ListVolumesRequest) (*csi.ListVolumesResponse, error) {
+	if req.GetMaxEntries() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "ListVolumes max entries must not be negative")
+	}
+
+	listVolumesResponse := &csi.ListVolumesResponse{}
+	if err := cs.forwardToKubeEdge(uuid.New().String(), constants.CSIOperationTypeListVolumes, req, listVolumesResponse); err != nil {
+		return nil, err
+	}
+	klog.V(4).Infof("list volumes response: %v", listVolumesResponse)
+	return listVolumesResponse, nil
 }
 
-func (cs *controllerServer) ListVolumes(context.Context, *csi.ListVolumesRequest) (*csi.ListVolumesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+// ControllerExpandVolume issues controller expand volume func
+func (cs *controllerServer) ControllerExpandVolume(_ context.Context, req *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ControllerExpandVolume Volume ID must be provided")
+	}
+	if req.GetCapacityRange() == nil {
+		return nil, status.Error(codes.InvalidArgument, "ControllerExpandVolume Capacity Range must be provided")
+	}
+
+	controllerExpandVolumeResponse := &csi.ControllerExpandVolumeResponse{}
+	if err := cs.forwardToKubeEdge(req.GetVolumeId(), constants.CSIOperationTypeControllerExpandVolume, req, controllerExpandVolumeResponse); err != nil {
+		return nil, err
+	}
+	klog.V(4).Infof("controller expand volume response: %v", controllerExpandVolumeResponse)
+	return controllerExpandVolumeResponse, nil
 }
 
-func (cs *controllerServer) ControllerExpandVolume(context.Context, *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "ControllerExpandVolume is not yet implemented")
+// forwardToKubeEdge sends the request of the operation on the volume to the edge node through KubeEdge,
+// and unmarshals the result of the edge CSI plugin into response
+func (cs *controllerServer) forwardToKubeEdge(volumeID, operation string, req proto.Message, response interface{}) error {
+	// Build message struct
+	resource, err := buildResource(cs.nodeID,
+		DefaultNamespace,
+		constants.CSIResourceTypeVolume,
+		volumeID)
+	if err != nil {
+		klog.Errorf("build message resource failed with error: %s", err)
+		return err
+	}
+
+	m := jsonpb.Marshaler{}
+	js, err := m.MarshalToString(req)
+	if err != nil {
+		klog.Errorf("failed to marshal to string with error: %s", err)
+		return err
+	}
+	klog.V(4).Infof("%s marshal to string: %s", operation, js)
+	msg := model.NewMessage("").
+		BuildRouter(DefaultReceiveModuleName, GroupResource, resource, operation).
+		FillBody(js)
+
+	// Marshal message
+	reqData, err := json.Marshal(msg)
+	if err != nil {
+		klog.Errorf("marshal request failed with error: %v", err)
+		return err
+	}
+
+	// Send message to KubeEdge
+	resdata, err := sendToKubeEdge(string(reqData), cs.kubeEdgeEndpoint)
+	if err != nil {
+		klog.Errorf("send to kubeedge failed with error: %v", err)
+		return err
+	}
+
+	// Unmarshal message
+	result, err := extractMessage(resdata)
+	if err != nil {
+		klog.Errorf("unmarshal response failed with error: %v", err)
+		return err
+	}
+
+	klog.V(4).Infof("%s result: %v", operation, result)
+	data, ok := result.GetContent().(string)
+	if !ok {
+		klog.Errorf("content is not string type: %v", result.GetContent())
+		return fmt.Errorf("content type %T is not string", result.GetContent())
+	}
+
+	if result.GetOperation() == model.ResponseErrorOperation {
+		klog.Errorf("%s with error: %s", operation, data)
+		return errors.New(data)
+	}
+
+	decodeBytes, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		klog.Errorf("%s decode with error: %v", operation, err)
+		return err
+	}
+
+	if err := json.Unmarshal(decodeBytes, response); err != nil {
+		klog.Errorf("%s unmarshal with error: %v", operation, err)
+		return err
+	}
+	return nil
 }
 
 func (cs *controllerServer) CreateSnapshot(context.Context, *csi.CreateSnapshotRequest) (*csi.CreateSnapshotResponse, error) {
@@ -17,6 +17,8 @@ limitations under the License.
 package csidriver
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"testing"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
@@ -24,6 +26,9 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/common/constants"
 )
 
 func TestNewControllerServer(t *testing.T) {
@@ -42,6 +47,9 @@ func TestNewControllerServer(t *testing.T) {
 		[]csi.ControllerServiceCapability_RPC_Type{
 			csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME,
 			csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
+			csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
+			csi.ControllerServiceCapability_RPC_GET_CAPACITY,
+			csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
 		})
 	assert.Equal(expectedCaps, cs.caps)
 
@@ -49,6 +57,12 @@ func TestNewControllerServer(t *testing.T) {
 		cs.caps[0].GetRpc().GetType())
 	assert.Equal(csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
 		cs.caps[1].GetRpc().GetType())
+	assert.Equal(csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
+		cs.caps[2].GetRpc().GetType())
+	assert.Equal(csi.ControllerServiceCapability_RPC_GET_CAPACITY,
+		cs.caps[3].GetRpc().GetType())
+	assert.Equal(csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
+		cs.caps[4].GetRpc().GetType())
 }
 
 func TestValidateVolumeCapabilities(t *testing.T) {
@@ -171,3 +185,120 @@ func TestGetControllerServiceCapabilities(t *testing.T) {
 			"Capability %d should be %v", i, capType)
 	}
 }
+
+// serveKubeEdge accepts one request on the socket and replies it with the response built by respond
+func serveKubeEdge(t *testing.T, socketPath string, respond func(req *model.Message) *model.Message) <-chan *model.Message {
+	listener, err := setupSocket(t, socketPath)
+	if err != nil {
+		t.Fatalf("Failed to setup socket: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan *model.Message, 1)
+	go func() {
+		defer close(received)
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		buf := make([]byte, DefaultBufferSize)
+		nr, err := conn.Read(buf)
+		if err != nil {
+			return
+		}
+		req, err := extractMessage(string(buf[:nr]))
+		if err != nil {
+			return
+		}
+		received <- req
+		data, err := json.Marshal(respond(req))
+		if err != nil {
+			return
+		}
+		_, _ = conn.Write(data)
+	}()
+	return received
+}
+
+func TestControllerExpandVolume(t *testing.T) {
+	assert := assert.New(t)
+	socketPath := createTempSocketPath(t)
+	cs := newControllerServer("test-node", "unix://"+socketPath)
+
+	// Test case 1: Invalid request (missing volume ID)
+	result, err := cs.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{
+		CapacityRange: &csi.CapacityRange{RequiredBytes: 1024},
+	})
+	assert.Nil(result)
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Test case 2: Invalid request (missing capacity range)
+	result, err = cs.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{
+		VolumeId: "test-volume-id",
+	})
+	assert.Nil(result)
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Test case 3: Valid request forwarded to the edge
+	received := serveKubeEdge(t, socketPath, func(req *model.Message) *model.Message {
+		content, _ := json.Marshal(&csi.ControllerExpandVolumeResponse{CapacityBytes: 2048, NodeExpansionRequired: true})
+		return model.NewMessage(req.GetID()).FillBody(base64.StdEncoding.EncodeToString(content))
+	})
+	result, err = cs.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{
+		VolumeId:      "test-volume-id",
+		CapacityRange: &csi.CapacityRange{RequiredBytes: 2048},
+	})
+	assert.NoError(err)
+	assert.Equal(int64(2048), result.GetCapacityBytes())
+	assert.True(result.GetNodeExpansionRequired())
+
+	req := <-received
+	assert.Equal(constants.CSIOperationTypeControllerExpandVolume, req.GetOperation())
+	assert.Equal("node/test-node/"+DefaultNamespace+"/volume/test-volume-id", req.GetResource())
+}
+
+func TestGetCapacity(t *testing.T) {
+	assert := assert.New(t)
+	socketPath := createTempSocketPath(t)
+	cs := newControllerServer("test-node", "unix://"+socketPath)
+
+	received := serveKubeEdge(t, socketPath, func(req *model.Message) *model.Message {
+		content, _ := json.Marshal(&csi.GetCapacityResponse{AvailableCapacity: 4096})
+		return model.NewMessage(req.GetID()).FillBody(base64.StdEncoding.EncodeToString(content))
+	})
+	result, err := cs.GetCapacity(context.Background(), &csi.GetCapacityRequest{
+		Parameters: map[string]string{"type": "ssd"},
+	})
+	assert.NoError(err)
+	assert.Equal(int64(4096), result.GetAvailableCapacity())
+
+	req := <-received
+	assert.Equal(constants.CSIOperationTypeGetCapacity, req.GetOperation())
+	assert.Contains(req.GetResource(), "node/test-node/"+DefaultNamespace+"/volume/")
+}
+
+func TestListVolumes(t *testing.T) {
+	assert := assert.New(t)
+	socketPath := createTempSocketPath(t)
+	cs := newControllerServer("test-node", "unix://"+socketPath)
+
+	// Test case 1: Invalid request (negative max entries)
+	result, err := cs.ListVolumes(context.Background(), &csi.ListVolumesRequest{MaxEntries: -1})
+	assert.Nil(result)
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Test case 2: Error responded by the edge
+	received := serveKubeEdge(t, socketPath, func(req *model.Message) *model.Message {
+		return model.NewMessage(req.GetID()).
+			BuildRouter(req.GetSource(), req.GetGroup(), req.GetResource(), model.ResponseErrorOperation).
+			FillBody("list volumes failed")
+	})
+	result, err = cs.ListVolumes(context.Background(), &csi.ListVolumesRequest{MaxEntries: 10})
+	assert.Nil(result)
+	assert.EqualError(err, "list volumes failed")
+
+	req := <-received
+	assert.Equal(constants.CSIOperationTypeListVolumes, req.GetOperation())
+}
@@ -25,6 +25,9 @@ const (
 	DataTypeFloat   = "float"
 	DataTypeBoolean = "boolean"
 	DataTypeBytes   = "bytes"
+	DataTypeEnum    = "enum"
+	DataTypeObject  = "object"
+	DataTypeArray   = "array"
 
 	ResourceTypeDeviceModel  = "devicemodel"
 	ResourceTypeDevice       = "device"
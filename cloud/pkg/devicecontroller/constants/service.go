@@ -5,6 +5,9 @@ const (
 	ResourceTypeTwinEdgeUpdated  = "twin/edge_updated"
 	ResourceTypeMembershipDetail = "membership/detail"
 	ResourceDeviceStateUpdated   = "state/update"
+	ResourceDeviceAlarmUpdated   = "alarm/update"
+	ResourceDeviceDiscovered     = "discovered/report"
+	ResourceMapperStatus         = "mapper/status"
 
 	// Group
 	GroupTwin     = "twin"
@@ -0,0 +1,468 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	crdClientset "github.com/kubeedge/api/client/clientset/versioned"
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/kubeedge/pkg/util"
+)
+
+// deviceGroupStatusDelay is how long the status update of a device group is delayed after
+// its devices change, so that the changes of many devices are aggregated in one update
+const deviceGroupStatusDelay = time.Second
+
+// deviceGroupState is the state of a device in a device group
+type deviceGroupState int
+
+const (
+	deviceGroupPending deviceGroupState = iota
+	deviceGroupApplied
+	deviceGroupDrifted
+)
+
+// deviceGroupUpdater applies the desired state of the device groups to their devices.
+// The devices of the same edge node are updated at a limited rate, so that a group selecting
+// many devices doesn't flood CloudHub with device messages.
+type deviceGroupUpdater struct {
+	crdClient crdClientset.Interface
+	// devices, key is device.Namespace+"/"+device.Name, value is *v1beta1.Device{}
+	devices *sync.Map
+	// groups, key is group.Namespace+"/"+group.Name, value is *v1beta1.DeviceGroup{}
+	groups sync.Map
+	// queue of the keys of the devices to update
+	queue workqueue.DelayingInterface
+	// statusQueue of the keys of the groups whose status to update
+	statusQueue workqueue.DelayingInterface
+
+	updatesPerNode int32
+	limitersLock   sync.Mutex
+	// limiters, key is the node name
+	limiters map[string]*rate.Limiter
+}
+
+func newDeviceGroupUpdater(crdClient crdClientset.Interface, devices *sync.Map, updatesPerNode int32) *deviceGroupUpdater {
+	if updatesPerNode <= 0 {
+		updatesPerNode = 1
+	}
+	return &deviceGroupUpdater{
+		crdClient:      crdClient,
+		devices:        devices,
+		queue:          workqueue.NewNamedDelayingQueue("devicegroup"),
+		statusQueue:    workqueue.NewNamedDelayingQueue("devicegroup-status"),
+		updatesPerNode: updatesPerNode,
+		limiters:       make(map[string]*rate.Limiter),
+	}
+}
+
+// groupSelectsDevice returns whether the device is selected by the group.
+func groupSelectsDevice(group *v1beta1.DeviceGroup, device *v1beta1.Device) bool {
+	if group.Namespace != device.Namespace {
+		return false
+	}
+	if group.Spec.Selector == nil && group.Spec.DeviceModelRef == nil {
+		return false
+	}
+	if group.Spec.DeviceModelRef != nil &&
+		(device.Spec.DeviceModelRef == nil || device.Spec.DeviceModelRef.Name != group.Spec.DeviceModelRef.Name) {
+		return false
+	}
+	if group.Spec.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(group.Spec.Selector)
+		if err != nil {
+			klog.Warningf("Invalid selector of device group %s/%s, %v", group.Namespace, group.Name, err)
+			return false
+		}
+		if !selector.Matches(labels.Set(device.Labels)) {
+			return false
+		}
+	}
+	return true
+}
+
+// applyDeviceGroup sets the desired values and push methods of the group to the device,
+// it returns whether the device is changed.
+func applyDeviceGroup(group *v1beta1.DeviceGroup, device *v1beta1.Device) bool {
+	changed := false
+	for _, gp := range group.Spec.Properties {
+		for i := range device.Spec.Properties {
+			property := &device.Spec.Properties[i]
+			if property.Name != gp.Name {
+				continue
+			}
+			if gp.Desired != nil && !equality.Semantic.DeepEqual(property.Desired, *gp.Desired) {
+				property.Desired = *gp.Desired.DeepCopy()
+				changed = true
+			}
+			if gp.PushMethod != nil && !equality.Semantic.DeepEqual(property.PushMethod, gp.PushMethod) {
+				property.PushMethod = gp.PushMethod.DeepCopy()
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// mergeDeviceGroups applies the groups selecting the device to a copy of it in order, the groups
+// applied later take precedence. It also returns the keys of the groups which conflict on the
+// device, that is they set different desired values or push methods of the same property.
+func mergeDeviceGroups(groups []*v1beta1.DeviceGroup, device *v1beta1.Device) (*v1beta1.Device, map[string]bool) {
+	merged := device.DeepCopy()
+	// setters, key is the property name, value is the groups setting it
+	desiredSetters := map[string][]*v1beta1.DeviceGroup{}
+	pushMethodSetters := map[string][]*v1beta1.DeviceGroup{}
+	for _, group := range groups {
+		if !groupSelectsDevice(group, device) {
+			continue
+		}
+		applyDeviceGroup(group, merged)
+		for _, gp := range group.Spec.Properties {
+			if !hasProperty(device, gp.Name) {
+				continue
+			}
+			if gp.Desired != nil {
+				desiredSetters[gp.Name] = append(desiredSetters[gp.Name], group)
+			}
+			if gp.PushMethod != nil {
+				pushMethodSetters[gp.Name] = append(pushMethodSetters[gp.Name], group)
+			}
+		}
+	}
+
+	conflicts := map[string]bool{}
+	markConflicts := func(name string, setters []*v1beta1.DeviceGroup, equal func(a, b *v1beta1.DeviceGroupProperty) bool) {
+		for i := 1; i < len(setters); i++ {
+			if equal(groupProperty(setters[0], name), groupProperty(setters[i], name)) {
+				continue
+			}
+			for _, group := range setters {
+				conflicts[util.GetResourceID(group.Namespace, group.Name)] = true
+			}
+			return
+		}
+	}
+	for name, setters := range desiredSetters {
+		markConflicts(name, setters, func(a, b *v1beta1.DeviceGroupProperty) bool {
+			return equality.Semantic.DeepEqual(a.Desired, b.Desired)
+		})
+	}
+	for name, setters := range pushMethodSetters {
+		markConflicts(name, setters, func(a, b *v1beta1.DeviceGroupProperty) bool {
+			return equality.Semantic.DeepEqual(a.PushMethod, b.PushMethod)
+		})
+	}
+	return merged, conflicts
+}
+
+// groupProperty returns the property of the group with the name, the last one is returned
+// if the group has several properties with the name as it's the one applied.
+func groupProperty(group *v1beta1.DeviceGroup, name string) *v1beta1.DeviceGroupProperty {
+	var property *v1beta1.DeviceGroupProperty
+	for i := range group.Spec.Properties {
+		if group.Spec.Properties[i].Name == name {
+			property = &group.Spec.Properties[i]
+		}
+	}
+	return property
+}
+
+// getDeviceGroupState returns the state of the device in the group. The device is pending
+// until it's updated and the edge has received the desired values, then it's applied if
+// the reported values are the desired values, or drifted otherwise.
+func getDeviceGroupState(group *v1beta1.DeviceGroup, device *v1beta1.Device) deviceGroupState {
+	if applyDeviceGroup(group, device.DeepCopy()) {
+		return deviceGroupPending
+	}
+	state := deviceGroupApplied
+	for _, gp := range group.Spec.Properties {
+		if gp.Desired == nil || !hasProperty(device, gp.Name) {
+			continue
+		}
+		twin := findTwin(device, gp.Name)
+		if twin == nil || twin.ObservedDesired.Value != gp.Desired.Value {
+			return deviceGroupPending
+		}
+		if twin.Reported.Value != gp.Desired.Value {
+			state = deviceGroupDrifted
+		}
+	}
+	return state
+}
+
+func hasProperty(device *v1beta1.Device, name string) bool {
+	for i := range device.Spec.Properties {
+		if device.Spec.Properties[i].Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func findTwin(device *v1beta1.Device, name string) *v1beta1.Twin {
+	for i := range device.Status.Twins {
+		if device.Status.Twins[i].PropertyName == name {
+			return &device.Status.Twins[i]
+		}
+	}
+	return nil
+}
+
+// aggregateDeviceGroupStatus counts the devices of the group by their states, the devices on
+// which the group conflicts with the other groups are counted as conflicted only.
+func aggregateDeviceGroupStatus(group *v1beta1.DeviceGroup, groups []*v1beta1.DeviceGroup, devices []*v1beta1.Device) v1beta1.DeviceGroupStatus {
+	status := v1beta1.DeviceGroupStatus{ObservedGeneration: group.Generation}
+	groupID := util.GetResourceID(group.Namespace, group.Name)
+	for _, device := range devices {
+		if !groupSelectsDevice(group, device) {
+			continue
+		}
+		status.MatchedDevices++
+		if _, conflicts := mergeDeviceGroups(groups, device); conflicts[groupID] {
+			status.ConflictedDevices++
+			continue
+		}
+		switch getDeviceGroupState(group, device) {
+		case deviceGroupApplied:
+			status.AppliedDevices++
+		case deviceGroupDrifted:
+			status.DriftedDevices++
+		default:
+			status.PendingDevices++
+		}
+	}
+	return status
+}
+
+// listDevices returns the devices in the namespace known by the downstream controller
+func (u *deviceGroupUpdater) listDevices(namespace string) []*v1beta1.Device {
+	var devices []*v1beta1.Device
+	u.devices.Range(func(_, value interface{}) bool {
+		if device, ok := value.(*v1beta1.Device); ok && device.Namespace == namespace {
+			devices = append(devices, device)
+		}
+		return true
+	})
+	return devices
+}
+
+// listGroups returns the device groups in the namespace sorted by their names, the groups
+// applied later take precedence when several groups select the same device.
+func (u *deviceGroupUpdater) listGroups(namespace string) []*v1beta1.DeviceGroup {
+	var groups []*v1beta1.DeviceGroup
+	u.groups.Range(func(_, value interface{}) bool {
+		if group := value.(*v1beta1.DeviceGroup); group.Namespace == namespace {
+			groups = append(groups, group)
+		}
+		return true
+	})
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+	return groups
+}
+
+// groupChanged records the group, and enqueues the devices in its namespace which haven't got
+// the desired state of their groups and the status of the groups in its namespace.
+func (u *deviceGroupUpdater) groupChanged(e watch.Event) {
+	group, ok := e.Object.(*v1beta1.DeviceGroup)
+	if !ok {
+		klog.Warningf("Object type: %T unsupported", e.Object)
+		return
+	}
+	groupID := util.GetResourceID(group.Namespace, group.Name)
+	switch e.Type {
+	case watch.Added, watch.Modified:
+		value, ok := u.groups.Load(groupID)
+		u.groups.Store(groupID, group)
+		if ok && equality.Semantic.DeepEqual(value.(*v1beta1.DeviceGroup).Spec, group.Spec) {
+			// only the status is changed
+			return
+		}
+	case watch.Deleted:
+		// the devices keep the desired state of the deleted group unless another group sets it
+		u.groups.Delete(groupID)
+	default:
+		klog.Warningf("Device group event type: %s unsupported", e.Type)
+		return
+	}
+	groups := u.listGroups(group.Namespace)
+	for _, device := range u.listDevices(group.Namespace) {
+		u.enqueueDevice(groups, device)
+	}
+	for _, group := range groups {
+		u.statusQueue.Add(util.GetResourceID(group.Namespace, group.Name))
+	}
+}
+
+// deviceChanged enqueues the device if it hasn't got the desired state of its groups, and the
+// status of the groups in its namespace as the devices selected by them may change.
+func (u *deviceGroupUpdater) deviceChanged(e watch.Event) {
+	device, ok := e.Object.(*v1beta1.Device)
+	if !ok {
+		return
+	}
+	groups := u.listGroups(device.Namespace)
+	if e.Type != watch.Deleted {
+		u.enqueueDevice(groups, device)
+	}
+	for _, group := range groups {
+		u.statusQueue.AddAfter(util.GetResourceID(group.Namespace, group.Name), deviceGroupStatusDelay)
+	}
+}
+
+// enqueueDevice enqueues the device if the groups merged differ from its current spec.
+func (u *deviceGroupUpdater) enqueueDevice(groups []*v1beta1.DeviceGroup, device *v1beta1.Device) {
+	merged, _ := mergeDeviceGroups(groups, device)
+	if !equality.Semantic.DeepEqual(merged.Spec, device.Spec) {
+		u.queue.Add(util.GetResourceID(device.Namespace, device.Name))
+	}
+}
+
+// processNextGroup updates the status of a group in the status queue, it returns false
+// when the queue is shut down.
+func (u *deviceGroupUpdater) processNextGroup(ctx context.Context) bool {
+	item, shutdown := u.statusQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer u.statusQueue.Done(item)
+
+	key := item.(string)
+	value, ok := u.groups.Load(key)
+	if !ok {
+		return true
+	}
+	group := value.(*v1beta1.DeviceGroup)
+	status := aggregateDeviceGroupStatus(group, u.listGroups(group.Namespace), u.listDevices(group.Namespace))
+	if equality.Semantic.DeepEqual(status, group.Status) {
+		return true
+	}
+	updated := group.DeepCopy()
+	updated.Status = status
+	updated, err := u.crdClient.DevicesV1beta1().DeviceGroups(group.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+	if err != nil {
+		klog.Warningf("Failed to update status of device group %s, %v", key, err)
+		u.statusQueue.AddAfter(key, deviceGroupStatusDelay)
+		return true
+	}
+	u.groups.Store(key, updated)
+	return true
+}
+
+// limiter returns the rate limiter of the updates of the devices on the node.
+func (u *deviceGroupUpdater) limiter(nodeName string) *rate.Limiter {
+	u.limitersLock.Lock()
+	defer u.limitersLock.Unlock()
+	limiter, ok := u.limiters[nodeName]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(u.updatesPerNode), int(u.updatesPerNode))
+		u.limiters[nodeName] = limiter
+	}
+	return limiter
+}
+
+// processNextDevice updates a device in the queue, it returns false when the queue is shut down.
+func (u *deviceGroupUpdater) processNextDevice(ctx context.Context) bool {
+	item, shutdown := u.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer u.queue.Done(item)
+
+	key := item.(string)
+	value, ok := u.devices.Load(key)
+	if !ok {
+		return true
+	}
+	cached := value.(*v1beta1.Device)
+	reservation := u.limiter(cached.Spec.NodeName).Reserve()
+	if delay := reservation.Delay(); delay > 0 {
+		reservation.Cancel()
+		u.queue.AddAfter(key, delay)
+		return true
+	}
+
+	if err := u.updateDevice(ctx, cached.Namespace, cached.Name); err != nil {
+		klog.Warningf("Failed to apply device groups to device %s, %v", key, err)
+	}
+	return true
+}
+
+// updateDevice applies the groups selecting the device to it.
+func (u *deviceGroupUpdater) updateDevice(ctx context.Context, namespace, name string) error {
+	client := u.crdClient.DevicesV1beta1().Devices(namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		device, err := client.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		merged, _ := mergeDeviceGroups(u.listGroups(namespace), device)
+		if equality.Semantic.DeepEqual(merged.Spec, device.Spec) {
+			return nil
+		}
+		_, err = client.Update(ctx, merged, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// syncDeviceGroup is used to get device group events from informer and apply the device groups,
+// the device events are passed to the updater by syncDevice.
+func (dc *DownstreamController) syncDeviceGroup() {
+	ctx := beehiveContext.GetContext()
+	go func() {
+		<-ctx.Done()
+		dc.deviceGroupUpdater.queue.ShutDown()
+		dc.deviceGroupUpdater.statusQueue.ShutDown()
+	}()
+	go func() {
+		for dc.deviceGroupUpdater.processNextDevice(ctx) {
+		}
+	}()
+	go func() {
+		for dc.deviceGroupUpdater.processNextGroup(ctx) {
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			klog.Info("Stop syncDeviceGroup")
+			return
+		case e := <-dc.deviceGroupManager.Events():
+			dc.deviceGroupUpdater.groupChanged(e)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	"github.com/kubeedge/api/client/clientset/versioned/fake"
+)
+
+func newGroupDevice(name, model string, labels map[string]string) *v1beta1.Device {
+	return &v1beta1.Device{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Spec: v1beta1.DeviceSpec{
+			DeviceModelRef: &v1.LocalObjectReference{Name: model},
+			NodeName:       "edge-node",
+			Properties:     []v1beta1.DeviceProperty{{Name: "temperature-limit"}, {Name: "unit"}},
+		},
+	}
+}
+
+func newDeviceGroup() *v1beta1.DeviceGroup {
+	return &v1beta1.DeviceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "sensors", Namespace: "default", Generation: 2},
+		Spec: v1beta1.DeviceGroupSpec{
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"floor": "1"}},
+			DeviceModelRef: &v1.LocalObjectReference{Name: "sensor-model"},
+			Properties: []v1beta1.DeviceGroupProperty{
+				{Name: "temperature-limit", Desired: &v1beta1.TwinProperty{Value: "30"}},
+				{Name: "missing", Desired: &v1beta1.TwinProperty{Value: "1"}},
+			},
+		},
+	}
+}
+
+func TestGroupSelectsDevice(t *testing.T) {
+	group := newDeviceGroup()
+	assert.True(t, groupSelectsDevice(group, newGroupDevice("a", "sensor-model", map[string]string{"floor": "1"})))
+	assert.False(t, groupSelectsDevice(group, newGroupDevice("b", "sensor-model", map[string]string{"floor": "2"})))
+	assert.False(t, groupSelectsDevice(group, newGroupDevice("c", "other-model", map[string]string{"floor": "1"})))
+
+	group.Spec.Selector = nil
+	group.Spec.DeviceModelRef = nil
+	assert.False(t, groupSelectsDevice(group, newGroupDevice("a", "sensor-model", map[string]string{"floor": "1"})))
+}
+
+func TestGetDeviceGroupState(t *testing.T) {
+	group := newDeviceGroup()
+	device := newGroupDevice("a", "sensor-model", nil)
+	assert.Equal(t, deviceGroupPending, getDeviceGroupState(group, device))
+
+	assert.True(t, applyDeviceGroup(group, device))
+	assert.False(t, applyDeviceGroup(group, device))
+	assert.Equal(t, "30", device.Spec.Properties[0].Desired.Value)
+	assert.Equal(t, deviceGroupPending, getDeviceGroupState(group, device))
+
+	device.Status.Twins = []v1beta1.Twin{{
+		PropertyName:    "temperature-limit",
+		ObservedDesired: v1beta1.TwinProperty{Value: "30"},
+		Reported:        v1beta1.TwinProperty{Value: "30"},
+	}}
+	assert.Equal(t, deviceGroupApplied, getDeviceGroupState(group, device))
+
+	device.Status.Twins[0].Reported.Value = "25"
+	assert.Equal(t, deviceGroupDrifted, getDeviceGroupState(group, device))
+}
+
+func TestDeviceGroupUpdater(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.TODO()
+	group := newDeviceGroup()
+	selected := newGroupDevice("a", "sensor-model", map[string]string{"floor": "1"})
+	other := newGroupDevice("b", "sensor-model", map[string]string{"floor": "2"})
+	crdClient := fake.NewSimpleClientset(group, selected, other)
+
+	devices := &sync.Map{}
+	devices.Store("default/a", selected)
+	devices.Store("default/b", other)
+	updater := newDeviceGroupUpdater(crdClient, devices, 1)
+	defer updater.queue.ShutDown()
+	defer updater.statusQueue.ShutDown()
+
+	updater.groupChanged(watch.Event{Type: watch.Added, Object: group})
+	assert.Equal(1, updater.queue.Len())
+	assert.True(updater.processNextGroup(ctx))
+	updated, err := crdClient.DevicesV1beta1().DeviceGroups("default").Get(ctx, "sensors", metav1.GetOptions{})
+	assert.NoError(err)
+	assert.Equal(v1beta1.DeviceGroupStatus{ObservedGeneration: 2, MatchedDevices: 1, PendingDevices: 1}, updated.Status)
+
+	// the status update of the group doesn't enqueue the devices again
+	updater.groupChanged(watch.Event{Type: watch.Modified, Object: updated})
+	assert.Equal(0, updater.statusQueue.Len())
+
+	assert.True(updater.processNextDevice(ctx))
+	device, err := crdClient.DevicesV1beta1().Devices("default").Get(ctx, "a", metav1.GetOptions{})
+	assert.NoError(err)
+	assert.Equal("30", device.Spec.Properties[0].Desired.Value)
+	device, err = crdClient.DevicesV1beta1().Devices("default").Get(ctx, "b", metav1.GetOptions{})
+	assert.NoError(err)
+	assert.Empty(device.Spec.Properties[0].Desired.Value)
+
+	// the updates of the devices on the same node are rate limited
+	assert.Less(updater.limiter("edge-node").Tokens(), 1.0)
+	updater.queue.Add("default/a")
+	assert.True(updater.processNextDevice(ctx))
+	assert.Less(updater.limiter("edge-node").Tokens(), 1.0)
+	assert.Equal(0, updater.queue.Len())
+
+	// the updated device has got the desired state
+	device, err = crdClient.DevicesV1beta1().Devices("default").Get(ctx, "a", metav1.GetOptions{})
+	assert.NoError(err)
+	devices.Store("default/a", device)
+	updater.deviceChanged(watch.Event{Type: watch.Modified, Object: device})
+	assert.Equal(0, updater.queue.Len())
+}
+
+func TestDeviceGroupConflicts(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.TODO()
+	first := newDeviceGroup()
+	second := newDeviceGroup()
+	second.Name = "sensors-override"
+	second.Spec.Properties[0].Desired = &v1beta1.TwinProperty{Value: "40"}
+	third := newDeviceGroup()
+	third.Name = "units"
+	third.Spec.Properties = []v1beta1.DeviceGroupProperty{{Name: "unit", Desired: &v1beta1.TwinProperty{Value: "C"}}}
+	selected := newGroupDevice("a", "sensor-model", map[string]string{"floor": "1"})
+	groups := []*v1beta1.DeviceGroup{first, second, third}
+
+	merged, conflicts := mergeDeviceGroups(groups, selected)
+	assert.Equal("40", merged.Spec.Properties[0].Desired.Value)
+	assert.Equal("C", merged.Spec.Properties[1].Desired.Value)
+	assert.Equal(map[string]bool{"default/sensors": true, "default/sensors-override": true}, conflicts)
+	assert.Equal(int32(1), aggregateDeviceGroupStatus(first, groups, []*v1beta1.Device{selected}).ConflictedDevices)
+	assert.Equal(int32(0), aggregateDeviceGroupStatus(third, groups, []*v1beta1.Device{selected}).ConflictedDevices)
+
+	// the device which has got the merged state isn't updated again
+	crdClient := fake.NewSimpleClientset(merged)
+	devices := &sync.Map{}
+	devices.Store("default/a", merged)
+	updater := newDeviceGroupUpdater(crdClient, devices, 1)
+	defer updater.queue.ShutDown()
+	defer updater.statusQueue.ShutDown()
+	for _, group := range groups {
+		updater.groups.Store("default/"+group.Name, group)
+	}
+	updater.deviceChanged(watch.Event{Type: watch.Modified, Object: merged})
+	assert.Equal(0, updater.queue.Len())
+	assert.NoError(updater.updateDevice(ctx, "default", "a"))
+	for _, action := range crdClient.Actions() {
+		assert.NotEqual("update", action.GetVerb())
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/componentconfig/cloudcore/v1alpha1"
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	crdClientset "github.com/kubeedge/api/client/clientset/versioned"
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	utilcontext "github.com/kubeedge/kubeedge/cloud/pkg/common/context"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/messagelayer"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/config"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/types"
+)
+
+const (
+	// ApprovedByOperator is the approver of the discovered devices approved manually
+	ApprovedByOperator = "Operator"
+
+	defaultDiscoveredDeviceNamespace = "default"
+	// lastSeenTimeUpdateInterval is the minimum interval to update the LastSeenTime of a discovered
+	// device, so that the devices reported periodically by the mappers don't write the status every time
+	lastSeenTimeUpdateInterval = 5 * time.Minute
+)
+
+// updateDiscoveredDevices records the devices discovered on the edge node as DiscoveredDevice,
+// and provisions the approved ones.
+func (uc *UpstreamController) updateDiscoveredDevices(msg model.Message) {
+	klog.Infof("Message: %s, operation is: %s, and resource is: %s", msg.GetID(), msg.GetOperation(), msg.GetResource())
+	contentData, err := msg.GetContentData()
+	if err != nil {
+		klog.Warningf("Failed to get content of message %s, %v", msg.GetID(), err)
+		return
+	}
+	report := &types.DiscoveredDeviceReport{}
+	if err := json.Unmarshal(contentData, report); err != nil {
+		klog.Warningf("Unmarshall failed due to error %v", err)
+		return
+	}
+	nodeID, err := messagelayer.GetNodeID(msg)
+	if err != nil {
+		klog.Warningf("Message: %s process failure, get node id failed with error: %s", msg.GetID(), err)
+		return
+	}
+
+	ctx := utilcontext.FromMessage(context.Background(), msg)
+	for i := range report.Devices {
+		namespace, err := discoveredDeviceNamespace(nodeID, report.Devices[i].Namespace, config.Config.DeviceController)
+		if err != nil {
+			klog.Warningf("Failed to record device %s discovered on node %s, %v", report.Devices[i].Name, nodeID, err)
+			continue
+		}
+		discovered, err := recordDiscoveredDevice(ctx, uc.crdClient, nodeID, namespace, &report.Devices[i])
+		if err != nil {
+			klog.Warningf("Failed to record device %s discovered on node %s, %v", report.Devices[i].Name, nodeID, err)
+			continue
+		}
+		if discovered.Status.Phase == v1beta1.DiscoveredDeviceProvisioned {
+			continue
+		}
+		approvedBy := approverOf(discovered, config.Config.DiscoveryPolicies)
+		if approvedBy == "" {
+			continue
+		}
+		if err := provisionDiscoveredDevice(ctx, uc.crdClient, discovered, approvedBy); err != nil {
+			klog.Warningf("Failed to provision discovered device %s/%s, %v", discovered.Namespace, discovered.Name, err)
+		}
+	}
+}
+
+// discoveredDeviceNamespace returns the namespace to record the device discovered on the node in.
+// The devices are recorded in the configured namespace, the namespace reported by the mapper is
+// allowed only if it is the namespace of a discovery policy applying to the node.
+func discoveredDeviceNamespace(nodeID, namespace string, dc v1alpha1.DeviceController) (string, error) {
+	defaultNamespace := dc.DiscoveredDeviceNamespace
+	if defaultNamespace == "" {
+		defaultNamespace = defaultDiscoveredDeviceNamespace
+	}
+	if namespace == "" || namespace == defaultNamespace {
+		return defaultNamespace, nil
+	}
+	for _, policy := range dc.DiscoveryPolicies {
+		if policy.Namespace != namespace {
+			continue
+		}
+		if len(policy.NodeNames) == 0 || containsString(policy.NodeNames, nodeID) {
+			return namespace, nil
+		}
+	}
+	return "", fmt.Errorf("namespace %s is not allowed for the devices discovered on node %s", namespace, nodeID)
+}
+
+// recordDiscoveredDevice creates the DiscoveredDevice in the namespace or updates it with the latest
+// report, the device model set by the operator and the approval are kept.
+func recordDiscoveredDevice(ctx context.Context, crdClient crdClientset.Interface, nodeID, namespace string,
+	device *types.DiscoveredDevice) (*v1beta1.DiscoveredDevice, error) {
+	client := crdClient.DevicesV1beta1().DiscoveredDevices(namespace)
+	now := metav1.Now()
+
+	existing, err := client.Get(ctx, device.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		discovered := &v1beta1.DiscoveredDevice{
+			ObjectMeta: metav1.ObjectMeta{Name: device.Name, Namespace: namespace},
+			Spec:       device.Spec,
+		}
+		discovered.Spec.NodeName = nodeID
+		discovered.Spec.Approved = false
+		discovered, err = client.Create(ctx, discovered, metav1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		discovered.Status = v1beta1.DiscoveredDeviceStatus{
+			Phase:        v1beta1.DiscoveredDevicePending,
+			LastSeenTime: now,
+		}
+		return client.UpdateStatus(ctx, discovered, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if existing.Spec.NodeName != nodeID {
+		return nil, fmt.Errorf("the device has been discovered on node %s", existing.Spec.NodeName)
+	}
+
+	discovered := existing.DeepCopy()
+	discovered.Spec.MapperName = device.Spec.MapperName
+	discovered.Spec.Protocol = device.Spec.Protocol
+	discovered.Spec.Properties = device.Spec.Properties
+	discovered.Spec.Attributes = device.Spec.Attributes
+	if discovered.Spec.DeviceModelRef == nil {
+		discovered.Spec.DeviceModelRef = device.Spec.DeviceModelRef
+	}
+	if !equality.Semantic.DeepEqual(discovered.Spec, existing.Spec) {
+		discovered, err = client.Update(ctx, discovered, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if discovered.Status.Phase != "" && now.Sub(discovered.Status.LastSeenTime.Time) < lastSeenTimeUpdateInterval {
+		return discovered, nil
+	}
+	discovered.Status.LastSeenTime = now
+	if discovered.Status.Phase == "" {
+		discovered.Status.Phase = v1beta1.DiscoveredDevicePending
+	}
+	return client.UpdateStatus(ctx, discovered, metav1.UpdateOptions{})
+}
+
+// approverOf returns who approves the discovered device, it's empty if the device isn't approved.
+func approverOf(discovered *v1beta1.DiscoveredDevice, policies []v1alpha1.DeviceDiscoveryPolicy) string {
+	if discovered.Spec.Approved {
+		return ApprovedByOperator
+	}
+	if policy := matchDiscoveryPolicy(discovered, policies); policy != nil {
+		return policy.Name
+	}
+	return ""
+}
+
+// matchDiscoveryPolicy returns the first policy matching the discovered device. The devices
+// without a device model are never approved automatically.
+func matchDiscoveryPolicy(discovered *v1beta1.DiscoveredDevice, policies []v1alpha1.DeviceDiscoveryPolicy) *v1alpha1.DeviceDiscoveryPolicy {
+	if discovered.Spec.DeviceModelRef == nil || discovered.Spec.DeviceModelRef.Name == "" {
+		return nil
+	}
+	for i := range policies {
+		policy := &policies[i]
+		if policy.Protocol != "" && policy.Protocol != discovered.Spec.Protocol.ProtocolName {
+			continue
+		}
+		if policy.DeviceModel != "" && policy.DeviceModel != discovered.Spec.DeviceModelRef.Name {
+			continue
+		}
+		if policy.Namespace != "" && policy.Namespace != discovered.Namespace {
+			continue
+		}
+		if len(policy.NodeNames) != 0 && !containsString(policy.NodeNames, discovered.Spec.NodeName) {
+			continue
+		}
+		return policy
+	}
+	return nil
+}
+
+func containsString(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// provisionDiscoveredDevice creates the Device of the discovered device and records the result
+// in the status of the discovered device.
+func provisionDiscoveredDevice(ctx context.Context, crdClient crdClientset.Interface,
+	discovered *v1beta1.DiscoveredDevice, approvedBy string) error {
+	provisionErr := createDiscoveredDevice(ctx, crdClient, discovered)
+
+	discovered = discovered.DeepCopy()
+	discovered.Status.ApprovedBy = approvedBy
+	if provisionErr != nil {
+		discovered.Status.Phase = v1beta1.DiscoveredDeviceFailed
+		discovered.Status.Message = provisionErr.Error()
+	} else {
+		discovered.Status.Phase = v1beta1.DiscoveredDeviceProvisioned
+		discovered.Status.DeviceName = discovered.Name
+		discovered.Status.Message = ""
+	}
+	_, err := crdClient.DevicesV1beta1().DiscoveredDevices(discovered.Namespace).UpdateStatus(ctx, discovered, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	return provisionErr
+}
+
+func createDiscoveredDevice(ctx context.Context, crdClient crdClientset.Interface, discovered *v1beta1.DiscoveredDevice) error {
+	if discovered.Spec.DeviceModelRef == nil || discovered.Spec.DeviceModelRef.Name == "" {
+		return fmt.Errorf("deviceModelRef is required to provision the device")
+	}
+	device := &v1beta1.Device{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      discovered.Name,
+			Namespace: discovered.Namespace,
+			Labels:    discovered.Labels,
+		},
+		Spec: v1beta1.DeviceSpec{
+			DeviceModelRef: discovered.Spec.DeviceModelRef.DeepCopy(),
+			NodeName:       discovered.Spec.NodeName,
+			Properties:     discovered.Spec.Properties,
+			Protocol:       discovered.Spec.Protocol,
+		},
+	}
+	client := crdClient.DevicesV1beta1().Devices(discovered.Namespace)
+	_, err := client.Create(ctx, device, metav1.CreateOptions{})
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	// the device may have been provisioned by the report of the mapper and the approval concurrently
+	existing, err := client.Get(ctx, discovered.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if existing.Spec.NodeName != discovered.Spec.NodeName {
+		return fmt.Errorf("device %s/%s already exists on node %s", existing.Namespace, existing.Name, existing.Spec.NodeName)
+	}
+	return nil
+}
+
+// syncDiscoveredDevice provisions the discovered devices approved by the operator
+func (dc *DownstreamController) syncDiscoveredDevice() {
+	for {
+		select {
+		case <-beehiveContext.Done():
+			klog.Info("stop syncDiscoveredDevice")
+			return
+		case e := <-dc.discoveredDeviceManager.Events():
+			discovered, ok := e.Object.(*v1beta1.DiscoveredDevice)
+			if !ok {
+				klog.Warningf("object type: %T unsupported", e.Object)
+				continue
+			}
+			if e.Type != watch.Added && e.Type != watch.Modified {
+				continue
+			}
+			// the devices approved by policies and the devices failed to be provisioned are
+			// provisioned when they are reported by the mapper again
+			if !discovered.Spec.Approved || discovered.Status.Phase == v1beta1.DiscoveredDeviceProvisioned ||
+				discovered.Status.Phase == v1beta1.DiscoveredDeviceFailed {
+				continue
+			}
+			if err := provisionDiscoveredDevice(context.Background(), dc.crdClient, discovered, ApprovedByOperator); err != nil {
+				klog.Warningf("Failed to provision discovered device %s/%s, %v", discovered.Namespace, discovered.Name, err)
+			}
+		}
+	}
+}
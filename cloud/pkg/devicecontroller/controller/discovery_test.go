@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/api/apis/componentconfig/cloudcore/v1alpha1"
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	"github.com/kubeedge/api/client/clientset/versioned/fake"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/types"
+)
+
+func newTestDiscoveredDevice(model string) *types.DiscoveredDevice {
+	device := &types.DiscoveredDevice{
+		Name: "thermometer",
+		Spec: v1beta1.DiscoveredDeviceSpec{
+			MapperName: "modbus-mapper",
+			Protocol:   v1beta1.ProtocolConfig{ProtocolName: "modbus"},
+			Properties: []v1beta1.DeviceProperty{{Name: "temperature"}},
+		},
+	}
+	if model != "" {
+		device.Spec.DeviceModelRef = &v1.LocalObjectReference{Name: model}
+	}
+	return device
+}
+
+func TestMatchDiscoveryPolicy(t *testing.T) {
+	policies := []v1alpha1.DeviceDiscoveryPolicy{
+		{Name: "opcua", Protocol: "opcua"},
+		{Name: "thermometers", Protocol: "modbus", DeviceModel: "thermometer-model", NodeNames: []string{"edge-node"}},
+		{Name: "sandbox", Namespace: "sandbox"},
+	}
+	cases := []struct {
+		name      string
+		namespace string
+		node      string
+		model     string
+		expected  string
+	}{
+		{name: "match protocol, model and node", namespace: "default", node: "edge-node", model: "thermometer-model", expected: "thermometers"},
+		{name: "node not matched", namespace: "default", node: "other-node", model: "thermometer-model"},
+		{name: "match namespace", namespace: "sandbox", node: "other-node", model: "pump-model", expected: "sandbox"},
+		{name: "without device model", namespace: "sandbox", node: "edge-node"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			device := newTestDiscoveredDevice(tc.model)
+			discovered := &v1beta1.DiscoveredDevice{
+				ObjectMeta: metav1.ObjectMeta{Name: device.Name, Namespace: tc.namespace},
+				Spec:       device.Spec,
+			}
+			discovered.Spec.NodeName = tc.node
+			assert.Equal(t, tc.expected, approverOf(discovered, policies))
+		})
+	}
+}
+
+func TestDiscoveredDeviceNamespace(t *testing.T) {
+	dc := v1alpha1.DeviceController{
+		DiscoveryPolicies: []v1alpha1.DeviceDiscoveryPolicy{
+			{Name: "sandbox", Namespace: "sandbox", NodeNames: []string{"edge-node"}},
+		},
+	}
+	cases := []struct {
+		name      string
+		node      string
+		namespace string
+		configure string
+		expected  string
+		wantErr   bool
+	}{
+		{name: "default namespace", node: "edge-node", expected: "default"},
+		{name: "configured namespace", node: "edge-node", configure: "devices", expected: "devices"},
+		{name: "namespace of the policy", node: "edge-node", namespace: "sandbox", expected: "sandbox"},
+		{name: "policy not applying to the node", node: "other-node", namespace: "sandbox", wantErr: true},
+		{name: "namespace without policy", node: "edge-node", namespace: "kube-system", wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dc.DiscoveredDeviceNamespace = tc.configure
+			namespace, err := discoveredDeviceNamespace(tc.node, tc.namespace, dc)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, namespace)
+		})
+	}
+}
+
+func TestRecordAndProvisionDiscoveredDevice(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.TODO()
+	crdClient := fake.NewSimpleClientset()
+
+	discovered, err := recordDiscoveredDevice(ctx, crdClient, "edge-node", "default", newTestDiscoveredDevice(""))
+	assert.NoError(err)
+	assert.Equal("default", discovered.Namespace)
+	assert.Equal("edge-node", discovered.Spec.NodeName)
+	assert.Equal(v1beta1.DiscoveredDevicePending, discovered.Status.Phase)
+
+	// the device reported again in a short time doesn't update the status
+	crdClient.ClearActions()
+	_, err = recordDiscoveredDevice(ctx, crdClient, "edge-node", "default", newTestDiscoveredDevice(""))
+	assert.NoError(err)
+	for _, action := range crdClient.Actions() {
+		assert.NotEqual("status", action.GetSubresource())
+	}
+
+	// the device can't be provisioned without a device model
+	assert.Error(provisionDiscoveredDevice(ctx, crdClient, discovered, ApprovedByOperator))
+	discovered, err = crdClient.DevicesV1beta1().DiscoveredDevices("default").Get(ctx, "thermometer", metav1.GetOptions{})
+	assert.NoError(err)
+	assert.Equal(v1beta1.DiscoveredDeviceFailed, discovered.Status.Phase)
+
+	// the device model reported later is recorded
+	discovered, err = recordDiscoveredDevice(ctx, crdClient, "edge-node", "default", newTestDiscoveredDevice("thermometer-model"))
+	assert.NoError(err)
+	assert.Equal("thermometer-model", discovered.Spec.DeviceModelRef.Name)
+
+	assert.NoError(provisionDiscoveredDevice(ctx, crdClient, discovered, "thermometers"))
+	device, err := crdClient.DevicesV1beta1().Devices("default").Get(ctx, "thermometer", metav1.GetOptions{})
+	assert.NoError(err)
+	assert.Equal("edge-node", device.Spec.NodeName)
+	assert.Equal("modbus", device.Spec.Protocol.ProtocolName)
+	discovered, err = crdClient.DevicesV1beta1().DiscoveredDevices("default").Get(ctx, "thermometer", metav1.GetOptions{})
+	assert.NoError(err)
+	assert.Equal(v1beta1.DiscoveredDeviceProvisioned, discovered.Status.Phase)
+	assert.Equal("thermometers", discovered.Status.ApprovedBy)
+	assert.Equal("thermometer", discovered.Status.DeviceName)
+
+	// the same device discovered on another node is ignored
+	_, err = recordDiscoveredDevice(ctx, crdClient, "other-node", "default", newTestDiscoveredDevice("thermometer-model"))
+	assert.Error(err)
+}
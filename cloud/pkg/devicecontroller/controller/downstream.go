@@ -26,16 +26,22 @@ import (
 	"github.com/google/uuid"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
+	k8sinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/api/apis/devices/v1beta1"
+	crdClientset "github.com/kubeedge/api/client/clientset/versioned"
 	crdinformers "github.com/kubeedge/api/client/informers/externalversions"
+	devicelisters "github.com/kubeedge/api/client/listers/devices/v1beta1"
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
 	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/messagelayer"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/config"
 	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
 	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/manager"
 	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/types"
@@ -45,10 +51,19 @@ import (
 // DownstreamController watch kubernetes api server and send change to edge
 type DownstreamController struct {
 	kubeClient   kubernetes.Interface
+	crdClient    crdClientset.Interface
 	messageLayer messagelayer.MessageLayer
 
-	deviceManager      *manager.DeviceManager
-	deviceModelManager *manager.DeviceModelManager
+	deviceManager           *manager.DeviceManager
+	deviceModelManager      *manager.DeviceModelManager
+	discoveredDeviceManager *manager.DiscoveredDeviceManager
+	deviceGroupManager      *manager.DeviceGroupManager
+	deviceGroupUpdater      *deviceGroupUpdater
+	// mapperLister lists the mappers in the informer cache
+	mapperLister devicelisters.MapperLister
+	// nodeLister gets the nodes of the mappers in the informer cache
+	nodeLister  corelisters.NodeLister
+	nodesSynced cache.InformerSynced
 }
 
 // syncDeviceModel is used to get events from informer
@@ -121,7 +136,9 @@ func (dc *DownstreamController) syncDevice() {
 				dc.deviceDeleted(device)
 			default:
 				klog.Warningf("Device event type: %s unsupported", e.Type)
+				continue
 			}
+			dc.deviceGroupUpdater.deviceChanged(e)
 		}
 	}
 }
@@ -131,7 +148,7 @@ func (dc *DownstreamController) deviceAdded(device *v1beta1.Device) {
 	deviceID := util.GetResourceID(device.Namespace, device.Name)
 	dc.deviceManager.Device.Store(deviceID, device)
 	if device.Spec.NodeName != "" {
-		edgeDevice := createDevice(device)
+		edgeDevice := createDevice(device, dc.deviceModelOf(device))
 		msg := model.NewMessage("")
 
 		resource, err := messagelayer.BuildResourceForDevice(device.Spec.NodeName, "membership", "")
@@ -160,8 +177,9 @@ func (dc *DownstreamController) deviceAdded(device *v1beta1.Device) {
 	}
 }
 
-// createDevice creates a device from CRD
-func createDevice(device *v1beta1.Device) types.Device {
+// createDevice creates a device from CRD, the twins of the enum, object and array properties
+// in the device model carry their type metadata so that the twin values are validated at the edge.
+func createDevice(device *v1beta1.Device, deviceModel *v1beta1.DeviceModel) types.Device {
 	edgeDevice := types.Device{
 		// ID and name can be used as ID as we are using CRD and name(key in ETCD) will always be unique
 		ID:   util.GetResourceID(device.Namespace, device.Name),
@@ -173,9 +191,35 @@ func createDevice(device *v1beta1.Device) types.Device {
 		edgeDevice.Description = description
 	}
 
+	if deviceModel != nil {
+		for i := range deviceModel.Spec.Properties {
+			metadata := types.NewTypeMetadata(&deviceModel.Spec.Properties[i])
+			if metadata == nil {
+				continue
+			}
+			if edgeDevice.Twin == nil {
+				edgeDevice.Twin = make(map[string]*types.MsgTwin)
+			}
+			edgeDevice.Twin[deviceModel.Spec.Properties[i].Name] = &types.MsgTwin{Metadata: metadata}
+		}
+	}
+
 	return edgeDevice
 }
 
+// deviceModelOf returns the device model of the device in cache, nil is returned if it isn't found.
+func (dc *DownstreamController) deviceModelOf(device *v1beta1.Device) *v1beta1.DeviceModel {
+	if device.Spec.DeviceModelRef == nil {
+		return nil
+	}
+	value, ok := dc.deviceModelManager.DeviceModel.Load(util.GetResourceID(device.Namespace, device.Spec.DeviceModelRef.Name))
+	if !ok {
+		return nil
+	}
+	deviceModel, _ := value.(*v1beta1.DeviceModel)
+	return deviceModel
+}
+
 // isExistModel check if the target node already has the model.
 func isExistModel(deviceMap *sync.Map, device *v1beta1.Device) bool {
 	var res bool
@@ -249,7 +293,7 @@ func (dc *DownstreamController) deviceDeleted(device *v1beta1.Device) {
 	dc.deviceManager.Device.Delete(deviceID)
 
 	if device.Spec.NodeName != "" {
-		edgeDevice := createDevice(device)
+		edgeDevice := createDevice(device, nil)
 		msg := model.NewMessage("")
 
 		resource, err := messagelayer.BuildResourceForDevice(device.Spec.NodeName, "membership", "")
@@ -391,12 +435,14 @@ func (dc *DownstreamController) Start() error {
 	// TODO need to think about sync
 	time.Sleep(1 * time.Second)
 	go dc.syncDevice()
+	go dc.syncDiscoveredDevice()
+	go dc.syncDeviceGroup()
 
 	return nil
 }
 
 // NewDownstreamController create a DownstreamController from config
-func NewDownstreamController(crdInformerFactory crdinformers.SharedInformerFactory) (*DownstreamController, error) {
+func NewDownstreamController(k8sInformerFactory k8sinformers.SharedInformerFactory, crdInformerFactory crdinformers.SharedInformerFactory) (*DownstreamController, error) {
 	deviceManager, err := manager.NewDeviceManager(crdInformerFactory.Devices().V1beta1().Devices().Informer())
 	if err != nil {
 		klog.Warningf("Create device manager failed with error: %s", err)
@@ -409,12 +455,31 @@ func NewDownstreamController(crdInformerFactory crdinformers.SharedInformerFacto
 		return nil, err
 	}
 
+	discoveredDeviceManager, err := manager.NewDiscoveredDeviceManager(crdInformerFactory.Devices().V1beta1().DiscoveredDevices().Informer())
+	if err != nil {
+		klog.Warningf("Create discovered device manager failed with error: %s", err)
+		return nil, err
+	}
+
+	deviceGroupManager, err := manager.NewDeviceGroupManager(crdInformerFactory.Devices().V1beta1().DeviceGroups().Informer())
+	if err != nil {
+		klog.Warningf("Create device group manager failed with error: %s", err)
+		return nil, err
+	}
+
 	dc := &DownstreamController{
-		kubeClient:         client.GetKubeClient(),
-		deviceManager:      deviceManager,
-		deviceModelManager: deviceModelManager,
-		messageLayer:       messagelayer.DeviceControllerMessageLayer(),
+		kubeClient:              client.GetKubeClient(),
+		crdClient:               client.GetCRDClient(),
+		deviceManager:           deviceManager,
+		deviceModelManager:      deviceModelManager,
+		discoveredDeviceManager: discoveredDeviceManager,
+		mapperLister:            crdInformerFactory.Devices().V1beta1().Mappers().Lister(),
+		nodeLister:              k8sInformerFactory.Core().V1().Nodes().Lister(),
+		nodesSynced:             k8sInformerFactory.Core().V1().Nodes().Informer().HasSynced,
+		deviceGroupManager:      deviceGroupManager,
+		messageLayer:            messagelayer.DeviceControllerMessageLayer(),
 	}
+	dc.deviceGroupUpdater = newDeviceGroupUpdater(dc.crdClient, &deviceManager.Device, config.Config.Load.DeviceGroupUpdatesPerNode)
 	return dc, nil
 }
 
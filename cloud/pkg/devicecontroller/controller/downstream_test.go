@@ -20,8 +20,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/kubeedge/api/apis/devices/v1beta1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/types"
 )
 
 func TestRemoveTwinWithNameChanged(t *testing.T) {
@@ -120,3 +123,28 @@ func TestRemoveTwinWithNameChanged(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateDevice(t *testing.T) {
+	device := &v1beta1.Device{
+		ObjectMeta: metav1.ObjectMeta{Name: "sensor", Namespace: "default"},
+	}
+	deviceModel := &v1beta1.DeviceModel{
+		Spec: v1beta1.DeviceModelSpec{
+			Properties: []v1beta1.ModelProperty{
+				{Name: "temperature", Type: v1beta1.INT},
+				{Name: "mode", Type: v1beta1.ENUM, EnumValues: []string{"auto", "manual"}},
+				{Name: "position", Type: v1beta1.OBJECT, Schema: &runtime.RawExtension{Raw: []byte(`{"type":"object"}`)}},
+			},
+		},
+	}
+
+	edgeDevice := createDevice(device, deviceModel)
+	assert.Equal(t, "default/sensor", edgeDevice.ID)
+	assert.Equal(t, map[string]*types.MsgTwin{
+		"mode":     {Metadata: &types.TypeMetadata{Type: "enum", EnumValues: []string{"auto", "manual"}}},
+		"position": {Metadata: &types.TypeMetadata{Type: "object", Schema: `{"type":"object"}`}},
+	}, edgeDevice.Twin)
+
+	edgeDevice = createDevice(device, nil)
+	assert.Nil(t, edgeDevice.Twin)
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	crdClientset "github.com/kubeedge/api/client/clientset/versioned"
+	devicelisters "github.com/kubeedge/api/client/listers/devices/v1beta1"
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	utilcontext "github.com/kubeedge/kubeedge/cloud/pkg/common/context"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/messagelayer"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/types"
+	commonconst "github.com/kubeedge/kubeedge/common/constants"
+)
+
+const (
+	// MapperHealthyTimeout is how long a mapper is healthy after its last heartbeat,
+	// edgecore reports the mappers every minute and mappers send heartbeats every 30 seconds.
+	MapperHealthyTimeout = 2 * time.Minute
+	// MapperStaleTimeout is how long after its last heartbeat a mapper becomes stale
+	MapperStaleTimeout = 10 * time.Minute
+	// MapperGCTimeout is how long after its last heartbeat a mapper is deleted, edgecore
+	// stops reporting the mappers which are removed from the edge node
+	MapperGCTimeout = 24 * time.Hour
+
+	mapperSyncPeriod = time.Minute
+)
+
+// MapperObjectName returns the name of the Mapper of the mapper running on the node
+func MapperObjectName(nodeName, mapperName string) string {
+	return strings.ToLower(strings.ReplaceAll(nodeName+"-"+mapperName, "_", "-"))
+}
+
+// updateMappers records the mappers reported by the edge node as Mapper.
+func (uc *UpstreamController) updateMappers(msg model.Message) {
+	klog.Infof("Message: %s, operation is: %s, and resource is: %s", msg.GetID(), msg.GetOperation(), msg.GetResource())
+	contentData, err := msg.GetContentData()
+	if err != nil {
+		klog.Warningf("Failed to get content of message %s, %v", msg.GetID(), err)
+		return
+	}
+	report := &types.MapperStatusReport{}
+	if err := json.Unmarshal(contentData, report); err != nil {
+		klog.Warningf("Unmarshall failed due to error %v", err)
+		return
+	}
+	nodeID, err := messagelayer.GetNodeID(msg)
+	if err != nil {
+		klog.Warningf("Message: %s process failure, get node id failed with error: %s", msg.GetID(), err)
+		return
+	}
+
+	ctx := utilcontext.FromMessage(context.Background(), msg)
+	for i := range report.Mappers {
+		if err := updateMapper(ctx, uc.crdClient, nodeID, &report.Mappers[i], report.Timestamp, time.Now()); err != nil {
+			klog.Warningf("Failed to update mapper %s of node %s, %v", report.Mappers[i].Name, nodeID, err)
+		}
+	}
+}
+
+// updateMapper creates or updates the Mapper of the mapper running on the node. The times reported
+// by the edge node are converted to the time of the cloud by their ages when the report was made,
+// so that the clock skew of the edge node doesn't affect the health of the mapper.
+func updateMapper(ctx context.Context, crdClient crdClientset.Interface, nodeID string, info *types.MapperInfo,
+	reportTime int64, now time.Time) error {
+	client := crdClient.DevicesV1beta1().Mappers(commonconst.SystemNamespace)
+	spec := v1beta1.MapperSpec{
+		NodeName:   nodeID,
+		MapperName: info.Name,
+		Protocol:   info.Protocol,
+	}
+
+	name := MapperObjectName(nodeID, info.Name)
+	mapper, err := client.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		mapper, err = client.Create(ctx, &v1beta1.Mapper{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: commonconst.SystemNamespace,
+				Labels:    map[string]string{v1beta1.MapperNodeNameLabel: nodeID},
+			},
+			Spec: spec,
+		}, metav1.CreateOptions{})
+	} else if err == nil && !equality.Semantic.DeepEqual(mapper.Spec, spec) {
+		mapper.Spec = spec
+		mapper, err = client.Update(ctx, mapper, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return err
+	}
+
+	mapper.Status.Version = info.Version
+	mapper.Status.APIVersion = info.APIVersion
+	mapper.Status.Address = info.Address
+	mapper.Status.State = info.State
+	if info.RegisteredTime != 0 {
+		mapper.Status.RegisteredTime = metav1.NewTime(receivedTime(info.RegisteredTime, reportTime, now))
+	}
+	if info.LastHeartbeatTime != 0 {
+		mapper.Status.LastHeartbeatTime = metav1.NewTime(receivedTime(info.LastHeartbeatTime, reportTime, now))
+	}
+	setMapperConditions(&mapper.Status, now)
+	_, err = client.UpdateStatus(ctx, mapper, metav1.UpdateOptions{})
+	return err
+}
+
+// receivedTime converts the time in milliseconds of the edge node to the time of the cloud, the age of
+// the time at reportTime on the edge node is taken as its age at now. The time is treated as received
+// now if the report time is unknown or earlier than the time.
+func receivedTime(edgeTime, reportTime int64, now time.Time) time.Time {
+	if reportTime == 0 || reportTime < edgeTime {
+		return now
+	}
+	return now.Add(-time.Duration(reportTime-edgeTime) * time.Millisecond)
+}
+
+// syncMapperConditions updates the conditions of the mappers periodically, so that the mappers
+// of the edge nodes which stop reporting become unhealthy and stale, and deletes the mappers
+// which are gone.
+func (uc *UpstreamController) syncMapperConditions() {
+	// the mappers of the nodes not in the cache yet would be taken as gone
+	if !cache.WaitForCacheSync(beehiveContext.Done(), uc.dc.nodesSynced) {
+		klog.Info("Stop syncMapperConditions")
+		return
+	}
+	ticker := time.NewTicker(mapperSyncPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-beehiveContext.Done():
+			klog.Info("Stop syncMapperConditions")
+			return
+		case <-ticker.C:
+			syncMapperConditions(context.Background(), uc.crdClient, uc.dc.mapperLister, uc.dc.nodeLister, time.Now())
+		}
+	}
+}
+
+// syncMapperConditions updates the conditions of the mappers in the informer cache which are changed
+// at now, the mappers are not listed from the apiserver. The mappers whose nodes no longer exist or
+// which are not heard from in MapperGCTimeout are deleted.
+func syncMapperConditions(ctx context.Context, crdClient crdClientset.Interface, mapperLister devicelisters.MapperLister,
+	nodeLister corelisters.NodeLister, now time.Time) {
+	mappers, err := mapperLister.Mappers(commonconst.SystemNamespace).List(labels.Everything())
+	if err != nil {
+		klog.Warningf("Failed to list mappers, %v", err)
+		return
+	}
+	client := crdClient.DevicesV1beta1().Mappers(commonconst.SystemNamespace)
+	for _, cached := range mappers {
+		if reason := mapperGoneReason(cached, nodeLister, now); reason != "" {
+			klog.Infof("Delete mapper %s since %s", cached.Name, reason)
+			if err := client.Delete(ctx, cached.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				klog.Warningf("Failed to delete mapper %s, %v", cached.Name, err)
+			}
+			continue
+		}
+		status := cached.Status.DeepCopy()
+		setMapperConditions(status, now)
+		if equality.Semantic.DeepEqual(status.Conditions, cached.Status.Conditions) {
+			continue
+		}
+		mapper := cached.DeepCopy()
+		mapper.Status = *status
+		if _, err := client.UpdateStatus(ctx, mapper, metav1.UpdateOptions{}); err != nil {
+			klog.Warningf("Failed to update conditions of mapper %s, %v", mapper.Name, err)
+		}
+	}
+}
+
+// mapperGoneReason returns why the mapper is taken as gone, it's empty if the mapper isn't gone.
+func mapperGoneReason(mapper *v1beta1.Mapper, nodeLister corelisters.NodeLister, now time.Time) string {
+	if _, err := nodeLister.Get(mapper.Spec.NodeName); apierrors.IsNotFound(err) {
+		return fmt.Sprintf("its node %s no longer exists", mapper.Spec.NodeName)
+	}
+	lastSeen := mapper.Status.LastHeartbeatTime.Time
+	if lastSeen.IsZero() {
+		lastSeen = mapper.CreationTimestamp.Time
+	}
+	if now.Sub(lastSeen) > MapperGCTimeout {
+		return "nothing is heard from it in " + MapperGCTimeout.String()
+	}
+	return ""
+}
+
+// setMapperConditions sets the Registered, Healthy and Stale conditions by the registered time
+// and the last heartbeat time of the mapper.
+func setMapperConditions(status *v1beta1.MapperStatus, now time.Time) {
+	registered := metav1.Condition{
+		Type:    v1beta1.MapperConditionRegistered,
+		Status:  metav1.ConditionTrue,
+		Reason:  "MapperRegistered",
+		Message: "the mapper has registered to edgecore",
+	}
+	if status.RegisteredTime.IsZero() {
+		registered.Status = metav1.ConditionFalse
+		registered.Reason = "MapperNotRegistered"
+		registered.Message = "the mapper hasn't registered to edgecore"
+	}
+	meta.SetStatusCondition(&status.Conditions, registered)
+
+	sinceHeartbeat := now.Sub(status.LastHeartbeatTime.Time)
+	healthy := metav1.Condition{
+		Type:    v1beta1.MapperConditionHealthy,
+		Status:  metav1.ConditionTrue,
+		Reason:  "HeartbeatReceived",
+		Message: "the heartbeats of the mapper are received in time",
+	}
+	if status.LastHeartbeatTime.IsZero() || sinceHeartbeat > MapperHealthyTimeout {
+		healthy.Status = metav1.ConditionFalse
+		healthy.Reason = "HeartbeatMissed"
+		healthy.Message = "no heartbeat of the mapper is received in " + MapperHealthyTimeout.String()
+	}
+	meta.SetStatusCondition(&status.Conditions, healthy)
+
+	stale := metav1.Condition{
+		Type:    v1beta1.MapperConditionStale,
+		Status:  metav1.ConditionFalse,
+		Reason:  "HeartbeatReceived",
+		Message: "the mapper was heard from recently",
+	}
+	if status.LastHeartbeatTime.IsZero() || sinceHeartbeat > MapperStaleTimeout {
+		stale.Status = metav1.ConditionTrue
+		stale.Reason = "HeartbeatLost"
+		stale.Message = "nothing is heard from the mapper in " + MapperStaleTimeout.String()
+	}
+	meta.SetStatusCondition(&status.Conditions, stale)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	"github.com/kubeedge/api/client/clientset/versioned/fake"
+	devicelisters "github.com/kubeedge/api/client/listers/devices/v1beta1"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/types"
+	commonconst "github.com/kubeedge/kubeedge/common/constants"
+)
+
+func TestSetMapperConditions(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		name          string
+		lastHeartbeat time.Duration
+		healthy       bool
+		stale         bool
+	}{
+		{name: "healthy", lastHeartbeat: 30 * time.Second, healthy: true},
+		{name: "heartbeat missed", lastHeartbeat: 5 * time.Minute},
+		{name: "stale", lastHeartbeat: time.Hour, stale: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			status := &v1beta1.MapperStatus{
+				RegisteredTime:    metav1.NewTime(now.Add(-2 * time.Hour)),
+				LastHeartbeatTime: metav1.NewTime(now.Add(-tc.lastHeartbeat)),
+			}
+			setMapperConditions(status, now)
+			assert.True(t, meta.IsStatusConditionTrue(status.Conditions, v1beta1.MapperConditionRegistered))
+			assert.Equal(t, tc.healthy, meta.IsStatusConditionTrue(status.Conditions, v1beta1.MapperConditionHealthy))
+			assert.Equal(t, tc.stale, meta.IsStatusConditionTrue(status.Conditions, v1beta1.MapperConditionStale))
+		})
+	}
+}
+
+func TestUpdateMapper(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.TODO()
+	crdClient := fake.NewSimpleClientset()
+	now := time.Now()
+	// the clock of the edge node is three hours behind
+	reportTime := now.Add(-3 * time.Hour)
+	info := &types.MapperInfo{
+		Name:              "modbus_mapper",
+		Version:           "v1.0.0",
+		Protocol:          "modbus",
+		RegisteredTime:    reportTime.Add(-time.Hour).UnixMilli(),
+		LastHeartbeatTime: reportTime.Add(-10 * time.Second).UnixMilli(),
+	}
+
+	assert.NoError(updateMapper(ctx, crdClient, "edge-node", info, reportTime.UnixMilli(), now))
+	mapper, err := crdClient.DevicesV1beta1().Mappers(commonconst.SystemNamespace).Get(ctx, "edge-node-modbus-mapper", metav1.GetOptions{})
+	assert.NoError(err)
+	assert.Equal("edge-node", mapper.Spec.NodeName)
+	assert.Equal("modbus_mapper", mapper.Spec.MapperName)
+	assert.Equal("v1.0.0", mapper.Status.Version)
+	assert.Equal(now.Add(-10*time.Second).UnixMilli(), mapper.Status.LastHeartbeatTime.UnixMilli())
+	assert.True(meta.IsStatusConditionTrue(mapper.Status.Conditions, v1beta1.MapperConditionHealthy))
+
+	// the edge node stops reporting
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	assert.NoError(indexer.Add(mapper))
+	mapperLister := devicelisters.NewMapperLister(indexer)
+	nodeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.NoError(nodeIndexer.Add(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "edge-node"}}))
+	nodeLister := corelisters.NewNodeLister(nodeIndexer)
+	syncMapperConditions(ctx, crdClient, mapperLister, nodeLister, now.Add(time.Hour))
+	mapper, err = crdClient.DevicesV1beta1().Mappers(commonconst.SystemNamespace).Get(ctx, "edge-node-modbus-mapper", metav1.GetOptions{})
+	assert.NoError(err)
+	assert.False(meta.IsStatusConditionTrue(mapper.Status.Conditions, v1beta1.MapperConditionHealthy))
+	assert.True(meta.IsStatusConditionTrue(mapper.Status.Conditions, v1beta1.MapperConditionStale))
+
+	// nothing is heard from the mapper for a long time
+	syncMapperConditions(ctx, crdClient, mapperLister, nodeLister, now.Add(MapperGCTimeout+time.Minute))
+	_, err = crdClient.DevicesV1beta1().Mappers(commonconst.SystemNamespace).Get(ctx, "edge-node-modbus-mapper", metav1.GetOptions{})
+	assert.True(apierrors.IsNotFound(err))
+}
+
+func TestSyncMapperConditionsDeletesMappersOfDeletedNodes(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.TODO()
+	crdClient := fake.NewSimpleClientset()
+	now := time.Now()
+	info := &types.MapperInfo{
+		Name:              "modbus_mapper",
+		Protocol:          "modbus",
+		RegisteredTime:    now.UnixMilli(),
+		LastHeartbeatTime: now.UnixMilli(),
+	}
+	assert.NoError(updateMapper(ctx, crdClient, "edge-node", info, now.UnixMilli(), now))
+	assert.NoError(updateMapper(ctx, crdClient, "deleted-node", info, now.UnixMilli(), now))
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	mappers, err := crdClient.DevicesV1beta1().Mappers(commonconst.SystemNamespace).List(ctx, metav1.ListOptions{})
+	assert.NoError(err)
+	for i := range mappers.Items {
+		assert.NoError(indexer.Add(&mappers.Items[i]))
+	}
+	nodeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.NoError(nodeIndexer.Add(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "edge-node"}}))
+
+	syncMapperConditions(ctx, crdClient, devicelisters.NewMapperLister(indexer), corelisters.NewNodeLister(nodeIndexer), now)
+	_, err = crdClient.DevicesV1beta1().Mappers(commonconst.SystemNamespace).Get(ctx, "edge-node-modbus-mapper", metav1.GetOptions{})
+	assert.NoError(err)
+	_, err = crdClient.DevicesV1beta1().Mappers(commonconst.SystemNamespace).Get(ctx, "deleted-node-modbus-mapper", metav1.GetOptions{})
+	assert.True(apierrors.IsNotFound(err))
+}
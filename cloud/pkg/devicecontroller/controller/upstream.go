@@ -57,6 +57,12 @@ type UpstreamController struct {
 	deviceTwinsChan chan model.Message
 	// deviceStates message channel
 	deviceStatesChan chan model.Message
+	// deviceAlarms message channel
+	deviceAlarmsChan chan model.Message
+	// discoveredDevices message channel
+	discoveredDevicesChan chan model.Message
+	// mappers message channel
+	mappersChan chan model.Message
 	// downstream controller to update device status in cache
 	dc *DownstreamController
 }
@@ -67,7 +73,11 @@ func (uc *UpstreamController) Start() error {
 
 	uc.deviceTwinsChan = make(chan model.Message, config.Config.Buffer.UpdateDeviceTwins)
 	uc.deviceStatesChan = make(chan model.Message, config.Config.Buffer.UpdateDeviceStates)
+	uc.deviceAlarmsChan = make(chan model.Message, config.Config.Buffer.UpdateDeviceStates)
+	uc.discoveredDevicesChan = make(chan model.Message, config.Config.Buffer.UpdateDeviceStates)
+	uc.mappersChan = make(chan model.Message, config.Config.Buffer.UpdateDeviceStates)
 	go uc.dispatchMessage()
+	go uc.syncMapperConditions()
 
 	for i := 0; i < int(config.Config.Load.UpdateDeviceStatusWorkers); i++ {
 		go uc.updateDeviceStatus()
@@ -103,6 +113,12 @@ func (uc *UpstreamController) dispatchMessage() {
 			uc.deviceTwinsChan <- msg
 		case constants.ResourceDeviceStateUpdated:
 			uc.deviceStatesChan <- msg
+		case constants.ResourceDeviceAlarmUpdated:
+			uc.deviceAlarmsChan <- msg
+		case constants.ResourceDeviceDiscovered:
+			uc.discoveredDevicesChan <- msg
+		case constants.ResourceMapperStatus:
+			uc.mappersChan <- msg
 		case constants.ResourceTypeMembershipDetail:
 		default:
 			klog.Warningf("Message: %s, with resource type: %s not intended for device controller", msg.GetID(), resourceType)
@@ -176,6 +192,12 @@ func (uc *UpstreamController) updateDeviceStatus() {
 				continue
 			}
 			klog.Infof("Message: %s process successfully", msg.GetID())
+		case msg := <-uc.deviceAlarmsChan:
+			uc.updateDeviceAlarms(msg)
+		case msg := <-uc.discoveredDevicesChan:
+			uc.updateDiscoveredDevices(msg)
+		case msg := <-uc.mappersChan:
+			uc.updateMappers(msg)
 		case msg := <-uc.deviceTwinsChan:
 			klog.Infof("Message: %s, operation is: %s, and resource is: %s", msg.GetID(), msg.GetOperation(), msg.GetResource())
 			msgTwin, err := uc.unmarshalDeviceStatusMessage(msg)
@@ -268,6 +290,78 @@ func (uc *UpstreamController) updateDeviceStatus() {
 	}
 }
 
+// updateDeviceAlarms patches the active alarms raised by the edge node to the device status.
+func (uc *UpstreamController) updateDeviceAlarms(msg model.Message) {
+	klog.Infof("Message: %s, operation is: %s, and resource is: %s", msg.GetID(), msg.GetOperation(), msg.GetResource())
+	contentData, err := msg.GetContentData()
+	if err != nil {
+		klog.Warningf("Failed to get content of message %s, %v", msg.GetID(), err)
+		return
+	}
+	alarmUpdate := &types.DeviceAlarmUpdate{}
+	if err := json.Unmarshal(contentData, alarmUpdate); err != nil {
+		klog.Warningf("Unmarshall failed due to error %v", err)
+		return
+	}
+	deviceID, err := messagelayer.GetDeviceID(msg.GetResource())
+	if err != nil {
+		klog.Warning("Failed to get device id")
+		return
+	}
+	device, ok := uc.dc.deviceManager.Device.Load(deviceID)
+	if !ok {
+		klog.Warningf("Device %s does not exist in upstream controller", deviceID)
+		return
+	}
+	cacheDevice, ok := device.(*v1beta1.Device)
+	if !ok {
+		klog.Warning("Failed to assert to CacheDevice type")
+		return
+	}
+
+	alarms := alarmUpdate.Alarms
+	if alarms == nil {
+		alarms = []v1beta1.DeviceAlarm{}
+	}
+	// Store the status in cache so that when update is received by informer, it is not processed by downstream controller
+	cacheDevice.Status.Alarms = alarms
+	uc.dc.deviceManager.Device.Store(deviceID, cacheDevice)
+
+	// alarms is never nil so that the cleared alarms are removed by the merge patch
+	body, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{"alarms": alarms},
+	})
+	if err != nil {
+		klog.Errorf("Failed to marshal device alarms %v", alarms)
+		return
+	}
+	err = uc.crdClient.DevicesV1beta1().RESTClient().Patch(MergePatchType).Namespace(cacheDevice.Namespace).Resource(ResourceTypeDevices).Name(cacheDevice.Name).Body(body).Do(utilcontext.FromMessage(context.Background(), msg)).Error()
+	if err != nil {
+		klog.Errorf("Failed to patch device alarms of device %v in namespace %v, err: %v", deviceID, cacheDevice.Namespace, err)
+		return
+	}
+
+	//send confirm message to edge twin
+	resMsg := model.NewMessage(msg.GetID())
+	nodeID, err := messagelayer.GetNodeID(msg)
+	if err != nil {
+		klog.Warningf("Message: %s process failure, get node id failed with error: %s", msg.GetID(), err)
+		return
+	}
+	resource, err := messagelayer.BuildResourceForDevice(nodeID, "twin", "")
+	if err != nil {
+		klog.Warningf("Message: %s process failure, build message resource failed with error: %s", msg.GetID(), err)
+		return
+	}
+	resMsg.BuildRouter(modules.DeviceControllerModuleName, constants.GroupTwin, resource, model.ResponseOperation)
+	resMsg.Content = commonconst.MessageSuccessfulContent
+	if err = uc.messageLayer.Response(*resMsg); err != nil {
+		klog.Warningf("Message: %s process failure, response failed with error: %s", msg.GetID(), err)
+		return
+	}
+	klog.Infof("Message: %s process successfully", msg.GetID())
+}
+
 func (uc *UpstreamController) unmarshalDeviceStatusMessage(msg model.Message) (*types.DeviceTwinUpdate, error) {
 	contentData, err := msg.GetContentData()
 	if err != nil {
@@ -26,7 +26,8 @@ func newDeviceController(enable bool) *DeviceController {
 	if !enable {
 		return &DeviceController{enable: enable}
 	}
-	downstream, err := controller.NewDownstreamController(informers.GetInformersManager().GetKubeEdgeInformerFactory())
+	downstream, err := controller.NewDownstreamController(informers.GetInformersManager().GetKubeInformerFactory(),
+		informers.GetInformersManager().GetKubeEdgeInformerFactory())
 	if err != nil {
 		klog.Exitf("New downstream controller failed with error: %s", err)
 	}
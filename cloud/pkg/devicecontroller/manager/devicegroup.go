@@ -0,0 +1,31 @@
+package manager
+
+import (
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/config"
+)
+
+// DeviceGroupManager is a manager watch DeviceGroup change event
+type DeviceGroupManager struct {
+	// events from watch kubernetes api server
+	events chan watch.Event
+}
+
+// Events return a channel, can receive all DeviceGroup event
+func (dgm *DeviceGroupManager) Events() chan watch.Event {
+	return dgm.events
+}
+
+// NewDeviceGroupManager create DeviceGroupManager from config
+func NewDeviceGroupManager(si cache.SharedIndexInformer) (*DeviceGroupManager, error) {
+	events := make(chan watch.Event, config.Config.Buffer.DeviceEvent)
+	rh := NewCommonResourceEventHandler(events)
+	_, err := si.AddEventHandler(rh)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeviceGroupManager{events: events}, nil
+}
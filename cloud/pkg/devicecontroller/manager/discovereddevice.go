@@ -0,0 +1,31 @@
+package manager
+
+import (
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/config"
+)
+
+// DiscoveredDeviceManager is a manager watch DiscoveredDevice change event
+type DiscoveredDeviceManager struct {
+	// events from watch kubernetes api server
+	events chan watch.Event
+}
+
+// Events return a channel, can receive all DiscoveredDevice event
+func (ddm *DiscoveredDeviceManager) Events() chan watch.Event {
+	return ddm.events
+}
+
+// NewDiscoveredDeviceManager create DiscoveredDeviceManager from config
+func NewDiscoveredDeviceManager(si cache.SharedIndexInformer) (*DiscoveredDeviceManager, error) {
+	events := make(chan watch.Event, config.Config.Buffer.DeviceEvent)
+	rh := NewCommonResourceEventHandler(events)
+	_, err := si.AddEventHandler(rh)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiscoveredDeviceManager{events: events}, nil
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"strings"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+)
+
 // Device the struct of device
 type Device struct {
 	ID             string              `json:"id,omitempty"`
@@ -76,6 +82,27 @@ type TwinVersion struct {
 // TypeMetadata the meta of value type
 type TypeMetadata struct {
 	Type string `json:"type,omitempty"`
+	// EnumValues are the allowed values of the enum type
+	EnumValues []string `json:"enumValues,omitempty"`
+	// Schema is the JSON schema fragment of the object or array type
+	Schema string `json:"schema,omitempty"`
+}
+
+// NewTypeMetadata returns the type metadata of the twin of the model property if the property is
+// of enum, object or array type, the metadata is needed to validate the twin value.
+func NewTypeMetadata(property *v1beta1.ModelProperty) *TypeMetadata {
+	switch property.Type {
+	case v1beta1.ENUM, v1beta1.OBJECT, v1beta1.ARRAY:
+		metadata := &TypeMetadata{
+			Type:       strings.ToLower(string(property.Type)),
+			EnumValues: property.EnumValues,
+		}
+		if property.Schema != nil {
+			metadata.Schema = string(property.Schema.Raw)
+		}
+		return metadata
+	}
+	return nil
 }
 
 // ValueMetadata the meta of value
@@ -112,3 +139,49 @@ type DeviceStateUpdate struct {
 	BaseMessage
 	Device Device
 }
+
+// DeviceAlarmUpdate the struct of device alarm update, Alarms are all the active alarms of the device
+type DeviceAlarmUpdate struct {
+	BaseMessage
+	Raised  []v1beta1.DeviceAlarm `json:"raised,omitempty"`
+	Cleared []v1beta1.DeviceAlarm `json:"cleared,omitempty"`
+	Alarms  []v1beta1.DeviceAlarm `json:"alarms"`
+}
+
+// DeviceAlarmEvent the struct of device alarm raised or cleared event
+type DeviceAlarmEvent struct {
+	BaseMessage
+	Alarm v1beta1.DeviceAlarm `json:"alarm"`
+}
+
+// DiscoveredDeviceReport the struct of devices discovered by a mapper on the edge node
+type DiscoveredDeviceReport struct {
+	BaseMessage
+	MapperName string             `json:"mapperName,omitempty"`
+	Devices    []DiscoveredDevice `json:"devices"`
+}
+
+// DiscoveredDevice the struct of a device discovered by a mapper
+type DiscoveredDevice struct {
+	Name      string                       `json:"name"`
+	Namespace string                       `json:"namespace,omitempty"`
+	Spec      v1beta1.DiscoveredDeviceSpec `json:"spec"`
+}
+
+// MapperStatusReport the struct of the mappers registered on the edge node
+type MapperStatusReport struct {
+	BaseMessage
+	Mappers []MapperInfo `json:"mappers"`
+}
+
+// MapperInfo the struct of a mapper, the times are unix timestamps in milliseconds
+type MapperInfo struct {
+	Name              string `json:"name"`
+	Version           string `json:"version,omitempty"`
+	APIVersion        string `json:"apiVersion,omitempty"`
+	Protocol          string `json:"protocol,omitempty"`
+	Address           string `json:"address,omitempty"`
+	State             string `json:"state,omitempty"`
+	RegisteredTime    int64  `json:"registeredTime"`
+	LastHeartbeatTime int64  `json:"lastHeartbeatTime"`
+}
@@ -28,6 +28,8 @@ const (
 	DefaultCheckNodeURL       = "/node/{nodename}"
 	DefaultNodeUpgradeURL     = "/nodeupgrade"
 	DefaultTaskStateReportURL = "/task/{taskType}/name/{taskID}/node/{nodeID}/status"
+	DefaultDebugSessionsURL   = "/debug/sessions"
+	DefaultForwardMessageURL  = "/messages/forward"
 
 	// update PodSandboxImage version when bumping k8s vendor version, consistent with vendor/k8s.io/kubernetes/cmd/kubelet/app/options/container_runtime.go defaultPodSandboxImageVersion
 	// When this value are updated, also update comments in pkg/apis/componentconfig/edgecore/v1alpha1/types.go
@@ -61,6 +63,9 @@ const (
 	CSIOperationTypeDeleteVolume              = "deletevolume"
 	CSIOperationTypeControllerPublishVolume   = "controllerpublishvolume"
 	CSIOperationTypeControllerUnpublishVolume = "controllerunpublishvolume"
+	CSIOperationTypeControllerExpandVolume    = "controllerexpandvolume"
+	CSIOperationTypeGetCapacity               = "getcapacity"
+	CSIOperationTypeListVolumes               = "listvolumes"
 	CSISyncMsgRespTimeout                     = 1 * time.Minute
 
 	ServerAddress = "127.0.0.1"
@@ -1,10 +1,12 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	ps "github.com/shirou/gopsutil/v3/process"
 	"github.com/spf13/cobra"
@@ -21,6 +23,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core"
 	"github.com/kubeedge/kubeedge/edge/cmd/edgecore/app/options"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/monitor"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin"
 	"github.com/kubeedge/kubeedge/edge/pkg/edged"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub"
@@ -31,6 +34,7 @@ import (
 	"github.com/kubeedge/kubeedge/edge/pkg/servicebus"
 	"github.com/kubeedge/kubeedge/edge/test"
 	"github.com/kubeedge/kubeedge/pkg/features"
+	"github.com/kubeedge/kubeedge/pkg/tracing"
 	"github.com/kubeedge/kubeedge/pkg/util"
 	"github.com/kubeedge/kubeedge/pkg/util/flag"
 	utilvalidation "github.com/kubeedge/kubeedge/pkg/util/validation"
@@ -135,8 +139,24 @@ offering HTTP client capabilities to components of cloud to reach HTTP servers r
 				core.EnableModuleRestart()
 			}
 
+			if config.MonitorServer != nil && config.MonitorServer.Enable {
+				go monitor.ServeMonitor(*config.MonitorServer)
+			}
+
+			shutdownTracing, err := tracing.Init(context.Background(), cmd.Name(), config.Tracing)
+			if err != nil {
+				klog.Exit(err)
+			}
+
 			// start all modules
 			core.Run()
+
+			// flush the spans which are not exported yet
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTracing(ctx); err != nil {
+				klog.Errorf("failed to shutdown tracing: %v", err)
+			}
 		},
 	}
 	fs := cmd.Flags()
@@ -100,6 +100,8 @@ func newHollowEdgeNodeCommand() *cobra.Command {
 	globalflag.AddGlobalFlags(fs, cmd.Name())
 	s.addFlags(fs)
 
+	cmd.AddCommand(newMultiNodeCommand())
+
 	return cmd
 }
 
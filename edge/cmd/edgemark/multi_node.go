@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/component-base/cli/globalflag"
+
+	"github.com/kubeedge/kubeedge/edge/cmd/edgemark/multinode"
+)
+
+// newMultiNodeCommand creates the command running many hollow edge nodes in one process
+func newMultiNodeCommand() *cobra.Command {
+	o := multinode.NewOptions()
+
+	cmd := &cobra.Command{
+		Use:   "multi-node",
+		Short: "Run many lightweight hollow edge nodes in one process",
+		Long: `Run many lightweight hollow edge nodes in one process. Each hollow node is a session
+connected to CloudHub instead of a whole EdgeCore, the nodes share a fake kubelet and
+report the twins of the synthetic devices. The links between the nodes and CloudHub can
+be impaired with latency, loss and periodic disconnections, and a report of the message
+latency and the resource usage of CloudCore is generated when the test ends.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if errs := o.Validate(); len(errs) > 0 {
+				return utilerrors.NewAggregate(errs)
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			report, err := multinode.Run(ctx, o)
+			if err != nil {
+				return err
+			}
+			return writeReport(report, o.ReportFile)
+		},
+	}
+
+	fs := cmd.Flags()
+	globalflag.AddGlobalFlags(fs, cmd.Name())
+	o.AddFlags(fs)
+
+	return cmd
+}
+
+// writeReport writes the report to the file in JSON, or prints it if file is empty
+func writeReport(report *multinode.Report, file string) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal the report: %v", err)
+	}
+	if file == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+	if err := os.WriteFile(file, data, 0644); err != nil {
+		return fmt.Errorf("failed to write the report to %s: %v", file, err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multinode
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
+
+	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/certificate"
+	"github.com/kubeedge/kubeedge/pkg/security/token"
+)
+
+// certApplier applies for the certificates of the hollow nodes, the certificates are only kept in memory
+// instead of the files of EdgeHub, so the hollow nodes don't share any files
+type certApplier struct {
+	httpServer string
+	token      string
+	caPEM      []byte
+	roots      *x509.CertPool
+}
+
+// newCertApplier gets the CA of CloudHub and verifies it by the token
+func newCertApplier(httpServer, joinToken string) (*certApplier, error) {
+	caDER, err := certificate.GetCACert(httpServer + constants.DefaultCAURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CA certificate, err: %v", err)
+	}
+	realToken, err := token.VerifyCAAndGetRealToken(joinToken, caDER)
+	if err != nil {
+		return nil, err
+	}
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: caDER})
+	roots := x509.NewCertPool()
+	if ok := roots.AppendCertsFromPEM(caPEM); !ok {
+		return nil, fmt.Errorf("cannot parse the certificates")
+	}
+	return &certApplier{
+		httpServer: httpServer,
+		token:      realToken,
+		caPEM:      caPEM,
+		roots:      roots,
+	}, nil
+}
+
+// tlsConfig applies for the certificate of the node and returns the TLS config to connect to CloudHub
+func (a *certApplier) tlsConfig(nodeName string) (*tls.Config, error) {
+	cm := certificate.NewCertManager(v1alpha2.EdgeHub{HTTPServer: a.httpServer}, nodeName)
+	certDER, keyDER, err := cm.GetEdgeCert(a.httpServer+constants.DefaultCertURL, a.caPEM, tls.Certificate{}, a.token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edge certificate of node %s from the cloudcore, error: %v", nodeName, err)
+	}
+	cert, err := tls.X509KeyPair(pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: certDER}),
+		pem.EncodeToMemory(&pem.Block{Type: keyutil.ECPrivateKeyBlockType, Bytes: keyDER}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load x509 key pair of node %s, error: %v", nodeName, err)
+	}
+	return &tls.Config{
+		RootCAs:      a.roots,
+		Certificates: []tls.Certificate{cert},
+	}, nil
+}
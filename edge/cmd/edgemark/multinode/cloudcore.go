@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multinode
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/klog/v2"
+)
+
+// the metrics of CloudCore scraped for the report
+const (
+	metricCPUSeconds      = "process_cpu_seconds_total"
+	metricResidentMemory  = "process_resident_memory_bytes"
+	metricGoroutines      = "go_goroutines"
+	metricConnectedNodes  = "KubeEdge_CloudHub_connected_nodes"
+	metricMessageRetries  = "KubeEdge_CloudHub_message_retries_total"
+	metricMessageDrops    = "KubeEdge_CloudHub_message_drops_total"
+	metricKeepaliveMisses = "KubeEdge_CloudHub_keepalive_misses_total"
+)
+
+// CloudCoreUsage is the resource usage of a CloudCore instance during the test
+type CloudCoreUsage struct {
+	URL   string `json:"url"`
+	Error string `json:"error,omitempty"`
+	// CPUCores is the average CPU cores used
+	CPUCores               float64 `json:"cpuCores"`
+	MaxResidentMemoryBytes float64 `json:"maxResidentMemoryBytes"`
+	MaxGoroutines          float64 `json:"maxGoroutines"`
+	MaxConnectedNodes      float64 `json:"maxConnectedNodes"`
+	// MessageRetries, MessageDrops and KeepaliveMisses are the increases of the CloudHub counters
+	MessageRetries  float64 `json:"messageRetries"`
+	MessageDrops    float64 `json:"messageDrops"`
+	KeepaliveMisses float64 `json:"keepaliveMisses"`
+	Samples         int     `json:"samples"`
+}
+
+// cloudCoreScraper scrapes the metrics of a CloudCore instance
+type cloudCoreScraper struct {
+	client *http.Client
+	usage  CloudCoreUsage
+
+	// the time and the counters of the first sample
+	start         time.Time
+	startCounters map[string]float64
+}
+
+func newCloudCoreScraper(url string) *cloudCoreScraper {
+	return &cloudCoreScraper{
+		client: &http.Client{Timeout: 10 * time.Second},
+		usage:  CloudCoreUsage{URL: url},
+	}
+}
+
+// run scrapes the metrics every interval until ctx is done
+func (s *cloudCoreScraper) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.scrape(ctx); err != nil {
+			klog.Warningf("failed to scrape the metrics of cloudcore %s: %v", s.usage.URL, err)
+			s.usage.Error = err.Error()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *cloudCoreScraper) scrape(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.usage.URL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("status %d: %s", resp.StatusCode, body)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return err
+	}
+	s.observe(time.Now(), families)
+	return nil
+}
+
+// observe updates the usage by the metrics scraped at now
+func (s *cloudCoreScraper) observe(now time.Time, families map[string]*dto.MetricFamily) {
+	u := &s.usage
+	counters := map[string]float64{
+		metricCPUSeconds:      sum(families[metricCPUSeconds]),
+		metricMessageRetries:  sum(families[metricMessageRetries]),
+		metricMessageDrops:    sum(families[metricMessageDrops]),
+		metricKeepaliveMisses: sum(families[metricKeepaliveMisses]),
+	}
+	if u.Samples == 0 {
+		s.start, s.startCounters = now, counters
+	}
+	u.Samples++
+	u.Error = ""
+	u.MaxResidentMemoryBytes = max(u.MaxResidentMemoryBytes, sum(families[metricResidentMemory]))
+	u.MaxGoroutines = max(u.MaxGoroutines, sum(families[metricGoroutines]))
+	u.MaxConnectedNodes = max(u.MaxConnectedNodes, sum(families[metricConnectedNodes]))
+	if elapsed := now.Sub(s.start).Seconds(); elapsed > 0 {
+		u.CPUCores = (counters[metricCPUSeconds] - s.startCounters[metricCPUSeconds]) / elapsed
+	}
+	// the counters of a node are deleted when its session is closed, so the increases are only estimates
+	u.MessageRetries = max(0, counters[metricMessageRetries]-s.startCounters[metricMessageRetries])
+	u.MessageDrops = max(0, counters[metricMessageDrops]-s.startCounters[metricMessageDrops])
+	u.KeepaliveMisses = max(0, counters[metricKeepaliveMisses]-s.startCounters[metricKeepaliveMisses])
+}
+
+// sum returns the sum of the values of the metrics in the family
+func sum(family *dto.MetricFamily) float64 {
+	if family == nil {
+		return 0
+	}
+	var total float64
+	for _, m := range family.GetMetric() {
+		switch {
+		case m.GetCounter() != nil:
+			total += m.GetCounter().GetValue()
+		case m.GetGauge() != nil:
+			total += m.GetGauge().GetValue()
+		case m.GetUntyped() != nil:
+			total += m.GetUntyped().GetValue()
+		}
+	}
+	return total
+}
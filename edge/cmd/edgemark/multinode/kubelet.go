@@ -0,0 +1,201 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multinode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/common/constants"
+	edgeapi "github.com/kubeedge/kubeedge/common/types"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/message"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+)
+
+// fakeKubelet is the kubelet shared by all the hollow nodes, it registers the nodes, renews the node
+// leases, reports the node status, and pretends to run the pods bound to the nodes
+type fakeKubelet struct {
+	options *Options
+	// pods are the UIDs of the running pods by node/namespace/name
+	pods sync.Map
+}
+
+func newFakeKubelet(options *Options) *fakeKubelet {
+	return &fakeKubelet{options: options}
+}
+
+func (k *fakeKubelet) connected(ctx context.Context, node *hollowNode) {
+	node.send(k.buildMessage(v1.NamespaceDefault, model.ResourceTypeNode, node.name, model.InsertOperation, k.node(node.name)), true)
+	go k.renewLease(ctx, node)
+	go k.reportNodeStatus(ctx, node)
+}
+
+func (k *fakeKubelet) renewLease(ctx context.Context, node *hollowNode) {
+	ticker := time.NewTicker(k.options.LeaseDuration / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		now := metav1.NewMicroTime(time.Now())
+		lease := &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: node.name, Namespace: v1.NamespaceNodeLease},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       pointer.String(node.name),
+				LeaseDurationSeconds: pointer.Int32(int32(k.options.LeaseDuration.Seconds())),
+				RenewTime:            &now,
+			},
+		}
+		node.send(k.buildMessage(v1.NamespaceNodeLease, model.ResourceTypeLease, node.name, model.UpdateOperation, lease), true)
+	}
+}
+
+func (k *fakeKubelet) reportNodeStatus(ctx context.Context, node *hollowNode) {
+	ticker := time.NewTicker(k.options.NodeStatusInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		status := edgeapi.NodeStatusRequest{Status: k.node(node.name).Status}
+		node.send(k.buildMessage(v1.NamespaceDefault, model.ResourceTypeNodeStatus, node.name, model.UpdateOperation, status), true)
+	}
+}
+
+func (k *fakeKubelet) handle(node *hollowNode, msg *model.Message) {
+	namespace, resourceType, name, err := message.ParseResourceEdge(msg.GetResource(), msg.GetOperation())
+	if err != nil || resourceType != model.ResourceTypePod || name == "" {
+		return
+	}
+	key := fmt.Sprintf("%s/%s/%s", node.name, namespace, name)
+	if msg.GetOperation() == model.DeleteOperation {
+		k.pods.Delete(key)
+		return
+	}
+
+	data, err := msg.GetContentData()
+	if err != nil {
+		klog.Errorf("hollow node %s failed to get the content of message %s: %v", node.name, msg.GetID(), err)
+		return
+	}
+	var pod v1.Pod
+	if err := json.Unmarshal(data, &pod); err != nil {
+		klog.Errorf("hollow node %s failed to unmarshal pod %s: %v", node.name, key, err)
+		return
+	}
+
+	if pod.DeletionTimestamp != nil {
+		// the containers are stopped at once, so the pod is deleted without the grace period
+		k.pods.Delete(key)
+		node.send(k.buildMessage(namespace, model.ResourceTypePod, name, model.DeleteOperation, string(pod.UID)), true)
+		return
+	}
+	// only report the status once, the pod updated by the status reported is sent to the node again
+	if uid, ok := k.pods.Load(key); ok && uid == pod.UID {
+		return
+	}
+	k.pods.Store(key, pod.UID)
+	request := edgeapi.PodStatusRequest{UID: pod.UID, Name: pod.Name, Status: runningStatus(&pod)}
+	node.send(k.buildMessage(namespace, model.ResourceTypePodStatus, name, model.UpdateOperation, request), true)
+}
+
+func (k *fakeKubelet) buildMessage(namespace, resourceType, name, operation string, content interface{}) *model.Message {
+	resource := fmt.Sprintf("%s/%s/%s", namespace, resourceType, name)
+	return message.BuildMsg(modules.MetaGroup, "", modules.EdgedModuleName, resource, operation, content)
+}
+
+// node returns the hollow node with a fixed capacity, which is always ready
+func (k *fakeKubelet) node(name string) *v1.Node {
+	labels := map[string]string{
+		v1.LabelHostname:          name,
+		v1.LabelOSStable:          "linux",
+		v1.LabelArchStable:        "amd64",
+		constants.EdgeNodeRoleKey: constants.EdgeNodeRoleValue,
+	}
+	for key, value := range k.options.NodeLabels {
+		labels[key] = value
+	}
+	resources := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("1"),
+		v1.ResourceMemory: resource.MustParse("3840Mi"),
+		v1.ResourcePods:   resource.MustParse("110"),
+	}
+	now := metav1.Now()
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: v1.NodeStatus{
+			Capacity:    resources,
+			Allocatable: resources,
+			Conditions: []v1.NodeCondition{{
+				Type:              v1.NodeReady,
+				Status:            v1.ConditionTrue,
+				Reason:            "EdgeReady",
+				Message:           "edge is posting ready status",
+				LastHeartbeatTime: now,
+			}},
+			NodeInfo: v1.NodeSystemInfo{
+				OperatingSystem:         "linux",
+				Architecture:            "amd64",
+				KubeletVersion:          "edgemark",
+				ContainerRuntimeVersion: "fake://edgemark",
+			},
+		},
+	}
+}
+
+// runningStatus returns the status of the pod whose containers are all running
+func runningStatus(pod *v1.Pod) v1.PodStatus {
+	now := metav1.Now()
+	status := v1.PodStatus{
+		Phase:     v1.PodRunning,
+		StartTime: &now,
+		PodIP:     "10.0.0.1",
+		Conditions: []v1.PodCondition{
+			{Type: v1.PodInitialized, Status: v1.ConditionTrue, LastTransitionTime: now},
+			{Type: v1.PodReady, Status: v1.ConditionTrue, LastTransitionTime: now},
+			{Type: v1.ContainersReady, Status: v1.ConditionTrue, LastTransitionTime: now},
+			{Type: v1.PodScheduled, Status: v1.ConditionTrue, LastTransitionTime: now},
+		},
+	}
+	for _, container := range pod.Spec.Containers {
+		status.ContainerStatuses = append(status.ContainerStatuses, v1.ContainerStatus{
+			Name:         container.Name,
+			Image:        container.Image,
+			Ready:        true,
+			Started:      pointer.Bool(true),
+			State:        v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: now}},
+			ContainerID:  "fake://" + string(pod.UID) + "/" + container.Name,
+			RestartCount: 0,
+		})
+	}
+	return status
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multinode
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// link simulates the impairment of the network link between a hollow node and CloudHub
+type link struct {
+	latency            time.Duration
+	jitter             time.Duration
+	lossRate           float64
+	disconnectInterval time.Duration
+
+	lock sync.Mutex
+	rand *rand.Rand
+}
+
+func newLink(o *Options, seed int64) *link {
+	return &link{
+		latency:            o.Latency,
+		jitter:             o.Jitter,
+		lossRate:           o.LossRate,
+		disconnectInterval: o.DisconnectInterval,
+		rand:               rand.New(rand.NewSource(seed)),
+	}
+}
+
+// drop returns true if the message is lost on the link
+func (l *link) drop() bool {
+	if l.lossRate <= 0 {
+		return false
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.rand.Float64() < l.lossRate
+}
+
+// delay returns the latency of the message on the link
+func (l *link) delay() time.Duration {
+	if l.jitter <= 0 {
+		return l.latency
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.latency + time.Duration(l.rand.Int63n(int64(l.jitter)+1))
+}
+
+// nextDisconnect returns the duration until the link is disconnected next time,
+// the disconnections are exponentially distributed so the nodes don't disconnect
+// at the same time. It returns 0 if the link is never disconnected.
+func (l *link) nextDisconnect() time.Duration {
+	if l.disconnectInterval <= 0 {
+		return 0
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return time.Duration(l.rand.ExpFloat64()*float64(l.disconnectInterval)) + 1
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package multinode runs many lightweight hollow edge nodes in one process. Instead of running
+// EdgeCore, each hollow node is a session connected to CloudHub as EdgeHub does, and the nodes
+// share a fake kubelet and a reporter of the synthetic device twins.
+package multinode
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/klog/v2"
+)
+
+// Run runs the hollow nodes until ctx is done or the duration of the test elapses, then returns the report
+func Run(ctx context.Context, o *Options) (*Report, error) {
+	if o.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Duration)
+		defer cancel()
+	}
+
+	certs, err := newCertApplier(o.HTTPServer, o.Token)
+	if err != nil {
+		return nil, err
+	}
+	var handlers []nodeHandler
+	if o.FakeKubelet {
+		handlers = append(handlers, newFakeKubelet(o))
+	}
+	if o.DevicesPerNode > 0 {
+		handlers = append(handlers, newTwinReporter(o))
+	}
+
+	report := &Report{
+		Start: time.Now(),
+		Nodes: o.NodeCount,
+		Link: LinkReport{
+			Latency:            o.Latency,
+			Jitter:             o.Jitter,
+			LossRate:           o.LossRate,
+			DisconnectInterval: o.DisconnectInterval,
+			DisconnectDuration: o.DisconnectDuration,
+		},
+	}
+	recorder := newRecorder()
+
+	var wg sync.WaitGroup
+	scrapers := make([]*cloudCoreScraper, 0, len(o.CloudCoreMetricsURLs))
+	for _, url := range o.CloudCoreMetricsURLs {
+		scraper := newCloudCoreScraper(url)
+		scrapers = append(scrapers, scraper)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scraper.run(ctx, o.ScrapeInterval)
+		}()
+	}
+
+	klog.Infof("start %d hollow nodes", o.NodeCount)
+	limiter := rate.NewLimiter(rate.Limit(o.ConnectQPS), 1)
+	for i := 0; i < o.NodeCount; i++ {
+		node := &hollowNode{
+			name:     o.nodeName(i),
+			options:  o,
+			certs:    certs,
+			link:     newLink(o, report.Start.UnixNano()+int64(i)),
+			recorder: recorder,
+			handlers: handlers,
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			node.run(ctx, limiter)
+		}()
+	}
+
+	<-ctx.Done()
+	klog.Infof("stop %d hollow nodes", o.NodeCount)
+	wg.Wait()
+
+	// take the last samples of CloudCore after the hollow nodes are stopped
+	scrapeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, scraper := range scrapers {
+		if err := scraper.scrape(scrapeCtx); err != nil {
+			klog.Warningf("failed to scrape the metrics of cloudcore %s: %v", scraper.usage.URL, err)
+		}
+		report.CloudCore = append(report.CloudCore, scraper.usage)
+	}
+	report.Duration = time.Since(report.Start)
+	recorder.report(report)
+	return report, nil
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multinode
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	messagepkg "github.com/kubeedge/kubeedge/edge/pkg/common/message"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/api"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/client"
+	"github.com/kubeedge/kubeedge/pkg/viaduct/pkg/conn"
+)
+
+const (
+	handshakeTimeout = 30 * time.Second
+	writeDeadline    = 15 * time.Second
+	// retryConnectPeriod is how long a hollow node waits to connect to CloudHub again after a failure
+	retryConnectPeriod = 5 * time.Second
+	// responseTimeout is how long a hollow node waits for the response of a message
+	responseTimeout = time.Minute
+)
+
+// nodeHandler handles the events of the hollow nodes
+type nodeHandler interface {
+	// connected is called every time the node connects to CloudHub,
+	// ctx is done when the node is disconnected
+	connected(ctx context.Context, node *hollowNode)
+	// handle handles the message from CloudHub which is not a response to the messages of the node
+	handle(node *hollowNode, message *model.Message)
+}
+
+// hollowNode is a lightweight EdgeHub session connected to CloudHub, which only sends the messages
+// of the node handlers and acknowledges the messages from CloudHub as MetaManager does
+type hollowNode struct {
+	name      string
+	options   *Options
+	certs     *certApplier
+	tlsConfig *tls.Config
+	link      *link
+	recorder  *recorder
+	handlers  []nodeHandler
+
+	lock       sync.Mutex
+	connection conn.Connection
+	// pending is the sending time of the messages waiting for responses by message ID
+	pending sync.Map
+}
+
+// run keeps the node connected to CloudHub until ctx is done, the certificate
+// applications and the connections of all the nodes are limited by limiter
+func (n *hollowNode) run(ctx context.Context, limiter *rate.Limiter) {
+	for n.tlsConfig == nil {
+		if err := limiter.Wait(ctx); err != nil {
+			return
+		}
+		tlsConfig, err := n.certs.tlsConfig(n.name)
+		if err != nil {
+			n.recorder.inc(CounterCertificateFailures)
+			klog.Errorf("hollow node %s failed to apply for the certificate: %v", n.name, err)
+			if !sleep(ctx, retryConnectPeriod) {
+				return
+			}
+			continue
+		}
+		n.tlsConfig = tlsConfig
+	}
+
+	for {
+		if err := limiter.Wait(ctx); err != nil {
+			return
+		}
+		connection, err := n.connect()
+		if err != nil {
+			n.recorder.inc(CounterConnectFailures)
+			klog.Errorf("hollow node %s failed to connect to cloudhub: %v", n.name, err)
+			if !sleep(ctx, retryConnectPeriod) {
+				return
+			}
+			continue
+		}
+		n.recorder.inc(CounterConnects)
+		klog.V(2).Infof("hollow node %s connected to cloudhub", n.name)
+
+		if disconnected := n.serve(ctx, connection); !disconnected {
+			return
+		}
+		n.recorder.inc(CounterDisconnects)
+		klog.V(2).Infof("hollow node %s is disconnected from cloudhub for %v", n.name, n.options.DisconnectDuration)
+		if !sleep(ctx, n.options.DisconnectDuration) {
+			return
+		}
+	}
+}
+
+func (n *hollowNode) connect() (conn.Connection, error) {
+	header := make(http.Header)
+	header.Set("node_id", n.name)
+	header.Set("project_id", n.options.ProjectID)
+
+	options := client.Options{
+		HandshakeTimeout: handshakeTimeout,
+		TLSConfig:        n.tlsConfig,
+		ConnUse:          api.UseTypeMessage,
+	}
+	var exOpts interface{}
+	if n.options.QuicServer != "" {
+		// the same as the quic client of EdgeHub
+		tlsConfig := n.tlsConfig.Clone()
+		tlsConfig.InsecureSkipVerify = true
+		options.TLSConfig = tlsConfig
+		options.Type = api.ProtocolTypeQuic
+		options.Addr = n.options.QuicServer
+		exOpts = api.QuicClientOption{Header: header}
+	} else {
+		options.Type = api.ProtocolTypeWS
+		options.Addr = strings.Join([]string{"wss:/", n.options.WebsocketServer, n.options.ProjectID, n.name, "events"}, "/")
+		exOpts = api.WSClientOption{Header: header}
+	}
+	c := &client.Client{Options: options, ExOpts: exOpts}
+	return c.Connect()
+}
+
+// serve serves the connection until it's broken or ctx is done, it returns true if the node
+// is disconnected by the link impairment
+func (n *hollowNode) serve(ctx context.Context, connection conn.Connection) bool {
+	connCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	n.setConnection(connection)
+	defer func() {
+		n.setConnection(nil)
+		connection.Close()
+	}()
+
+	go n.receive(connCtx, cancel, connection)
+	go n.keepalive(connCtx)
+	for _, handler := range n.handlers {
+		handler.connected(connCtx, n)
+	}
+
+	var disconnect <-chan time.Time
+	if d := n.link.nextDisconnect(); d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		disconnect = timer.C
+	}
+	select {
+	case <-connCtx.Done():
+		return false
+	case <-disconnect:
+		return true
+	}
+}
+
+func (n *hollowNode) setConnection(connection conn.Connection) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.connection = connection
+}
+
+func (n *hollowNode) receive(ctx context.Context, cancel context.CancelFunc, connection conn.Connection) {
+	defer cancel()
+	for {
+		var message model.Message
+		if err := connection.ReadMessage(&message); err != nil {
+			if ctx.Err() == nil {
+				klog.Errorf("hollow node %s failed to read message: %v", n.name, err)
+			}
+			return
+		}
+		if n.link.drop() {
+			n.recorder.inc(CounterLostDownstream)
+			continue
+		}
+		if delay := n.link.delay(); delay > 0 {
+			time.AfterFunc(delay, func() { n.dispatch(&message) })
+			continue
+		}
+		n.dispatch(&message)
+	}
+}
+
+func (n *hollowNode) dispatch(message *model.Message) {
+	n.recorder.inc(CounterReceived)
+	if sent, ok := n.pending.LoadAndDelete(message.GetParentID()); ok {
+		n.recorder.observe(LatencyRoundTrip, time.Since(sent.(time.Time)))
+		return
+	}
+	if timestamp := message.GetTimestamp(); timestamp > 0 {
+		n.recorder.observe(LatencyDownstream, time.Since(time.UnixMilli(timestamp)))
+	}
+
+	operation := message.GetOperation()
+	if operation == model.ResponseOperation || operation == model.ResponseErrorOperation {
+		return
+	}
+	// acknowledge the message as MetaManager does, so CloudHub doesn't send it again
+	n.send(model.NewMessage("").NewRespByMessage(message, "OK"), false)
+	for _, handler := range n.handlers {
+		handler.handle(n, message)
+	}
+}
+
+func (n *hollowNode) keepalive(ctx context.Context) {
+	ticker := time.NewTicker(n.options.Heartbeat)
+	defer ticker.Stop()
+	for {
+		message := model.NewMessage("").
+			BuildRouter(modules.EdgeHubModuleName, "resource", "node", messagepkg.OperationKeepalive).
+			FillBody("ping")
+		n.send(message, false)
+		n.expirePending()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// expirePending stops waiting for the responses which are not received in responseTimeout
+func (n *hollowNode) expirePending() {
+	n.pending.Range(func(id, sent interface{}) bool {
+		if time.Since(sent.(time.Time)) > responseTimeout {
+			n.pending.Delete(id)
+			n.recorder.inc(CounterResponseTimeout)
+		}
+		return true
+	})
+}
+
+// send sends the message to CloudHub through the impaired link,
+// the round trip latency is recorded if expectResponse is true
+func (n *hollowNode) send(message *model.Message, expectResponse bool) {
+	if expectResponse {
+		n.pending.Store(message.GetID(), time.Now())
+	}
+	if n.link.drop() {
+		n.recorder.inc(CounterLostUpstream)
+		return
+	}
+	if delay := n.link.delay(); delay > 0 {
+		time.AfterFunc(delay, func() { n.write(message) })
+		return
+	}
+	n.write(message)
+}
+
+func (n *hollowNode) write(message *model.Message) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	err := errors.New("not connected")
+	if n.connection != nil {
+		if err = n.connection.SetWriteDeadline(time.Now().Add(writeDeadline)); err == nil {
+			err = n.connection.WriteMessageAsync(message)
+		}
+	}
+	if err != nil {
+		n.recorder.inc(CounterSendFailures)
+		klog.V(4).Infof("hollow node %s failed to send message %s: %v", n.name, message.GetID(), err)
+		return
+	}
+	n.recorder.inc(CounterSent)
+}
+
+// sleep returns false if ctx is done before d elapses
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multinode
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	cliflag "k8s.io/component-base/cli/flag"
+
+	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+)
+
+// Options are the options of the multi-node edgemark
+type Options struct {
+	// Token is the token to apply for the certificates of the hollow nodes
+	Token string
+	// HTTPServer is the server of CloudHub to apply for the certificates
+	HTTPServer string
+	// WebsocketServer is the websocket server of CloudHub, the hollow nodes connect to it if QuicServer is empty
+	WebsocketServer string
+	// QuicServer is the quic server of CloudHub
+	QuicServer string
+	ProjectID  string
+
+	// NodeCount is the number of the hollow nodes run in the process
+	NodeCount int
+	// NodeNamePrefix is the prefix of the hollow node names, which are suffixed by the node index
+	NodeNamePrefix string
+	// ConnectQPS limits the rate of the hollow nodes connecting to CloudHub
+	ConnectQPS float64
+	Heartbeat  time.Duration
+
+	// FakeKubelet enables the fake kubelet shared by the hollow nodes, which registers the nodes,
+	// reports the node status and the lease, and runs the pods bound to the nodes
+	FakeKubelet          bool
+	NodeStatusInterval   time.Duration
+	LeaseDuration        time.Duration
+	NodeLabels           map[string]string
+	DevicesPerNode       int
+	TwinUpdateInterval   time.Duration
+	TwinPropertiesNumber int
+
+	// Latency and Jitter delay the messages in both directions
+	Latency time.Duration
+	Jitter  time.Duration
+	// LossRate is the probability of dropping a message in both directions
+	LossRate float64
+	// DisconnectInterval is the mean interval of disconnecting a hollow node from CloudHub,
+	// and the node reconnects after DisconnectDuration
+	DisconnectInterval time.Duration
+	DisconnectDuration time.Duration
+
+	// Duration is how long the test runs, it runs until the process is interrupted if it's zero
+	Duration time.Duration
+	// CloudCoreMetricsURLs are the metrics endpoints of CloudCore scraped for the report
+	CloudCoreMetricsURLs []string
+	ScrapeInterval       time.Duration
+	// ReportFile is the file the report is written to in JSON, the report is printed if it's empty
+	ReportFile string
+}
+
+// NewOptions returns the default options of the multi-node edgemark
+func NewOptions() *Options {
+	edgeHub := v1alpha2.NewDefaultEdgeCoreConfig().Modules.EdgeHub
+	return &Options{
+		ProjectID:            edgeHub.ProjectID,
+		NodeCount:            100,
+		NodeNamePrefix:       "hollow-edge-node",
+		ConnectQPS:           50,
+		Heartbeat:            time.Duration(edgeHub.Heartbeat) * time.Second,
+		FakeKubelet:          true,
+		NodeStatusInterval:   time.Minute,
+		LeaseDuration:        40 * time.Second,
+		NodeLabels:           make(map[string]string),
+		TwinUpdateInterval:   10 * time.Second,
+		TwinPropertiesNumber: 3,
+		ScrapeInterval:       15 * time.Second,
+	}
+}
+
+// AddFlags adds the flags of the options to fs
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Token, "token", o.Token, "Token to apply for the certificates of the hollow nodes.")
+	fs.StringVar(&o.HTTPServer, "http-server", o.HTTPServer, "HTTPServer indicates the server for edge to apply for the certificate.")
+	fs.StringVar(&o.WebsocketServer, "websocket-server", o.WebsocketServer, "Server indicates websocket server address.")
+	fs.StringVar(&o.QuicServer, "quic-server", o.QuicServer, "Server indicates quic server address, the hollow nodes connect to it instead of the websocket server if it's set.")
+	fs.StringVar(&o.ProjectID, "project-id", o.ProjectID, "Project ID of the hollow nodes.")
+
+	fs.IntVar(&o.NodeCount, "node-count", o.NodeCount, "Number of the hollow nodes run in this process.")
+	fs.StringVar(&o.NodeNamePrefix, "node-name-prefix", o.NodeNamePrefix, "Prefix of the hollow node names, the node names are suffixed by the node index.")
+	fs.Float64Var(&o.ConnectQPS, "connect-qps", o.ConnectQPS, "Rate of the hollow nodes connecting to CloudHub.")
+	fs.DurationVar(&o.Heartbeat, "heartbeat", o.Heartbeat, "Interval of the keepalive messages of the hollow nodes.")
+
+	fs.BoolVar(&o.FakeKubelet, "fake-kubelet", o.FakeKubelet, "Run the fake kubelet shared by the hollow nodes, which registers the nodes and runs the pods bound to them.")
+	fs.DurationVar(&o.NodeStatusInterval, "node-status-interval", o.NodeStatusInterval, "Interval of reporting the node status by the fake kubelet.")
+	fs.DurationVar(&o.LeaseDuration, "lease-duration", o.LeaseDuration, "Duration of the node lease renewed by the fake kubelet, the lease is renewed every quarter of it.")
+	bindableNodeLabels := cliflag.ConfigurationMap(o.NodeLabels)
+	fs.Var(&bindableNodeLabels, "node-labels", "Additional node labels")
+	fs.IntVar(&o.DevicesPerNode, "devices-per-node", o.DevicesPerNode, "Number of the synthetic devices of each hollow node, whose twins are reported periodically.")
+	fs.DurationVar(&o.TwinUpdateInterval, "twin-update-interval", o.TwinUpdateInterval, "Interval of reporting the twins of each synthetic device.")
+	fs.IntVar(&o.TwinPropertiesNumber, "twin-properties", o.TwinPropertiesNumber, "Number of the twin properties of each synthetic device.")
+
+	fs.DurationVar(&o.Latency, "link-latency", o.Latency, "Latency added to the messages between the hollow nodes and CloudHub in both directions.")
+	fs.DurationVar(&o.Jitter, "link-jitter", o.Jitter, "Maximum jitter added to the link latency.")
+	fs.Float64Var(&o.LossRate, "link-loss-rate", o.LossRate, "Probability of dropping a message between the hollow nodes and CloudHub, between 0 and 1.")
+	fs.DurationVar(&o.DisconnectInterval, "disconnect-interval", o.DisconnectInterval, "Mean interval of disconnecting each hollow node from CloudHub, 0 disables the disconnection.")
+	fs.DurationVar(&o.DisconnectDuration, "disconnect-duration", o.DisconnectDuration, "Duration of each disconnection before the hollow node reconnects.")
+
+	fs.DurationVar(&o.Duration, "duration", o.Duration, "Duration of the test, the test runs until interrupted if it's 0.")
+	fs.StringSliceVar(&o.CloudCoreMetricsURLs, "cloudcore-metrics-url", o.CloudCoreMetricsURLs, "Metrics endpoints of the CloudCore instances scraped for the report, e.g. http://127.0.0.1:9091/metrics.")
+	fs.DurationVar(&o.ScrapeInterval, "scrape-interval", o.ScrapeInterval, "Interval of scraping the metrics of CloudCore.")
+	fs.StringVar(&o.ReportFile, "report-file", o.ReportFile, "File the report is written to in JSON.")
+}
+
+// Validate validates the options
+func (o *Options) Validate() []error {
+	var errs []error
+	if o.Token == "" {
+		errs = append(errs, field.Required(field.NewPath("token"), "token is required to apply for the certificates"))
+	}
+	if o.HTTPServer == "" {
+		errs = append(errs, field.Required(field.NewPath("http-server"), "server to apply for the certificates is required"))
+	}
+	if o.WebsocketServer == "" && o.QuicServer == "" {
+		errs = append(errs, field.Required(field.NewPath("websocket-server"), "either websocket server or quic server is required"))
+	}
+	if o.NodeCount <= 0 {
+		errs = append(errs, field.Invalid(field.NewPath("node-count"), o.NodeCount, "must be greater than 0"))
+	}
+	if o.ConnectQPS <= 0 {
+		errs = append(errs, field.Invalid(field.NewPath("connect-qps"), o.ConnectQPS, "must be greater than 0"))
+	}
+	for name, d := range map[string]time.Duration{
+		"heartbeat":            o.Heartbeat,
+		"node-status-interval": o.NodeStatusInterval,
+		"lease-duration":       o.LeaseDuration,
+		"twin-update-interval": o.TwinUpdateInterval,
+		"scrape-interval":      o.ScrapeInterval,
+	} {
+		if d <= 0 {
+			errs = append(errs, field.Invalid(field.NewPath(name), d.String(), "must be greater than 0"))
+		}
+	}
+	if o.DevicesPerNode < 0 {
+		errs = append(errs, field.Invalid(field.NewPath("devices-per-node"), o.DevicesPerNode, "must not be negative"))
+	}
+	if o.LossRate < 0 || o.LossRate > 1 {
+		errs = append(errs, field.Invalid(field.NewPath("link-loss-rate"), o.LossRate, "must be between 0 and 1"))
+	}
+	for name, d := range map[string]time.Duration{
+		"link-latency":        o.Latency,
+		"link-jitter":         o.Jitter,
+		"disconnect-interval": o.DisconnectInterval,
+		"disconnect-duration": o.DisconnectDuration,
+		"duration":            o.Duration,
+	} {
+		if d < 0 {
+			errs = append(errs, field.Invalid(field.NewPath(name), d.String(), "must not be negative"))
+		}
+	}
+	return errs
+}
+
+// nodeName returns the name of the i-th hollow node
+func (o *Options) nodeName(i int) string {
+	return fmt.Sprintf("%s-%d", o.NodeNamePrefix, i)
+}
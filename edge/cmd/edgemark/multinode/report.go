@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multinode
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// LatencyRoundTrip is the latency between sending a message to CloudCore and receiving its response
+	LatencyRoundTrip = "round_trip"
+	// LatencyDownstream is the latency between creating a message in CloudCore and receiving it
+	LatencyDownstream = "downstream"
+)
+
+// the counters of the report
+const (
+	CounterSent                = "sent"
+	CounterReceived            = "received"
+	CounterLostUpstream        = "lost_upstream"
+	CounterLostDownstream      = "lost_downstream"
+	CounterSendFailures        = "send_failures"
+	CounterResponseTimeout     = "response_timeouts"
+	CounterCertificateFailures = "certificate_failures"
+	CounterConnects            = "connects"
+	CounterConnectFailures     = "connect_failures"
+	CounterDisconnects         = "disconnects"
+)
+
+// maxLatencySamples is the size of the reservoir of the latency samples of each kind
+const maxLatencySamples = 100000
+
+// Report is the report of the multi-node edgemark
+type Report struct {
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+	Nodes    int           `json:"nodes"`
+	Link     LinkReport    `json:"link"`
+	// Counters are the numbers of the messages and the connection events of all the hollow nodes
+	Counters  map[string]int64          `json:"counters"`
+	Latencies map[string]LatencySummary `json:"latencies"`
+	CloudCore []CloudCoreUsage          `json:"cloudcore,omitempty"`
+}
+
+// LinkReport is the impairment of the links between the hollow nodes and CloudHub
+type LinkReport struct {
+	Latency            time.Duration `json:"latency"`
+	Jitter             time.Duration `json:"jitter"`
+	LossRate           float64       `json:"lossRate"`
+	DisconnectInterval time.Duration `json:"disconnectInterval"`
+	DisconnectDuration time.Duration `json:"disconnectDuration"`
+}
+
+// LatencySummary summarizes the latency samples of a kind
+type LatencySummary struct {
+	Count int64         `json:"count"`
+	P50   time.Duration `json:"p50"`
+	P90   time.Duration `json:"p90"`
+	P99   time.Duration `json:"p99"`
+	Max   time.Duration `json:"max"`
+}
+
+// reservoir keeps a uniform sample of the latencies
+type reservoir struct {
+	count  int64
+	max    time.Duration
+	values []time.Duration
+}
+
+// recorder records the counters and the latencies of all the hollow nodes
+type recorder struct {
+	lock      sync.Mutex
+	rand      *rand.Rand
+	counters  map[string]int64
+	latencies map[string]*reservoir
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		counters:  make(map[string]int64),
+		latencies: make(map[string]*reservoir),
+	}
+}
+
+func (r *recorder) inc(counter string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.counters[counter]++
+}
+
+func (r *recorder) observe(kind string, latency time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	s, ok := r.latencies[kind]
+	if !ok {
+		s = &reservoir{}
+		r.latencies[kind] = s
+	}
+	s.count++
+	if latency > s.max {
+		s.max = latency
+	}
+	if len(s.values) < maxLatencySamples {
+		s.values = append(s.values, latency)
+		return
+	}
+	if i := r.rand.Int63n(s.count); i < maxLatencySamples {
+		s.values[i] = latency
+	}
+}
+
+// report fills the counters and the latencies into the report
+func (r *recorder) report(report *Report) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	report.Counters = make(map[string]int64, len(r.counters))
+	for counter, n := range r.counters {
+		report.Counters[counter] = n
+	}
+	report.Latencies = make(map[string]LatencySummary, len(r.latencies))
+	for kind, s := range r.latencies {
+		values := append([]time.Duration(nil), s.values...)
+		sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+		report.Latencies[kind] = LatencySummary{
+			Count: s.count,
+			P50:   percentile(values, 0.5),
+			P90:   percentile(values, 0.9),
+			P99:   percentile(values, 0.99),
+			Max:   s.max,
+		}
+	}
+}
+
+// percentile returns the percentile of the sorted values
+func percentile(values []time.Duration, p float64) time.Duration {
+	if len(values) == 0 {
+		return 0
+	}
+	i := int(float64(len(values))*p+0.5) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(values) {
+		i = len(values) - 1
+	}
+	return values[i]
+}
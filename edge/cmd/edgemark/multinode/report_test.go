@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multinode
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+)
+
+func TestRecorder(t *testing.T) {
+	r := newRecorder()
+	for i := 1; i <= 200; i++ {
+		r.observe(LatencyRoundTrip, time.Duration(i)*time.Millisecond)
+		r.inc(CounterSent)
+	}
+	r.inc(CounterDisconnects)
+
+	var report Report
+	r.report(&report)
+	if report.Counters[CounterSent] != 200 || report.Counters[CounterDisconnects] != 1 {
+		t.Errorf("unexpected counters %v", report.Counters)
+	}
+	expected := LatencySummary{
+		Count: 200,
+		P50:   100 * time.Millisecond,
+		P90:   180 * time.Millisecond,
+		P99:   198 * time.Millisecond,
+		Max:   200 * time.Millisecond,
+	}
+	if summary := report.Latencies[LatencyRoundTrip]; summary != expected {
+		t.Errorf("latency summary %+v, expected %+v", summary, expected)
+	}
+}
+
+func TestRecorderReservoir(t *testing.T) {
+	r := newRecorder()
+	for i := 0; i < maxLatencySamples+100; i++ {
+		r.observe(LatencyDownstream, time.Millisecond)
+	}
+	r.observe(LatencyDownstream, time.Second)
+
+	var report Report
+	r.report(&report)
+	summary := report.Latencies[LatencyDownstream]
+	if summary.Count != maxLatencySamples+101 || summary.Max != time.Second {
+		t.Errorf("unexpected latency summary %+v", summary)
+	}
+	if n := len(r.latencies[LatencyDownstream].values); n != maxLatencySamples {
+		t.Errorf("%d latency samples are kept, expected %d", n, maxLatencySamples)
+	}
+}
+
+func TestCloudCoreScraperObserve(t *testing.T) {
+	sample := func(cpuSeconds, memory, connected, retries string) string {
+		return strings.Join([]string{
+			"# TYPE process_cpu_seconds_total counter",
+			"process_cpu_seconds_total " + cpuSeconds,
+			"# TYPE process_resident_memory_bytes gauge",
+			"process_resident_memory_bytes " + memory,
+			"# TYPE KubeEdge_CloudHub_connected_nodes gauge",
+			"KubeEdge_CloudHub_connected_nodes " + connected,
+			"# TYPE KubeEdge_CloudHub_message_retries_total counter",
+			`KubeEdge_CloudHub_message_retries_total{node="a"} ` + retries,
+			`KubeEdge_CloudHub_message_retries_total{node="b"} 1`,
+			"",
+		}, "\n")
+	}
+
+	s := newCloudCoreScraper("http://cloudcore/metrics")
+	start := time.Now()
+	for i, metrics := range []string{
+		sample("10", "100", "0", "1"),
+		sample("15", "300", "20", "3"),
+		sample("30", "200", "10", "5"),
+	} {
+		var parser expfmt.TextParser
+		families, err := parser.TextToMetricFamilies(strings.NewReader(metrics))
+		if err != nil {
+			t.Fatalf("failed to parse metrics: %v", err)
+		}
+		s.observe(start.Add(time.Duration(i)*10*time.Second), families)
+	}
+
+	expected := CloudCoreUsage{
+		URL:                    "http://cloudcore/metrics",
+		CPUCores:               1,
+		MaxResidentMemoryBytes: 300,
+		MaxConnectedNodes:      20,
+		MessageRetries:         4,
+		Samples:                3,
+	}
+	if s.usage != expected {
+		t.Errorf("usage %+v, expected %+v", s.usage, expected)
+	}
+}
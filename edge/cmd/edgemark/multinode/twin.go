@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multinode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dttype"
+)
+
+// twinReporter reports the twins of the synthetic devices of the hollow nodes as DeviceTwin does when
+// the mappers update the actual values. The devices don't need to exist in the cluster, DeviceController
+// ignores the twins of the unknown devices after receiving them.
+type twinReporter struct {
+	options *Options
+}
+
+func newTwinReporter(options *Options) *twinReporter {
+	return &twinReporter{options: options}
+}
+
+func (r *twinReporter) connected(ctx context.Context, node *hollowNode) {
+	go r.run(ctx, node)
+}
+
+// handle ignores the messages from CloudHub, which are already acknowledged by the node
+func (r *twinReporter) handle(*hollowNode, *model.Message) {}
+
+func (r *twinReporter) run(ctx context.Context, node *hollowNode) {
+	// spread the reports of the devices over the interval
+	interval := r.options.TwinUpdateInterval / time.Duration(r.options.DevicesPerNode)
+	if interval <= 0 {
+		interval = time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for i := 0; ; i = (i + 1) % r.options.DevicesPerNode {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		node.send(r.buildMessage(fmt.Sprintf("%s-device-%d", node.name, i)), false)
+	}
+}
+
+func (r *twinReporter) buildMessage(deviceID string) *model.Message {
+	now := time.Now().UnixMilli()
+	twins := make(map[string]*dttype.MsgTwin, r.options.TwinPropertiesNumber)
+	for i := 0; i < r.options.TwinPropertiesNumber; i++ {
+		value := strconv.FormatInt(now%1000+int64(i), 10)
+		twins[fmt.Sprintf("property-%d", i)] = &dttype.MsgTwin{
+			Actual: &dttype.TwinValue{
+				Value:    &value,
+				Metadata: &dttype.ValueMetadata{Timestamp: now},
+			},
+			Metadata: &dttype.TypeMetadata{Type: "int"},
+		}
+	}
+	result := dttype.DeviceTwinResult{
+		BaseMessage: dttype.BaseMessage{EventID: deviceID, Timestamp: now},
+		Twin:        twins,
+	}
+	resource := "device/" + deviceID + dtcommon.TwinETEdgeSyncSuffix
+	return model.NewMessage("").BuildRouter(modules.TwinGroup, "resource", resource, model.UpdateOperation).FillBody(result)
+}
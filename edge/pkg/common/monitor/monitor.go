@@ -0,0 +1,276 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/beehive/pkg/common"
+	"github.com/kubeedge/beehive/pkg/core"
+	beehivecontext "github.com/kubeedge/beehive/pkg/core/context"
+	cloudmonitor "github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
+)
+
+const (
+	metricNamespace = "KubeEdge"
+
+	// EdgeHubSubsystem - subsystem name used by EdgeHub
+	EdgeHubSubsystem = "EdgeHub"
+	// MetaManagerSubsystem - subsystem name used by MetaManager
+	MetaManagerSubsystem = "MetaManager"
+	// DeviceTwinSubsystem - subsystem name used by DeviceTwin
+	DeviceTwinSubsystem = "DeviceTwin"
+	// EventBusSubsystem - subsystem name used by EventBus
+	EventBusSubsystem = "EventBus"
+	// BeehiveSubsystem - subsystem name used by the beehive framework
+	BeehiveSubsystem = "Beehive"
+)
+
+const (
+	// DirectionSend is the direction of the messages sent to the cloud
+	DirectionSend = "send"
+	// DirectionReceive is the direction of the messages received from the cloud
+	DirectionReceive = "receive"
+
+	// SourceEdge is the source of the twin updates reported on the edge
+	SourceEdge = "edge"
+	// SourceCloud is the source of the twin updates synced from the cloud
+	SourceCloud = "cloud"
+
+	// OperationPublish is the eventbus operation publishing a message to a topic
+	OperationPublish = "publish"
+	// OperationSubscribe is the eventbus operation subscribing a topic
+	OperationSubscribe = "subscribe"
+	// OperationUnsubscribe is the eventbus operation unsubscribing a topic
+	OperationUnsubscribe = "unsubscribe"
+)
+
+var (
+	EdgeHubConnected = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: EdgeHubSubsystem,
+			Name:      "connected",
+			Help:      "Whether the EdgeHub is connected to the CloudHub, 1 if connected, 0 otherwise",
+		},
+	)
+	EdgeHubReconnects = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: EdgeHubSubsystem,
+			Name:      "reconnects_total",
+			Help:      "Number of times the EdgeHub reconnected to the CloudHub after the connection was broken",
+		},
+	)
+	EdgeHubMessages = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: EdgeHubSubsystem,
+			Name:      "messages_total",
+			Help:      "Number of messages sent to or received from the CloudHub by direction",
+		},
+		[]string{"direction"},
+	)
+	EdgeHubThrottledMessages = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: EdgeHubSubsystem,
+			Name:      "throttled_messages_total",
+			Help:      "Number of messages to the CloudHub delayed by the client-side rate limiter",
+		},
+	)
+
+	MetaManagerOperations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: MetaManagerSubsystem,
+			Name:      "operations_total",
+			Help:      "Number of messages processed by the MetaManager by operation",
+		},
+		[]string{"operation"},
+	)
+	MetaManagerOperationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricNamespace,
+			Subsystem: MetaManagerSubsystem,
+			Name:      "operation_duration_seconds",
+			Help:      "Latency of the messages processed by the MetaManager by operation, including the local database access",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"operation"},
+	)
+
+	DeviceTwinUpdates = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: DeviceTwinSubsystem,
+			Name:      "twin_updates_total",
+			Help:      "Number of device twin updates by source",
+		},
+		[]string{"source"},
+	)
+	DeviceTwinDMIErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: DeviceTwinSubsystem,
+			Name:      "dmi_errors_total",
+			Help:      "Number of DMI requests from mappers that failed by method",
+		},
+		[]string{"method"},
+	)
+
+	EventBusMessages = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: EventBusSubsystem,
+			Name:      "messages_total",
+			Help:      "Number of MQTT publish, subscribe and unsubscribe requests handled by the EventBus by operation",
+		},
+		[]string{"operation"},
+	)
+
+	BeehiveQueueFull = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: BeehiveSubsystem,
+			Name:      "queue_full_total",
+			Help:      "Number of messages sent to the module whose queue is full by the overflow policy of the module",
+		},
+		[]string{"module", "policy"},
+	)
+	BeehiveQueueLength = prometheus.NewDesc(
+		prometheus.BuildFQName(metricNamespace, BeehiveSubsystem, "queue_length"),
+		"Number of messages waiting to be received by the module",
+		[]string{"module"}, nil,
+	)
+)
+
+// queueCollector collects the queue length of the beehive modules when scraped
+type queueCollector struct{}
+
+func (queueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- BeehiveQueueLength
+}
+
+func (queueCollector) Collect(ch chan<- prometheus.Metric) {
+	for module, length := range beehivecontext.QueueLengths() {
+		ch <- prometheus.MustNewConstMetric(BeehiveQueueLength, prometheus.GaugeValue, float64(length), module)
+	}
+}
+
+var registerOnce sync.Once
+
+// registerMetrics register all metrics.
+func registerMetrics() {
+	registerOnce.Do(func() {
+		prometheus.MustRegister(
+			EdgeHubConnected,
+			EdgeHubReconnects,
+			EdgeHubMessages,
+			EdgeHubThrottledMessages,
+			MetaManagerOperations,
+			MetaManagerOperationDuration,
+			DeviceTwinUpdates,
+			DeviceTwinDMIErrors,
+			EventBusMessages,
+			BeehiveQueueFull,
+			queueCollector{},
+		)
+		beehivecontext.OnQueueFull(func(module string, policy common.OverflowPolicy) {
+			BeehiveQueueFull.WithLabelValues(module, string(policy)).Inc()
+		})
+	})
+}
+
+// probeHandler aggregates the probe results of the modules, it responds 503 if any module fails the probe
+func probeHandler(probe func() map[string]error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		results := probe()
+		names := make([]string, 0, len(results))
+		for name := range results {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var body strings.Builder
+		failed := false
+		for _, name := range names {
+			if err := results[name]; err != nil {
+				failed = true
+				fmt.Fprintf(&body, "[-]%s failed: %v\n", name, err)
+			} else {
+				fmt.Fprintf(&body, "[+]%s ok\n", name)
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if failed {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		_, _ = w.Write([]byte(body.String()))
+	})
+}
+
+// ServeMonitor serve monitoring metric.
+func ServeMonitor(config v1alpha2.MonitorServer) {
+	registerMetrics()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/readyz", probeHandler(core.Readiness))
+	mux.Handle("/healthz", probeHandler(core.Health))
+	if config.EnableProfiling {
+		cloudmonitor.InstallHandlerForPProf(mux)
+	}
+
+	s := http.Server{
+		Addr:              config.BindAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		ctx := beehivecontext.GetContext()
+		<-ctx.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := s.Shutdown(ctx); err != nil {
+			klog.Errorf("Server shutdown failed: %v", err)
+		}
+	}()
+
+	klog.Infof("starting monitor server on addr: %s", config.BindAddress)
+	if err := s.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		klog.Exit(err)
+	}
+}
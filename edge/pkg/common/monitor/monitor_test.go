@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+   http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeedge/beehive/pkg/common"
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/beehive/pkg/core/model"
+)
+
+func TestQueueCollector(t *testing.T) {
+	beehiveContext.InitContext([]string{common.MsgCtxTypeChannel})
+	beehiveContext.AddModule(&common.ModuleInfo{
+		ModuleName: "monitortest",
+		ModuleType: common.MsgCtxTypeChannel,
+	})
+	defer beehiveContext.Cleanup("monitortest")
+
+	for i := 0; i < 3; i++ {
+		beehiveContext.Send("monitortest", *model.NewMessage(""))
+	}
+
+	expected := `
+# HELP KubeEdge_Beehive_queue_length Number of messages waiting to be received by the module
+# TYPE KubeEdge_Beehive_queue_length gauge
+KubeEdge_Beehive_queue_length{module="monitortest"} 3
+`
+	err := testutil.CollectAndCompare(queueCollector{}, strings.NewReader(expected))
+	assert.NoError(t, err)
+}
+
+func TestProbeHandler(t *testing.T) {
+	cases := []struct {
+		name     string
+		results  map[string]error
+		code     int
+		expected string
+	}{
+		{
+			name:     "all ok",
+			results:  map[string]error{"metamanager": nil, "edged": nil},
+			code:     http.StatusOK,
+			expected: "[+]edged ok\n[+]metamanager ok\n",
+		},
+		{
+			name:     "module failed",
+			results:  map[string]error{"metamanager": nil, "edged": errors.New("kubelet is not ready")},
+			code:     http.StatusServiceUnavailable,
+			expected: "[-]edged failed: kubelet is not ready\n[+]metamanager ok\n",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			probeHandler(func() map[string]error { return c.results }).
+				ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			assert.Equal(t, c.code, recorder.Code)
+			assert.Equal(t, c.expected, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmiserver
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	beehiveModel "github.com/kubeedge/beehive/pkg/core/model"
+	deviceconst "github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/types"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
+)
+
+const (
+	// MapperReportInterval is the interval to report the mappers to the cloud
+	MapperReportInterval = 60 * time.Second
+	// MapperExpiration is how long a mapper is tracked after its last heartbeat, the mappers
+	// which are removed or renamed are not reported any more after it
+	MapperExpiration = 10 * time.Minute
+)
+
+type mapperRecord struct {
+	info              *pb.MapperInfo
+	registeredTime    time.Time
+	lastHeartbeatTime time.Time
+}
+
+// mapperTracker tracks the registrations and heartbeats of the mappers.
+type mapperTracker struct {
+	mu      sync.Mutex
+	mappers map[string]*mapperRecord
+}
+
+func newMapperTracker() *mapperTracker {
+	return &mapperTracker{mappers: map[string]*mapperRecord{}}
+}
+
+// register records the registration of the mapper. A mapper registers with data when it starts,
+// and registers without data periodically as heartbeats. It returns true if the mapper is
+// registered for the first time or restarted.
+func (t *mapperTracker) register(info *pb.MapperInfo, withData bool, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	record, ok := t.mappers[info.Name]
+	if !ok {
+		record = &mapperRecord{}
+		t.mappers[info.Name] = record
+	}
+	record.info = info
+	record.lastHeartbeatTime = now
+	if !ok || withData {
+		record.registeredTime = now
+		return true
+	}
+	return false
+}
+
+// heartbeat records that the mapper is alive, it's ignored if the mapper hasn't registered.
+func (t *mapperTracker) heartbeat(name string, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if record, ok := t.mappers[name]; ok {
+		record.lastHeartbeatTime = now
+	}
+}
+
+// expire stops tracking the mappers without heartbeats in MapperExpiration.
+func (t *mapperTracker) expire(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for name, record := range t.mappers {
+		if now.Sub(record.lastHeartbeatTime) > MapperExpiration {
+			klog.Infof("stop tracking mapper %s without heartbeats since %v", name, record.lastHeartbeatTime)
+			delete(t.mappers, name)
+		}
+	}
+}
+
+// list returns the mappers ordered by name.
+func (t *mapperTracker) list() []types.MapperInfo {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	mappers := make([]types.MapperInfo, 0, len(t.mappers))
+	for _, record := range t.mappers {
+		mappers = append(mappers, types.MapperInfo{
+			Name:              record.info.Name,
+			Version:           record.info.Version,
+			APIVersion:        record.info.ApiVersion,
+			Protocol:          record.info.Protocol,
+			Address:           string(record.info.Address),
+			State:             record.info.State,
+			RegisteredTime:    record.registeredTime.UnixNano() / 1e6,
+			LastHeartbeatTime: record.lastHeartbeatTime.UnixNano() / 1e6,
+		})
+	}
+	sort.Slice(mappers, func(i, j int) bool {
+		return mappers[i].Name < mappers[j].Name
+	})
+	return mappers
+}
+
+// reportMappers reports the mappers to the cloud periodically.
+func (s *server) reportMappers() {
+	ticker := time.NewTicker(MapperReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-beehiveContext.Done():
+			klog.Info("stop reporting mappers")
+			return
+		case <-ticker.C:
+			s.mappers.expire(time.Now())
+			s.sendMapperReport()
+		}
+	}
+}
+
+func (s *server) sendMapperReport() {
+	mappers := s.mappers.list()
+	if len(mappers) == 0 {
+		return
+	}
+	var report types.MapperStatusReport
+	report.BaseMessage.Timestamp = getTimestamp()
+	report.Mappers = mappers
+	payload, err := json.Marshal(report)
+	if err != nil {
+		klog.Errorf("fail to marshal mapper report with err: %v", err)
+		return
+	}
+	message := beehiveModel.NewMessage("").BuildRouter(modules.TwinGroup, deviceconst.GroupResource,
+		dtcommon.MapperStatusResource, beehiveModel.UpdateOperation).FillBody(string(payload))
+
+	beehiveContext.Send(modules.EdgeHubModuleName, *message)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmiserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
+)
+
+func TestMapperTracker(t *testing.T) {
+	tracker := newMapperTracker()
+	start := time.Now()
+	modbus := &pb.MapperInfo{Name: "modbus-mapper", Version: "v1.0.0", Protocol: "modbus", Address: []byte("/etc/kubeedge/modbus.sock")}
+
+	if !tracker.register(modbus, true, start) {
+		t.Errorf("expected the first registration to be reported")
+	}
+	if tracker.register(modbus, false, start.Add(30*time.Second)) {
+		t.Errorf("expected the heartbeat not to be reported")
+	}
+	// another mapper of the same protocol
+	modbusTCP := &pb.MapperInfo{Name: "modbus-tcp-mapper", Version: "v1.0.0", Protocol: "modbus", Address: []byte("/etc/kubeedge/modbus-tcp.sock")}
+	tracker.register(modbusTCP, true, start)
+	tracker.heartbeat("modbus-mapper", start.Add(40*time.Second))
+	tracker.heartbeat("opcua-mapper", start.Add(50*time.Second))
+
+	mappers := tracker.list()
+	if len(mappers) != 2 {
+		t.Fatalf("expected 2 mappers, got %v", mappers)
+	}
+	if mappers[1].LastHeartbeatTime != start.UnixNano()/1e6 {
+		t.Errorf("expected the heartbeat of another mapper of the same protocol not to be recorded, got %d", mappers[1].LastHeartbeatTime)
+	}
+	if mappers[0].RegisteredTime != start.UnixNano()/1e6 {
+		t.Errorf("expected registered time %d, got %d", start.UnixNano()/1e6, mappers[0].RegisteredTime)
+	}
+	if want := start.Add(40*time.Second).UnixNano() / 1e6; mappers[0].LastHeartbeatTime != want {
+		t.Errorf("expected last heartbeat time %d, got %d", want, mappers[0].LastHeartbeatTime)
+	}
+	if mappers[0].Address != "/etc/kubeedge/modbus.sock" {
+		t.Errorf("unexpected address %s", mappers[0].Address)
+	}
+
+	// the mapper restarts
+	if !tracker.register(modbus, true, start.Add(time.Minute)) {
+		t.Errorf("expected the registration after restart to be reported")
+	}
+
+	// the mapper without heartbeats is not tracked any more
+	tracker.expire(start.Add(time.Minute + MapperExpiration))
+	if mappers := tracker.list(); len(mappers) != 1 || mappers[0].Name != "modbus-mapper" {
+		t.Errorf("expected only modbus-mapper tracked, got %v", mappers)
+	}
+}
+
+func TestMapperRegisterHeartbeat(t *testing.T) {
+	modbus := &pb.MapperInfo{Name: "modbus-mapper", Version: "v1.0.0", Protocol: "modbus", Address: []byte("/etc/kubeedge/modbus.sock")}
+	s := &server{
+		limiter: rate.NewLimiter(rate.Inf, 1),
+		dmiCache: &DMICache{
+			MapperMu:   &sync.Mutex{},
+			MapperList: map[string]*pb.MapperInfo{modbus.Name: modbus},
+		},
+		mappers: newMapperTracker(),
+	}
+	s.mappers.register(modbus, true, time.Now())
+
+	// the heartbeat of the registered mapper isn't saved to db, which isn't initialized here
+	heartbeat := &pb.MapperInfo{Name: "modbus-mapper", Version: "v1.0.0", Protocol: "modbus", Address: []byte("/etc/kubeedge/modbus.sock")}
+	if _, err := s.MapperRegister(context.TODO(), &pb.MapperRegisterRequest{Mapper: heartbeat}); err != nil {
+		t.Errorf("unexpected error of heartbeat: %v", err)
+	}
+}
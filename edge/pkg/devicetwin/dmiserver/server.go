@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"path"
 	"sync"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/proto"
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/api/apis/devices/v1beta1"
@@ -40,6 +42,7 @@ import (
 	"github.com/kubeedge/kubeedge/common/constants"
 	messagepkg "github.com/kubeedge/kubeedge/edge/pkg/common/message"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/monitor"
 	deviceconfig "github.com/kubeedge/kubeedge/edge/pkg/devicetwin/config"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmiclient"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
@@ -56,6 +59,7 @@ type server struct {
 	pb.UnimplementedDeviceManagerServiceServer
 	limiter  *rate.Limiter
 	dmiCache *DMICache
+	mappers  *mapperTracker
 }
 
 type DMICache struct {
@@ -78,14 +82,23 @@ func (s *server) MapperRegister(_ context.Context, in *pb.MapperRegisterRequest)
 	}
 
 	klog.V(4).Infof("receive mapper register: %+v", in.Mapper)
-	err := saveMapper(in.Mapper)
-	if err != nil {
-		klog.Errorf("fail to save mapper %s to db with err: %v", in.Mapper.Name, err)
-		return nil, err
-	}
 	s.dmiCache.MapperMu.Lock()
-	s.dmiCache.MapperList[in.Mapper.Name] = in.Mapper
+	cached, ok := s.dmiCache.MapperList[in.Mapper.Name]
 	s.dmiCache.MapperMu.Unlock()
+	// the heartbeats of a registered mapper only refresh its heartbeat time, the mapper
+	// is saved to db when it registers with data or its info changes
+	if in.WithData || !ok || !proto.Equal(cached, in.Mapper) {
+		if err := saveMapper(in.Mapper); err != nil {
+			klog.Errorf("fail to save mapper %s to db with err: %v", in.Mapper.Name, err)
+			return nil, err
+		}
+		s.dmiCache.MapperMu.Lock()
+		s.dmiCache.MapperList[in.Mapper.Name] = in.Mapper
+		s.dmiCache.MapperMu.Unlock()
+	}
+	if s.mappers.register(in.Mapper, in.WithData, time.Now()) {
+		s.sendMapperReport()
+	}
 
 	if !in.WithData {
 		return &pb.MapperRegisterResponse{}, nil
@@ -137,7 +150,7 @@ func (s *server) ReportDeviceStatus(_ context.Context, in *pb.ReportDeviceStatus
 
 	if in != nil && in.ReportedDevice != nil && in.ReportedDevice.Twins != nil {
 		for _, twin := range in.ReportedDevice.Twins {
-			msg, err := CreateMessageTwinUpdate(twin)
+			msg, err := CreateMessageTwinUpdate(twin, s.twinMetadataOf(in.DeviceNamespace, in.DeviceName, twin.PropertyName))
 			if err != nil {
 				klog.Errorf("fail to create message data for property %s of device %s with err: %v", twin.PropertyName, in.DeviceName, err)
 				return nil, err
@@ -171,6 +184,27 @@ func (s *server) ReportDeviceStates(_ context.Context, in *pb.ReportDeviceStates
 	return &pb.ReportDeviceStatesResponse{}, nil
 }
 
+// ReportDiscoveredDevices reports the devices discovered by the mapper to the cloud, where they
+// are recorded as DiscoveredDevice and provisioned once approved.
+func (s *server) ReportDiscoveredDevices(_ context.Context, in *pb.ReportDiscoveredDevicesRequest) (*pb.
+	ReportDiscoveredDevicesResponse, error) {
+	if !s.limiter.Allow() {
+		return nil, fmt.Errorf("fail to report discovered devices because of too many request: %s", in.MapperName)
+	}
+
+	if in == nil || len(in.Devices) == 0 {
+		return &pb.ReportDiscoveredDevicesResponse{}, errors.New("ReportDiscoveredDevicesRequest does not have devices")
+	}
+	msg, err := CreateMessageDiscoveredDevices(in)
+	if err != nil {
+		klog.Errorf("fail to create discovered devices message of mapper %s with err: %v", in.MapperName, err)
+		return nil, err
+	}
+	handleDiscoveredDevices(msg)
+	s.mappers.heartbeat(in.MapperName, time.Now())
+	return &pb.ReportDiscoveredDevicesResponse{}, nil
+}
+
 func handleDeviceTwin(in *pb.ReportDeviceStatusRequest, payload []byte) {
 	deviceID := util.GetResourceID(in.DeviceNamespace, in.DeviceName)
 	topic := dtcommon.DeviceETPrefix + deviceID + dtcommon.TwinETUpdateSuffix
@@ -195,8 +229,50 @@ func handleDeviceState(in *pb.ReportDeviceStatesRequest, payload []byte) {
 	beehiveContext.SendToGroup(target, *message)
 }
 
+// handleDiscoveredDevices sends the discovered devices to the cloud directly, the mapper reports
+// them periodically so the message is not kept for resending.
+func handleDiscoveredDevices(payload []byte) {
+	message := beehiveModel.NewMessage("").BuildRouter(modules.TwinGroup, deviceconst.GroupResource,
+		dtcommon.DiscoveredDeviceResource, beehiveModel.UpdateOperation).FillBody(string(payload))
+
+	beehiveContext.Send(modules.EdgeHubModuleName, *message)
+}
+
+// DeviceAndModel returns the device and its device model in the cache, the model is nil
+// if the device doesn't refer to a device model or the model isn't found.
+func (c *DMICache) DeviceAndModel(deviceID string) (*v1beta1.Device, *v1beta1.DeviceModel) {
+	c.DeviceMu.Lock()
+	device, ok := c.DeviceList[deviceID]
+	c.DeviceMu.Unlock()
+	if !ok {
+		return nil, nil
+	}
+	if device.Spec.DeviceModelRef == nil {
+		return device, nil
+	}
+	c.DeviceModelMu.Lock()
+	model := c.DeviceModelList[util.GetResourceID(device.Namespace, device.Spec.DeviceModelRef.Name)]
+	c.DeviceModelMu.Unlock()
+	return device, model
+}
+
+// twinMetadataOf returns the type metadata of the twin if the property is of enum, object or
+// array type in the device model, the metadata is needed to validate the twin value.
+func (s *server) twinMetadataOf(namespace, deviceName, propertyName string) *types.TypeMetadata {
+	_, model := s.dmiCache.DeviceAndModel(util.GetResourceID(namespace, deviceName))
+	if model == nil {
+		return nil
+	}
+	for i := range model.Spec.Properties {
+		if model.Spec.Properties[i].Name == propertyName {
+			return types.NewTypeMetadata(&model.Spec.Properties[i])
+		}
+	}
+	return nil
+}
+
 // CreateMessageTwinUpdate create twin update message.
-func CreateMessageTwinUpdate(twin *pb.Twin) ([]byte, error) {
+func CreateMessageTwinUpdate(twin *pb.Twin, metadata *types.TypeMetadata) ([]byte, error) {
 	var updateMsg DeviceTwinUpdate
 
 	updateMsg.BaseMessage.Timestamp = getTimestamp()
@@ -204,11 +280,46 @@ func CreateMessageTwinUpdate(twin *pb.Twin) ([]byte, error) {
 	updateMsg.Twin[twin.PropertyName] = &types.MsgTwin{}
 	updateMsg.Twin[twin.PropertyName].Expected = &types.TwinValue{Value: &twin.ObservedDesired.Value}
 	updateMsg.Twin[twin.PropertyName].Actual = &types.TwinValue{Value: &twin.Reported.Value}
+	updateMsg.Twin[twin.PropertyName].Metadata = metadata
 
 	msg, err := json.Marshal(updateMsg)
 	return msg, err
 }
 
+// CreateMessageAlarmUpdate create alarm update message.
+func CreateMessageAlarmUpdate(raised, cleared, active []v1beta1.DeviceAlarm) ([]byte, error) {
+	var alarmMsg types.DeviceAlarmUpdate
+	alarmMsg.BaseMessage.Timestamp = getTimestamp()
+	alarmMsg.Raised = raised
+	alarmMsg.Cleared = cleared
+	alarmMsg.Alarms = active
+	msg, err := json.Marshal(alarmMsg)
+	return msg, err
+}
+
+// CreateMessageDiscoveredDevices create discovered devices report message.
+func CreateMessageDiscoveredDevices(in *pb.ReportDiscoveredDevicesRequest) ([]byte, error) {
+	var report types.DiscoveredDeviceReport
+	report.BaseMessage.Timestamp = getTimestamp()
+	report.MapperName = in.MapperName
+	for _, device := range in.Devices {
+		if device == nil || device.Name == "" {
+			return nil, errors.New("discovered device name is required")
+		}
+		spec, err := dtcommon.ConvertDiscoveredDevice(device)
+		if err != nil {
+			return nil, fmt.Errorf("fail to convert discovered device %s: %v", device.Name, err)
+		}
+		spec.MapperName = in.MapperName
+		report.Devices = append(report.Devices, types.DiscoveredDevice{
+			Name:      device.Name,
+			Namespace: device.Namespace,
+			Spec:      *spec,
+		})
+	}
+	return json.Marshal(report)
+}
+
 // CreateMessageStateUpdate create state update message.
 func CreateMessageStateUpdate(in *pb.ReportDeviceStatesRequest) ([]byte, error) {
 	var stateMsg DeviceStateUpdate
@@ -239,11 +350,15 @@ func StartDMIServer(cache *DMICache) {
 
 	limiter := rate.NewLimiter(rate.Every(Limit*time.Millisecond), Burst)
 
-	s := grpc.NewServer()
-	pb.RegisterDeviceManagerServiceServer(s, &server{
+	dmiServer := &server{
 		limiter:  limiter,
 		dmiCache: cache,
-	})
+		mappers:  newMapperTracker(),
+	}
+	go dmiServer.reportMappers()
+
+	s := grpc.NewServer(grpc.UnaryInterceptor(countErrors))
+	pb.RegisterDeviceManagerServiceServer(s, dmiServer)
 	reflection.Register(s)
 
 	if err := s.Serve(lis); err != nil {
@@ -253,6 +368,15 @@ func StartDMIServer(cache *DMICache) {
 	klog.Infoln("success to start DMI Server")
 }
 
+// countErrors counts the DMI requests from mappers that failed
+func countErrors(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		monitor.DeviceTwinDMIErrors.WithLabelValues(path.Base(info.FullMethod)).Inc()
+	}
+	return resp, err
+}
+
 func saveMapper(mapper *pb.MapperInfo) error {
 	content, err := json.Marshal(mapper)
 	if err != nil {
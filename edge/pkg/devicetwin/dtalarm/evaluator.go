@@ -0,0 +1,259 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dtalarm
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+)
+
+// Result is the result of evaluating the alarm rules of a device property.
+type Result struct {
+	Raised  []v1beta1.DeviceAlarm
+	Cleared []v1beta1.DeviceAlarm
+	// Resync is set on the first evaluation of the device since the evaluator started. The
+	// states of the rules are kept in memory, so the alarms recorded before the restart are
+	// unknown, and the active alarms should be synced to replace them even if nothing changes.
+	Resync bool
+}
+
+// Changed returns true if any alarm is raised or cleared.
+func (r Result) Changed() bool {
+	return len(r.Raised) != 0 || len(r.Cleared) != 0
+}
+
+// ruleState is the evaluation state of an alarm rule of a device property.
+type ruleState struct {
+	// pendingSince is the time the condition of the rule has been met since,
+	// it's zero if the condition isn't met.
+	pendingSince time.Time
+	// active is the alarm raised by the rule, it's nil if no alarm is raised.
+	active *v1beta1.DeviceAlarm
+}
+
+// Evaluator evaluates the alarm rules of device properties on each reported value,
+// it keeps the state of the rules in memory.
+type Evaluator struct {
+	mu sync.Mutex
+	// states maps the device id to the states of its rules keyed by property and rule name.
+	states map[string]map[string]*ruleState
+	// synced is the set of the devices whose active alarms have been synced since the evaluator started.
+	synced map[string]bool
+}
+
+// NewEvaluator creates an alarm rule evaluator.
+func NewEvaluator() *Evaluator {
+	return &Evaluator{
+		states: map[string]map[string]*ruleState{},
+		synced: map[string]bool{},
+	}
+}
+
+// MergeRules returns the alarm rules of a device property, the rules of the device
+// override the rules with the same name of the device model.
+func MergeRules(modelRules, deviceRules []v1beta1.AlarmRule) []v1beta1.AlarmRule {
+	if len(deviceRules) == 0 {
+		return modelRules
+	}
+	rules := make([]v1beta1.AlarmRule, 0, len(modelRules)+len(deviceRules))
+	overridden := make(map[string]bool, len(deviceRules))
+	for _, rule := range deviceRules {
+		overridden[rule.Name] = true
+	}
+	for _, rule := range modelRules {
+		if !overridden[rule.Name] {
+			rules = append(rules, rule)
+		}
+	}
+	return append(rules, deviceRules...)
+}
+
+// Evaluate evaluates the alarm rules of the property with the reported value. The alarms
+// of the rules that no longer exist are cleared.
+func (e *Evaluator) Evaluate(deviceID, propertyName, value string, rules []v1beta1.AlarmRule, now time.Time) Result {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var result Result
+	if !e.synced[deviceID] {
+		e.synced[deviceID] = true
+		result.Resync = true
+	}
+	states := e.states[deviceID]
+	if states == nil {
+		if len(rules) == 0 {
+			return result
+		}
+		states = map[string]*ruleState{}
+		e.states[deviceID] = states
+	}
+
+	current := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		key := stateKey(propertyName, rule.Name)
+		current[key] = true
+		state, ok := states[key]
+		if !ok {
+			state = &ruleState{}
+			states[key] = state
+		}
+
+		if state.active != nil {
+			met, err := evaluate(rule, value, true)
+			if err != nil {
+				klog.Warningf("failed to evaluate alarm rule %s of property %s of device %s: %v", rule.Name, propertyName, deviceID, err)
+				continue
+			}
+			if !met {
+				result.Cleared = append(result.Cleared, *state.active)
+				state.active = nil
+				state.pendingSince = time.Time{}
+			}
+			continue
+		}
+
+		met, err := evaluate(rule, value, false)
+		if err != nil {
+			klog.Warningf("failed to evaluate alarm rule %s of property %s of device %s: %v", rule.Name, propertyName, deviceID, err)
+			continue
+		}
+		if !met {
+			state.pendingSince = time.Time{}
+			continue
+		}
+		if state.pendingSince.IsZero() {
+			state.pendingSince = now
+		}
+		if now.Sub(state.pendingSince) < time.Duration(rule.DurationSeconds)*time.Second {
+			continue
+		}
+		severity := rule.Severity
+		if severity == "" {
+			severity = v1beta1.AlarmSeverityWarning
+		}
+		message := rule.Message
+		if message == "" {
+			message = fmt.Sprintf("%s is %s, which is %s %s", propertyName, value, rule.Operator, rule.Threshold)
+		}
+		state.active = &v1beta1.DeviceAlarm{
+			PropertyName: propertyName,
+			RuleName:     rule.Name,
+			Severity:     severity,
+			Value:        value,
+			Message:      message,
+			RaisedAt:     metav1.NewTime(now),
+		}
+		result.Raised = append(result.Raised, *state.active)
+	}
+
+	prefix := propertyName + "/"
+	for key, state := range states {
+		if !strings.HasPrefix(key, prefix) || current[key] {
+			continue
+		}
+		if state.active != nil {
+			result.Cleared = append(result.Cleared, *state.active)
+		}
+		delete(states, key)
+	}
+	return result
+}
+
+// ActiveAlarms returns the active alarms of the device ordered by property and rule name.
+func (e *Evaluator) ActiveAlarms(deviceID string) []v1beta1.DeviceAlarm {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	alarms := []v1beta1.DeviceAlarm{}
+	for _, state := range e.states[deviceID] {
+		if state.active != nil {
+			alarms = append(alarms, *state.active)
+		}
+	}
+	sort.Slice(alarms, func(i, j int) bool {
+		if alarms[i].PropertyName != alarms[j].PropertyName {
+			return alarms[i].PropertyName < alarms[j].PropertyName
+		}
+		return alarms[i].RuleName < alarms[j].RuleName
+	})
+	return alarms
+}
+
+// Forget removes the states of the rules of the device, it's called when the device is deleted.
+func (e *Evaluator) Forget(deviceID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.states, deviceID)
+	delete(e.synced, deviceID)
+}
+
+func stateKey(propertyName, ruleName string) string {
+	return propertyName + "/" + ruleName
+}
+
+// evaluate checks whether the value meets the condition of the rule. If the alarm of the rule
+// is active, the threshold is moved back by the hysteresis so that a value oscillating around
+// the threshold doesn't raise and clear the alarm repeatedly.
+func evaluate(rule v1beta1.AlarmRule, value string, active bool) (bool, error) {
+	switch rule.Operator {
+	case v1beta1.AlarmOperatorEqual, v1beta1.AlarmOperatorNotEqual:
+		equal := value == rule.Threshold
+		if v, err := strconv.ParseFloat(value, 64); err == nil {
+			if threshold, err := strconv.ParseFloat(rule.Threshold, 64); err == nil {
+				equal = v == threshold
+			}
+		}
+		return equal == (rule.Operator == v1beta1.AlarmOperatorEqual), nil
+	}
+
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false, fmt.Errorf("value %q is not a number", value)
+	}
+	threshold, err := strconv.ParseFloat(rule.Threshold, 64)
+	if err != nil {
+		return false, fmt.Errorf("threshold %q is not a number", rule.Threshold)
+	}
+	var hysteresis float64
+	if active && rule.Hysteresis != "" {
+		hysteresis, err = strconv.ParseFloat(rule.Hysteresis, 64)
+		if err != nil || hysteresis < 0 {
+			return false, fmt.Errorf("hysteresis %q is not a non-negative number", rule.Hysteresis)
+		}
+	}
+
+	switch rule.Operator {
+	case v1beta1.AlarmOperatorGreaterThan:
+		return v > threshold-hysteresis, nil
+	case v1beta1.AlarmOperatorGreaterThanOrEqual:
+		return v >= threshold-hysteresis, nil
+	case v1beta1.AlarmOperatorLessThan:
+		return v < threshold+hysteresis, nil
+	case v1beta1.AlarmOperatorLessThanOrEqual:
+		return v <= threshold+hysteresis, nil
+	}
+	return false, fmt.Errorf("unsupported operator %q", rule.Operator)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dtalarm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+)
+
+func TestEvaluate(t *testing.T) {
+	rule := v1beta1.AlarmRule{
+		Name:            "overheat",
+		Operator:        v1beta1.AlarmOperatorGreaterThan,
+		Threshold:       "80",
+		DurationSeconds: 30,
+		Hysteresis:      "5",
+		Severity:        v1beta1.AlarmSeverityCritical,
+	}
+	start := time.Now()
+	steps := []struct {
+		value       string
+		after       time.Duration
+		wantRaised  int
+		wantCleared int
+		wantActive  int
+	}{
+		{value: "85", after: 0},
+		// the condition hasn't been met for 30s
+		{value: "86", after: 20 * time.Second},
+		{value: "87", after: 30 * time.Second, wantRaised: 1, wantActive: 1},
+		// within the hysteresis
+		{value: "78", after: 40 * time.Second, wantActive: 1},
+		{value: "75", after: 50 * time.Second, wantCleared: 1},
+		{value: "90", after: 60 * time.Second},
+		// the condition isn't met continuously
+		{value: "70", after: 70 * time.Second},
+		{value: "90", after: 95 * time.Second},
+	}
+
+	e := NewEvaluator()
+	for i, step := range steps {
+		result := e.Evaluate("default/thermometer", "temperature", step.value, []v1beta1.AlarmRule{rule}, start.Add(step.after))
+		if len(result.Raised) != step.wantRaised || len(result.Cleared) != step.wantCleared {
+			t.Errorf("step %d: expected %d raised and %d cleared alarms, got %v", i, step.wantRaised, step.wantCleared, result)
+		}
+		if active := e.ActiveAlarms("default/thermometer"); len(active) != step.wantActive {
+			t.Errorf("step %d: expected %d active alarms, got %v", i, step.wantActive, active)
+		}
+	}
+}
+
+func TestEvaluateRemovedRule(t *testing.T) {
+	e := NewEvaluator()
+	rules := []v1beta1.AlarmRule{{Name: "fault", Operator: v1beta1.AlarmOperatorEqual, Threshold: "ERROR"}}
+	result := e.Evaluate("default/pump", "status", "ERROR", rules, time.Now())
+	if len(result.Raised) != 1 || result.Raised[0].Severity != v1beta1.AlarmSeverityWarning {
+		t.Fatalf("expected a warning alarm raised, got %v", result)
+	}
+	result = e.Evaluate("default/pump", "status", "ERROR", nil, time.Now())
+	if len(result.Cleared) != 1 {
+		t.Errorf("expected the alarm of the removed rule cleared, got %v", result)
+	}
+	if active := e.ActiveAlarms("default/pump"); len(active) != 0 {
+		t.Errorf("expected no active alarm, got %v", active)
+	}
+}
+
+func TestEvaluateResync(t *testing.T) {
+	e := NewEvaluator()
+	rules := []v1beta1.AlarmRule{{Name: "high", Operator: v1beta1.AlarmOperatorGreaterThan, Threshold: "80"}}
+	if result := e.Evaluate("default/boiler", "temperature", "20", rules, time.Now()); !result.Resync || result.Changed() {
+		t.Errorf("expected the first evaluation of the device to resync without changes, got %v", result)
+	}
+	if result := e.Evaluate("default/boiler", "pressure", "1", nil, time.Now()); result.Resync {
+		t.Errorf("expected only the first evaluation of the device to resync, got %v", result)
+	}
+	// a device without alarm rules resyncs too, so that the alarms recorded before are removed
+	if result := e.Evaluate("default/pump", "status", "OK", nil, time.Now()); !result.Resync {
+		t.Errorf("expected the first evaluation of the device without rules to resync, got %v", result)
+	}
+
+	e.Forget("default/boiler")
+	if result := e.Evaluate("default/boiler", "temperature", "20", rules, time.Now()); !result.Resync {
+		t.Errorf("expected the device forgotten to resync, got %v", result)
+	}
+}
+
+func TestEvaluateInvalidValue(t *testing.T) {
+	e := NewEvaluator()
+	rules := []v1beta1.AlarmRule{{Name: "low", Operator: v1beta1.AlarmOperatorLessThan, Threshold: "10"}}
+	if result := e.Evaluate("default/tank", "level", "empty", rules, time.Now()); result.Changed() {
+		t.Errorf("expected no alarm for a non-numeric value, got %v", result)
+	}
+}
+
+func TestMergeRules(t *testing.T) {
+	modelRules := []v1beta1.AlarmRule{
+		{Name: "high", Operator: v1beta1.AlarmOperatorGreaterThan, Threshold: "80"},
+		{Name: "low", Operator: v1beta1.AlarmOperatorLessThan, Threshold: "0"},
+	}
+	deviceRules := []v1beta1.AlarmRule{
+		{Name: "high", Operator: v1beta1.AlarmOperatorGreaterThan, Threshold: "60"},
+	}
+	rules := MergeRules(modelRules, deviceRules)
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %v", rules)
+	}
+	for _, rule := range rules {
+		if rule.Name == "high" && rule.Threshold != "60" {
+			t.Errorf("expected the rule of the device to override the rule of the model, got %v", rule)
+		}
+	}
+}
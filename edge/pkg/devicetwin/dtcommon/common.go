@@ -51,6 +51,16 @@ const (
 	DeviceETStateUpdateSuffix = "/state/update"
 	// DeviceETStateUpdateResultSuffix the topic suffix for device state update result event
 	DeviceETStateUpdateResultSuffix = "/state/update/result"
+	// DeviceETAlarmUpdateSuffix the topic suffix for device alarm update event
+	DeviceETAlarmUpdateSuffix = "/alarm/update"
+	// DeviceETAlarmRaiseSuffix the topic suffix for device alarm raised event
+	DeviceETAlarmRaiseSuffix = "/alarm/raise"
+	// DeviceETAlarmClearSuffix the topic suffix for device alarm cleared event
+	DeviceETAlarmClearSuffix = "/alarm/clear"
+	// DiscoveredDeviceResource the resource of the message reporting discovered devices to the cloud
+	DiscoveredDeviceResource = "device/discovered/report"
+	// MapperStatusResource the resource of the message reporting mappers to the cloud
+	MapperStatusResource = "device/mapper/status"
 	// DeviceETStateGetSuffix the topic suffix for device state get event
 	DeviceETStateGetSuffix = "/state/get"
 
@@ -65,6 +75,8 @@ const (
 
 	// TwinGet get twin
 	TwinGet = "TwinGet"
+	// TwinReported twin actual values reported by the device
+	TwinReported = "TwinReported"
 	// TwinUpdate twin update
 	TwinUpdate = "TwinUpdate"
 	// TwinCloudSync twin cloud sync
@@ -78,6 +90,8 @@ const (
 	DeviceStateGet = "DeviceStateGet"
 	// DeviceStateUpdate device state update
 	DeviceStateUpdate = "DeviceStateUpdate"
+	// DeviceAlarmUpdate device alarm update
+	DeviceAlarmUpdate = "DeviceAlarmUpdate"
 
 	// SendToEdge send info to edge
 	SendToEdge = "SendToEdge"
@@ -123,6 +137,9 @@ const (
 	TypeDeleted = "deleted"
 	TypeUpdated = "updated"
 
+	// MaxStructuredTwinValueLength the max length of the twin value of object or array type
+	MaxStructuredTwinValueLength = 64 * 1024
+
 	DeviceStatusOK        = "ok"
 	DeviceStatusOnline    = "online"
 	DeviceStatusOffline   = "offline"
@@ -11,6 +11,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/api/apis/devices/v1beta1"
@@ -18,8 +19,8 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
 )
 
-// ValidateValue validate value type
-func ValidateValue(valueType string, value string) error {
+// ValidateValue validate value type, the value of enum type must be one of enumValues
+func ValidateValue(valueType string, value string, enumValues ...string) error {
 	switch valueType {
 	case "":
 		valueType = constants.DataTypeString
@@ -43,6 +44,20 @@ func ValidateValue(valueType string, value string) error {
 			return errors.New("the bool value must be true or false")
 		}
 		return nil
+	case constants.DataTypeEnum:
+		return ValidateEnumValue(enumValues, value)
+	case constants.DataTypeObject:
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(value), &object); err != nil || object == nil {
+			return errors.New("the value is not a JSON object")
+		}
+		return nil
+	case constants.DataTypeArray:
+		var array []interface{}
+		if err := json.Unmarshal([]byte(value), &array); err != nil || array == nil {
+			return errors.New("the value is not a JSON array")
+		}
+		return nil
 	case TypeDeleted:
 		return nil
 	default:
@@ -50,6 +65,24 @@ func ValidateValue(valueType string, value string) error {
 	}
 }
 
+// ValidateEnumValue validate value is one of the allowed values of enum type
+func ValidateEnumValue(enumValues []string, value string) error {
+	if len(enumValues) == 0 {
+		return nil
+	}
+	for _, v := range enumValues {
+		if v == value {
+			return nil
+		}
+	}
+	return fmt.Errorf("the value must be one of %v", enumValues)
+}
+
+// IsStructuredType returns whether the values of the type are JSON documents
+func IsStructuredType(valueType string) bool {
+	return valueType == constants.DataTypeObject || valueType == constants.DataTypeArray
+}
+
 // ValidateTwinKey validate twin key
 func ValidateTwinKey(key string) bool {
 	pattern := "^[a-zA-Z0-9-_.,:/@#]{1,128}$"
@@ -138,6 +171,18 @@ func convertDeviceProperty(prop *v1beta1.DeviceProperty) (*pb.DeviceProperty, er
 	return item, nil
 }
 func ConvertDeviceModel(model *v1beta1.DeviceModel) (*pb.DeviceModel, error) {
+	// the schema is a JSON document in device model while a string in DMI,
+	// so it is converted separately
+	schemas := make([]string, len(model.Spec.Properties))
+	if hasPropertySchema(model) {
+		model = model.DeepCopy()
+		for i := range model.Spec.Properties {
+			if schema := model.Spec.Properties[i].Schema; schema != nil {
+				schemas[i] = string(schema.Raw)
+				model.Spec.Properties[i].Schema = nil
+			}
+		}
+	}
 	data, err := json.Marshal(model)
 	if err != nil {
 		klog.Errorf("fail to marshal device model %s with err: %v", model.Name, err)
@@ -149,10 +194,94 @@ func ConvertDeviceModel(model *v1beta1.DeviceModel) (*pb.DeviceModel, error) {
 		klog.Errorf("fail to unmarshal device model %s with err: %v", model.Name, err)
 		return nil, err
 	}
+	if edgeDeviceModel.Spec != nil {
+		for i, property := range edgeDeviceModel.Spec.Properties {
+			if i < len(schemas) {
+				property.Schema = schemas[i]
+			}
+		}
+	}
 	edgeDeviceModel.Name = model.Name
 	edgeDeviceModel.Namespace = model.Namespace
 	return &edgeDeviceModel, nil
 }
+
+// ConvertDiscoveredDevice converts the device discovered by a mapper to the spec of DiscoveredDevice.
+func ConvertDiscoveredDevice(device *pb.DiscoveredDevice) (*v1beta1.DiscoveredDeviceSpec, error) {
+	if device == nil {
+		return nil, errors.New("discovered device cannot be nil")
+	}
+
+	spec := &v1beta1.DiscoveredDeviceSpec{
+		Attributes: device.Attributes,
+	}
+	if device.ModelName != "" {
+		spec.DeviceModelRef = &v1.LocalObjectReference{Name: device.ModelName}
+	}
+	if device.Protocol != nil {
+		configData, err := convertCustomizedValue(device.Protocol.ConfigData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert protocol config data: %v", err)
+		}
+		spec.Protocol = v1beta1.ProtocolConfig{
+			ProtocolName: device.Protocol.ProtocolName,
+			ConfigData:   configData,
+		}
+	}
+	for _, prop := range device.Properties {
+		if prop == nil {
+			continue
+		}
+		property := v1beta1.DeviceProperty{
+			Name:          prop.Name,
+			ReportCycle:   prop.ReportCycle,
+			CollectCycle:  prop.CollectCycle,
+			ReportToCloud: prop.ReportToCloud,
+		}
+		if prop.Desired != nil {
+			property.Desired = v1beta1.TwinProperty{
+				Value:    prop.Desired.Value,
+				Metadata: prop.Desired.Metadata,
+			}
+		}
+		if prop.Visitors != nil {
+			configData, err := convertCustomizedValue(prop.Visitors.ConfigData)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert visitor config data of property %s: %v", prop.Name, err)
+			}
+			property.Visitors = v1beta1.VisitorConfig{
+				ProtocolName: prop.Visitors.ProtocolName,
+				ConfigData:   configData,
+			}
+		}
+		spec.Properties = append(spec.Properties, property)
+	}
+	return spec, nil
+}
+
+func convertCustomizedValue(value *pb.CustomizedValue) (*v1beta1.CustomizedValue, error) {
+	if value == nil || value.Data == nil {
+		return nil, nil
+	}
+	data := make(map[string]interface{}, len(value.Data))
+	for k, v := range value.Data {
+		d, err := anyToData(v)
+		if err != nil {
+			return nil, err
+		}
+		data[k] = d
+	}
+	return &v1beta1.CustomizedValue{Data: data}, nil
+}
+
+func hasPropertySchema(model *v1beta1.DeviceModel) bool {
+	for i := range model.Spec.Properties {
+		if model.Spec.Properties[i].Schema != nil {
+			return true
+		}
+	}
+	return false
+}
 func dataToAny(v interface{}) (*anypb.Any, error) {
 	switch m := v.(type) {
 	case string:
@@ -231,3 +360,29 @@ func dataToAny(v interface{}) (*anypb.Any, error) {
 		return nil, fmt.Errorf("%v does not support converting to any", reflect.TypeOf(v))
 	}
 }
+
+func anyToData(v *anypb.Any) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	m, err := v.UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+	switch w := m.(type) {
+	case *wrapperspb.StringValue:
+		return w.GetValue(), nil
+	case *wrapperspb.Int32Value:
+		return int64(w.GetValue()), nil
+	case *wrapperspb.Int64Value:
+		return w.GetValue(), nil
+	case *wrapperspb.FloatValue:
+		return float64(w.GetValue()), nil
+	case *wrapperspb.DoubleValue:
+		return w.GetValue(), nil
+	case *wrapperspb.BoolValue:
+		return w.GetValue(), nil
+	default:
+		return nil, fmt.Errorf("%s does not support converting from any", v.GetTypeUrl())
+	}
+}
@@ -24,8 +24,10 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/kubeedge/api/apis/devices/v1beta1"
+	pb "github.com/kubeedge/api/apis/dmi/v1beta1"
 )
 
 // TestValidateValue is function to test ValidateValue
@@ -37,6 +39,8 @@ func TestValidateValue(t *testing.T) {
 		valueType string
 		// value is value in the test case, second parameter to ValidateValue function
 		value string
+		// enumValues is the allowed values of enum type, third parameter to ValidateValue function
+		enumValues []string
 		// wantErr is expected error in the test case, returned by ValidateValue function
 		wantErr error
 	}{{
@@ -105,13 +109,51 @@ func TestValidateValue(t *testing.T) {
 		valueType: "boolean",
 		value:     "false",
 		wantErr:   nil,
+	}, {
+		// enum success
+		name:       "ValidateValueEnumSuccessCase",
+		valueType:  "enum",
+		value:      "auto",
+		enumValues: []string{"auto", "manual"},
+		wantErr:    nil,
+	}, {
+		// enum error
+		name:       "ValidateValueEnumErrorCase",
+		valueType:  "enum",
+		value:      "off",
+		enumValues: []string{"auto", "manual"},
+		wantErr:    errors.New("the value must be one of [auto manual]"),
+	}, {
+		// object success
+		name:      "ValidateValueObjectSuccessCase",
+		valueType: "object",
+		value:     `{"x":1.5,"y":2,"z":0}`,
+		wantErr:   nil,
+	}, {
+		// object error
+		name:      "ValidateValueObjectErrorCase",
+		valueType: "object",
+		value:     "[1,2,3]",
+		wantErr:   errors.New("the value is not a JSON object"),
+	}, {
+		// array success
+		name:      "ValidateValueArraySuccessCase",
+		valueType: "array",
+		value:     "[1,2,3]",
+		wantErr:   nil,
+	}, {
+		// array error
+		name:      "ValidateValueArrayErrorCase",
+		valueType: "array",
+		value:     "test",
+		wantErr:   errors.New("the value is not a JSON array"),
 	},
 	}
 
 	// run the test cases
 	for _, test := range cases {
 		t.Run(test.name, func(t *testing.T) {
-			err := ValidateValue(test.valueType, test.value)
+			err := ValidateValue(test.valueType, test.value, test.enumValues...)
 			if (err == nil && err != test.wantErr) || (err != nil && err.Error() != test.wantErr.Error()) {
 				t.Errorf("TestValidateValue Case failed: wanted %v and got %v", test.wantErr, err)
 			}
@@ -119,6 +161,20 @@ func TestValidateValue(t *testing.T) {
 	}
 }
 
+// TestValidateEnumValue is function to test ValidateEnumValue
+func TestValidateEnumValue(t *testing.T) {
+	enumValues := []string{"auto", "manual"}
+	if err := ValidateEnumValue(enumValues, "manual"); err != nil {
+		t.Errorf("expected value in enum values to be valid, got %v", err)
+	}
+	if err := ValidateEnumValue(enumValues, "off"); err == nil {
+		t.Errorf("expected value not in enum values to be invalid")
+	}
+	if err := ValidateEnumValue(nil, "off"); err != nil {
+		t.Errorf("expected any value to be valid without enum values, got %v", err)
+	}
+}
+
 // TestValidateTwinKey is function to test ValidateTwinKey
 func TestValidateTwinKey(t *testing.T) {
 	cases := []struct {
@@ -538,6 +594,29 @@ func TestConvertDeviceModel(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertDeviceModelWithStructuredProperties(t *testing.T) {
+	schema := `{"type":"object","properties":{"x":{"type":"number"}}}`
+	model := &v1beta1.DeviceModel{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-model", Namespace: "default"},
+		Spec: v1beta1.DeviceModelSpec{
+			Properties: []v1beta1.ModelProperty{
+				{Name: "mode", Type: v1beta1.ENUM, EnumValues: []string{"auto", "manual"}},
+				{Name: "acceleration", Type: v1beta1.OBJECT, Schema: &runtime.RawExtension{Raw: []byte(schema)}},
+			},
+		},
+	}
+
+	got, err := ConvertDeviceModel(model)
+	assert.NoError(t, err)
+	assert.Len(t, got.Spec.Properties, 2)
+	assert.Equal(t, []string{"auto", "manual"}, got.Spec.Properties[0].EnumValues)
+	assert.Equal(t, "", got.Spec.Properties[0].Schema)
+	assert.Equal(t, "OBJECT", got.Spec.Properties[1].Type)
+	assert.Equal(t, schema, got.Spec.Properties[1].Schema)
+	// the passed-in model should not be modified
+	assert.NotNil(t, model.Spec.Properties[1].Schema)
+}
 func TestConvertDeviceProperty(t *testing.T) {
 	invalidValue := func() {}
 	type CustomValue struct {
@@ -616,3 +695,59 @@ func TestConvertDeviceProperty(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertDevicePropertyPrometheusPushMethod(t *testing.T) {
+	prop := &v1beta1.DeviceProperty{
+		Name: "temperature",
+		PushMethod: &v1beta1.PushMethod{
+			Prometheus: &v1beta1.PushMethodPrometheus{
+				Port:       9100,
+				MetricName: "room_temperature",
+				Labels:     map[string]string{"room": "101"},
+			},
+		},
+	}
+	got, err := convertDeviceProperty(prop)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9100), got.GetPushMethod().GetPrometheus().GetPort())
+	assert.Equal(t, "room_temperature", got.GetPushMethod().GetPrometheus().GetMetricName())
+	assert.Equal(t, map[string]string{"room": "101"}, got.GetPushMethod().GetPrometheus().GetLabels())
+}
+
+func TestConvertDiscoveredDevice(t *testing.T) {
+	slaveID, err := anypb.New(wrapperspb.Int32(1))
+	assert.NoError(t, err)
+	port, err := anypb.New(wrapperspb.String("/dev/ttyS0"))
+	assert.NoError(t, err)
+	offset, err := anypb.New(wrapperspb.Int64(2))
+	assert.NoError(t, err)
+
+	spec, err := ConvertDiscoveredDevice(&pb.DiscoveredDevice{
+		Name:      "thermometer",
+		ModelName: "thermometer-model",
+		Protocol: &pb.ProtocolConfig{
+			ProtocolName: "modbus",
+			ConfigData:   &pb.CustomizedValue{Data: map[string]*anypb.Any{"slaveID": slaveID, "port": port}},
+		},
+		Properties: []*pb.DeviceProperty{{
+			Name:        "temperature",
+			ReportCycle: 10000,
+			Visitors: &pb.VisitorConfig{
+				ProtocolName: "modbus",
+				ConfigData:   &pb.CustomizedValue{Data: map[string]*anypb.Any{"offset": offset}},
+			},
+		}},
+		Attributes: map[string]string{"serialNumber": "SN001"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "thermometer-model", spec.DeviceModelRef.Name)
+	assert.Equal(t, "modbus", spec.Protocol.ProtocolName)
+	assert.Equal(t, map[string]interface{}{"slaveID": int64(1), "port": "/dev/ttyS0"}, spec.Protocol.ConfigData.Data)
+	assert.Len(t, spec.Properties, 1)
+	assert.Equal(t, int64(10000), spec.Properties[0].ReportCycle)
+	assert.Equal(t, map[string]interface{}{"offset": int64(2)}, spec.Properties[0].Visitors.ConfigData.Data)
+	assert.Equal(t, "SN001", spec.Attributes["serialNumber"])
+
+	_, err = ConvertDiscoveredDevice(nil)
+	assert.Error(t, err)
+}
@@ -27,6 +27,7 @@ type DTContext struct {
 	ModulesContext *context.Context
 	DeviceList     *sync.Map
 	DeviceMutex    *sync.Map
+	DeviceAlarms   *sync.Map
 	Mutex          *sync.RWMutex
 	// DBConn *dtclient.Conn
 	State string
@@ -43,6 +44,7 @@ func InitDTContext() (*DTContext, error) {
 		ModulesHealth: &sync.Map{},
 		DeviceList:    &sync.Map{},
 		DeviceMutex:   &sync.Map{},
+		DeviceAlarms:  &sync.Map{},
 		Mutex:         &sync.RWMutex{},
 		State:         dtcommon.Disconnected,
 	}, nil
@@ -111,6 +111,7 @@ func dealLifeCycle(context *dtcontext.DTContext, _ string, msg interface{}) erro
 				klog.Errorf("detail request: %v", err)
 				return err
 			}
+			resyncAlarms(context)
 		}
 		context.State = dtcommon.Connected
 	} else if strings.Compare(connectedInfo, connect.CloudDisconnected) == 0 {
@@ -132,6 +133,23 @@ func dealConfirm(context *dtcontext.DTContext, _ string, msg interface{}) error
 	return nil
 }
 
+// resyncAlarms syncs the latest alarms of the devices to the cloud, so that the alarms
+// raised or cleared while the cloud was disconnected are reflected in the device status.
+func resyncAlarms(context *dtcontext.DTContext) {
+	context.DeviceAlarms.Range(func(key, value interface{}) bool {
+		deviceID, _ := key.(string)
+		payload, _ := value.([]byte)
+		if _, exist := context.DeviceList.Load(deviceID); !exist {
+			context.DeviceAlarms.Delete(deviceID)
+			return true
+		}
+		message := context.BuildModelMessage("resource", "", AlarmResource(deviceID), model.UpdateOperation, string(payload))
+		context.ConfirmMap.Store(message.GetID(), &dttype.DTMessage{Msg: message, Action: dtcommon.SendToCloud, Type: dtcommon.CommModule})
+		beehiveContext.Send(dtcommon.HubModule, *message)
+		return true
+	})
+}
+
 func detailRequest(context *dtcontext.DTContext) error {
 	getDetail := dttype.GetDetailNode{
 		EventType: "group_membership_event",
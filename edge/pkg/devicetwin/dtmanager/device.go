@@ -9,7 +9,9 @@ import (
 
 	"k8s.io/klog/v2"
 
+	"github.com/kubeedge/api/apis/devices/v1beta1"
 	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/types"
 	messagepkg "github.com/kubeedge/kubeedge/edge/pkg/common/message"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtclient"
@@ -63,6 +65,7 @@ func initDeviceActionCallBack() {
 	deviceActionCallBack = make(map[string]CallBack)
 	deviceActionCallBack[dtcommon.DeviceUpdated] = dealDeviceAttrUpdate
 	deviceActionCallBack[dtcommon.DeviceStateUpdate] = dealDeviceStateUpdate
+	deviceActionCallBack[dtcommon.DeviceAlarmUpdate] = dealDeviceAlarmUpdate
 }
 
 func dealDeviceStateUpdate(context *dtcontext.DTContext, resource string, msg interface{}) error {
@@ -143,6 +146,73 @@ func dealDeviceStateUpdate(context *dtcontext.DTContext, resource string, msg in
 	return nil
 }
 
+// dealDeviceAlarmUpdate publishes the raised and cleared alarms of the device evaluated by
+// the DMI module to the edge, and syncs the active alarms of the device to the cloud.
+func dealDeviceAlarmUpdate(context *dtcontext.DTContext, resource string, msg interface{}) error {
+	message, ok := msg.(*model.Message)
+	if !ok {
+		return errors.New("msg not Message type")
+	}
+
+	var alarmUpdate types.DeviceAlarmUpdate
+	if err := json.Unmarshal(message.Content.([]byte), &alarmUpdate); err != nil {
+		klog.Errorf("Unmarshal device alarms failed, err: %#v", err)
+		return err
+	}
+	deviceID := resource
+	if _, exist := context.DeviceList.Load(deviceID); !exist {
+		return nil
+	}
+
+	publish := func(suffix string, alarms []v1beta1.DeviceAlarm) {
+		topic := dtcommon.DeviceETPrefix + deviceID + suffix
+		for _, alarm := range alarms {
+			payload, err := json.Marshal(types.DeviceAlarmEvent{BaseMessage: buildBaseMessage(), Alarm: alarm})
+			if err != nil {
+				klog.Errorf("Marshal alarm %s of device %s failed, err: %#v", alarm.RuleName, deviceID, err)
+				continue
+			}
+			err = context.Send(deviceID,
+				dtcommon.SendToEdge,
+				dtcommon.CommModule,
+				context.BuildModelMessage(modules.BusGroup, "", topic, messagepkg.OperationPublish, payload))
+			if err != nil {
+				klog.Error(err)
+			}
+		}
+	}
+	publish(dtcommon.DeviceETAlarmRaiseSuffix, alarmUpdate.Raised)
+	publish(dtcommon.DeviceETAlarmClearSuffix, alarmUpdate.Cleared)
+
+	payload, err := json.Marshal(types.DeviceAlarmUpdate{BaseMessage: buildBaseMessage(), Alarms: alarmUpdate.Alarms})
+	if err != nil {
+		return err
+	}
+	// the latest alarms are kept to be synced again when the cloud is connected
+	context.DeviceAlarms.Store(deviceID, payload)
+	if context.State == dtcommon.Disconnected {
+		return nil
+	}
+	err = context.Send(deviceID,
+		dtcommon.SendToCloud,
+		dtcommon.CommModule,
+		context.BuildModelMessage("resource", "", AlarmResource(deviceID), model.UpdateOperation, string(payload)))
+	if err != nil {
+		klog.Error(err)
+	}
+	return nil
+}
+
+// AlarmResource returns the resource of the message syncing the alarms of the device to the cloud.
+func AlarmResource(deviceID string) string {
+	return "device/" + deviceID + dtcommon.DeviceETAlarmUpdateSuffix
+}
+
+func buildBaseMessage() types.BaseMessage {
+	base := dttype.BuildBaseMessage()
+	return types.BaseMessage{EventID: base.EventID, Timestamp: base.Timestamp}
+}
+
 func dealDeviceAttrUpdate(context *dtcontext.DTContext, resource string, msg interface{}) error {
 	message, ok := msg.(*model.Message)
 	if !ok {
@@ -20,8 +20,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"k8s.io/klog/v2"
 
@@ -31,6 +33,7 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/constants"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmiclient"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmiserver"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtalarm"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcontext"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dttype"
@@ -43,6 +46,8 @@ type DMIWorker struct {
 	Worker
 	Group    string
 	dmiCache *dmiserver.DMICache
+	// alarms keeps the states of the alarm rules of the devices
+	alarms *dtalarm.Evaluator
 	//dmiActionCallBack map for action to callback
 	dmiActionCallBack map[string]CallBack
 }
@@ -56,6 +61,7 @@ func (dw *DMIWorker) init() {
 		DeviceList:      make(map[string]*v1beta1.Device),
 		DeviceModelList: make(map[string]*v1beta1.DeviceModel),
 	}
+	dw.alarms = dtalarm.NewEvaluator()
 
 	dw.initDMIActionCallBack()
 	dw.initDeviceModelInfoFromDB()
@@ -102,6 +108,77 @@ func (dw DMIWorker) Start() {
 func (dw *DMIWorker) initDMIActionCallBack() {
 	dw.dmiActionCallBack = make(map[string]CallBack)
 	dw.dmiActionCallBack[dtcommon.MetaDeviceOperation] = dw.dealMetaDeviceOperation
+	dw.dmiActionCallBack[dtcommon.TwinReported] = dw.dealTwinReported
+}
+
+// dealTwinReported evaluates the alarm rules of the device properties with the reported values,
+// and sends the raised and cleared alarms to the device module if any alarm changes. The active
+// alarms are also sent on the first evaluation of the device after edgecore restarts, to replace
+// the alarms recorded before.
+func (dw *DMIWorker) dealTwinReported(context *dtcontext.DTContext, resource string, msg interface{}) error {
+	message, ok := msg.(*model.Message)
+	if !ok {
+		return errors.New("msg not Message type")
+	}
+	reported, ok := message.Content.(map[string]string)
+	if !ok {
+		return errors.New("invalid message content")
+	}
+	deviceID := resource
+	device, deviceModel := dw.dmiCache.DeviceAndModel(deviceID)
+	if device == nil {
+		dw.alarms.Forget(deviceID)
+		return nil
+	}
+
+	propertyNames := make([]string, 0, len(reported))
+	for propertyName := range reported {
+		propertyNames = append(propertyNames, propertyName)
+	}
+	sort.Strings(propertyNames)
+	now := time.Now()
+	var raised, cleared []v1beta1.DeviceAlarm
+	var resync bool
+	for _, propertyName := range propertyNames {
+		result := dw.alarms.Evaluate(deviceID, propertyName, reported[propertyName],
+			alarmRulesOf(device, deviceModel, propertyName), now)
+		raised = append(raised, result.Raised...)
+		cleared = append(cleared, result.Cleared...)
+		resync = resync || result.Resync
+	}
+	if len(raised) == 0 && len(cleared) == 0 && !resync {
+		return nil
+	}
+
+	payload, err := dmiserver.CreateMessageAlarmUpdate(raised, cleared, dw.alarms.ActiveAlarms(deviceID))
+	if err != nil {
+		return fmt.Errorf("fail to create alarm message data of device %s with err: %v", deviceID, err)
+	}
+	return context.Send(deviceID,
+		dtcommon.DeviceAlarmUpdate,
+		dtcommon.DeviceModule,
+		context.BuildModelMessage("resource", "", deviceID, model.UpdateOperation, payload))
+}
+
+// alarmRulesOf returns the alarm rules of the device property, the rules defined in the device
+// override the rules with the same name defined in the device model.
+func alarmRulesOf(device *v1beta1.Device, deviceModel *v1beta1.DeviceModel, propertyName string) []v1beta1.AlarmRule {
+	var modelRules, deviceRules []v1beta1.AlarmRule
+	if deviceModel != nil {
+		for _, property := range deviceModel.Spec.Properties {
+			if property.Name == propertyName {
+				modelRules = property.AlarmRules
+				break
+			}
+		}
+	}
+	for _, property := range device.Spec.Properties {
+		if property.Name == propertyName {
+			deviceRules = property.AlarmRules
+			break
+		}
+	}
+	return dtalarm.MergeRules(modelRules, deviceRules)
 }
 
 func (dw *DMIWorker) dealMetaDeviceOperation(_ *dtcontext.DTContext, _ string, msg interface{}) error {
@@ -141,6 +218,7 @@ func (dw *DMIWorker) dealMetaDeviceOperation(_ *dtcontext.DTContext, _ string, m
 			dw.dmiCache.DeviceMu.Lock()
 			delete(dw.dmiCache.DeviceList, deviceID)
 			dw.dmiCache.DeviceMu.Unlock()
+			dw.alarms.Forget(deviceID)
 		case model.UpdateOperation:
 			dw.dmiCache.DeviceMu.Lock()
 			dw.dmiCache.DeviceList[deviceID] = &device
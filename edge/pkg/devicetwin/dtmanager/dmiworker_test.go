@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dtmanager
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/devicecontroller/types"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dmiserver"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtalarm"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcontext"
+	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dttype"
+)
+
+func TestDealTwinReported(t *testing.T) {
+	deviceID := "default/sensor"
+	dw := &DMIWorker{
+		dmiCache: &dmiserver.DMICache{
+			DeviceMu:      &sync.Mutex{},
+			DeviceModelMu: &sync.Mutex{},
+			DeviceList: map[string]*v1beta1.Device{
+				deviceID: {
+					ObjectMeta: metav1.ObjectMeta{Name: "sensor", Namespace: "default"},
+					Spec: v1beta1.DeviceSpec{
+						Properties: []v1beta1.DeviceProperty{{
+							Name: "temperature",
+							AlarmRules: []v1beta1.AlarmRule{{
+								Name:      "overheat",
+								Operator:  v1beta1.AlarmOperatorGreaterThan,
+								Threshold: "80",
+							}},
+						}},
+					},
+				},
+			},
+			DeviceModelList: map[string]*v1beta1.DeviceModel{},
+		},
+		alarms: dtalarm.NewEvaluator(),
+	}
+	deviceChan := make(chan interface{}, 1)
+	context := &dtcontext.DTContext{
+		CommChan: map[string]chan interface{}{dtcommon.DeviceModule: deviceChan},
+	}
+	report := func(value string) *types.DeviceAlarmUpdate {
+		msg := context.BuildModelMessage("resource", "", deviceID, model.UpdateOperation,
+			map[string]string{"temperature": value})
+		if err := dw.dealTwinReported(context, deviceID, msg); err != nil {
+			t.Fatalf("dealTwinReported() failed: %v", err)
+		}
+		select {
+		case sent := <-deviceChan:
+			dtMsg := sent.(*dttype.DTMessage)
+			if dtMsg.Action != dtcommon.DeviceAlarmUpdate || dtMsg.Identity != deviceID {
+				t.Fatalf("want action %s of %s, got %s of %s", dtcommon.DeviceAlarmUpdate, deviceID, dtMsg.Action, dtMsg.Identity)
+			}
+			var update types.DeviceAlarmUpdate
+			if err := json.Unmarshal(dtMsg.Msg.Content.([]byte), &update); err != nil {
+				t.Fatalf("invalid alarm update: %v", err)
+			}
+			return &update
+		default:
+			return nil
+		}
+	}
+
+	// the first evaluation syncs the active alarms even if nothing is raised
+	if update := report("20"); update == nil || len(update.Alarms) != 0 {
+		t.Errorf("want the empty active alarms synced, got %v", update)
+	}
+	if update := report("30"); update != nil {
+		t.Errorf("want no alarm update, got %v", update)
+	}
+	if update := report("90"); update == nil || len(update.Raised) != 1 || update.Raised[0].RuleName != "overheat" {
+		t.Errorf("want alarm overheat raised, got %v", update)
+	}
+	if update := report("70"); update == nil || len(update.Cleared) != 1 || len(update.Alarms) != 0 {
+		t.Errorf("want alarm overheat cleared, got %v", update)
+	}
+}
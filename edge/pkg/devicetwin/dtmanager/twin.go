@@ -13,6 +13,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core/model"
 	messagepkg "github.com/kubeedge/kubeedge/edge/pkg/common/message"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/monitor"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtclient"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcommon"
 	"github.com/kubeedge/kubeedge/edge/pkg/devicetwin/dtcontext"
@@ -112,6 +113,7 @@ func dealTwinSync(context *dtcontext.DTContext, resource string, msg interface{}
 	}
 
 	klog.Infof("Begin to update twin of the device %s", resource)
+	monitor.DeviceTwinUpdates.WithLabelValues(monitor.SourceCloud).Inc()
 	eventID := msgTwin.EventID
 	context.Lock(resource)
 	if err := DealDeviceTwin(context, resource, eventID, msgTwin.Twin, SyncDealType); err != nil {
@@ -173,6 +175,7 @@ func Updated(context *dtcontext.DTContext, deviceID string, payload []byte) {
 		return
 	}
 	klog.Infof("Begin to update twin of the device %s", deviceID)
+	monitor.DeviceTwinUpdates.WithLabelValues(monitor.SourceEdge).Inc()
 	eventID := msg.EventID
 	if err := DealDeviceTwin(context, deviceID, eventID, msg.Twin, RestDealType); err != nil {
 		// TODO: handle error
@@ -248,6 +251,7 @@ func DealDeviceTwin(context *dtcontext.DTContext, deviceID string, eventID strin
 		if err != nil { // The error returned by dtclient.DeviceTwinTrans()
 			return err
 		}
+		dealTwinReported(context, deviceID, content)
 	}
 	if len(dealTwinResult.Document) > 0 {
 		if err := dealDocument(context, deviceID, dttype.BaseMessage{EventID: eventID, Timestamp: now}, dealTwinResult.Document); err != nil {
@@ -273,6 +277,28 @@ func DealDeviceTwin(context *dtcontext.DTContext, deviceID string, eventID strin
 	return nil
 }
 
+// dealTwinReported sends the actual values of the twins reported by the device to the DMI module,
+// which evaluates the alarm rules of the device properties. The twins reported by the mappers
+// through DMI and by the devices through MQTT are both updated here.
+func dealTwinReported(context *dtcontext.DTContext, deviceID string, msgTwin map[string]*dttype.MsgTwin) {
+	reported := make(map[string]string, len(msgTwin))
+	for name, twin := range msgTwin {
+		if twin != nil && twin.Actual != nil && twin.Actual.Value != nil {
+			reported[name] = *twin.Actual.Value
+		}
+	}
+	if len(reported) == 0 {
+		return
+	}
+	err := context.Send(deviceID,
+		dtcommon.TwinReported,
+		dtcommon.DMIModule,
+		context.BuildModelMessage("resource", "", deviceID, model.UpdateOperation, reported))
+	if err != nil {
+		klog.Errorf("Send reported twins of device %s to evaluate alarms failed, err: %v", deviceID, err)
+	}
+}
+
 // dealUpdateResult build update result and send result, if success send the current state
 func dealUpdateResult(context *dtcontext.DTContext, deviceID string, eventID string, code int, err error, payload []byte) error {
 	klog.Infof("Deal update result of device %s: Build and send result", deviceID)
@@ -551,7 +577,9 @@ func isTwinValueDiff(twin *dttype.MsgTwin, msgTwin *dttype.MsgTwin, dealType int
 	}
 
 	valueType := stringType
+	metadata := twin.Metadata
 	if strings.Compare(twin.Metadata.Type, dtcommon.TypeDeleted) == 0 {
+		metadata = msgTwin.Metadata
 		if msgTwin.Metadata != nil {
 			valueType = msgTwin.Metadata.Type
 		}
@@ -560,7 +588,7 @@ func isTwinValueDiff(twin *dttype.MsgTwin, msgTwin *dttype.MsgTwin, dealType int
 	}
 	if hasMsgTwin {
 		if hasTwin {
-			err := dtcommon.ValidateValue(valueType, *msgTwinValue.Value)
+			err := validateTwinValue(metadata, valueType, *msgTwinValue.Value)
 			if err != nil {
 				return false, err
 			}
@@ -571,6 +599,15 @@ func isTwinValueDiff(twin *dttype.MsgTwin, msgTwin *dttype.MsgTwin, dealType int
 	return false, nil
 }
 
+// validateTwinValue validates the value against the value type and the allowed values in the metadata
+func validateTwinValue(metadata *dttype.TypeMetadata, valueType string, value string) error {
+	var enumValues []string
+	if metadata != nil {
+		enumValues = metadata.EnumValues
+	}
+	return dtcommon.ValidateValue(valueType, value, enumValues...)
+}
+
 func dealTwinCompare(returnResult *dttype.DealTwinResult, deviceID string, key string, twin *dttype.MsgTwin, msgTwin *dttype.MsgTwin, dealType int) error {
 	klog.Info("dealtwincompare")
 	now := time.Now().UnixNano() / 1e6
@@ -849,7 +886,7 @@ func dealTwinAdd(returnResult *dttype.DealTwinResult, deviceID string, key strin
 			valueType = msgTwin.Metadata.Type
 		}
 
-		err = dtcommon.ValidateValue(valueType, *msgTwin.Expected.Value)
+		err = validateTwinValue(msgTwin.Metadata, valueType, *msgTwin.Expected.Value)
 		if err == nil {
 			meta := dttype.ValueMetadata{Timestamp: now}
 			metaJSON, _ := json.Marshal(meta)
@@ -888,7 +925,7 @@ func dealTwinAdd(returnResult *dttype.DealTwinResult, deviceID string, key strin
 		if msgTwin.Metadata != nil {
 			valueType = msgTwin.Metadata.Type
 		}
-		err = dtcommon.ValidateValue(valueType, *msgTwin.Actual.Value)
+		err = validateTwinValue(msgTwin.Metadata, valueType, *msgTwin.Actual.Value)
 		if err == nil {
 			meta := dttype.ValueMetadata{Timestamp: now}
 			metaJSON, _ := json.Marshal(meta)
@@ -1148,6 +1148,7 @@ func TestDealTwinCompare(t *testing.T) {
 func TestDealTwinAdd(t *testing.T) {
 	optionTrue := true
 	str := typeString
+	off := "off"
 	doc := make(map[string]*dttype.TwinDoc)
 	doc[key1] = &dttype.TwinDoc{}
 	sync := make(map[string]*dttype.MsgTwin)
@@ -1360,6 +1361,29 @@ func TestDealTwinAdd(t *testing.T) {
 			dealType: SyncDealType,
 			err:      nil,
 		},
+		{
+			name: "TestDealTwinAdd(): Case 9: msgTwin.Expected is not in the enum values; dealType=0",
+			returnResult: &dttype.DealTwinResult{
+				Document:   doc,
+				SyncResult: sync,
+				Result:     result,
+			},
+			deviceID: deviceA,
+			key:      key1,
+			twins:    twinDelete,
+			msgTwin: &dttype.MsgTwin{
+				Expected: &dttype.TwinValue{
+					Value: &off,
+				},
+				Optional: &optionTrue,
+				Metadata: &dttype.TypeMetadata{
+					Type:       "enum",
+					EnumValues: []string{"auto", "manual"},
+				},
+			},
+			dealType: RestDealType,
+			err:      errors.New("the value must be one of [auto manual]"),
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -145,6 +145,10 @@ type TwinVersion struct {
 // TypeMetadata the meta of value type
 type TypeMetadata struct {
 	Type string `json:"type,omitempty"`
+	// EnumValues are the allowed values of the enum type
+	EnumValues []string `json:"enumValues,omitempty"`
+	// Schema is the JSON schema fragment of the object or array type
+	Schema string `json:"schema,omitempty"`
 }
 
 // ValueMetadata the meta of value
@@ -275,7 +279,7 @@ func UnmarshalDeviceTwinUpdate(payload []byte) (*DeviceTwinUpdate, error) {
 			if value.Expected != nil {
 				if value.Expected.Value != nil {
 					if *value.Expected.Value != "" {
-						match := dtcommon.ValidateTwinValue(*value.Expected.Value)
+						match := validateTwinValueFormat(value.Metadata, *value.Expected.Value)
 						if !match {
 							return &deviceTwinUpdate, ErrorValue
 						}
@@ -285,7 +289,7 @@ func UnmarshalDeviceTwinUpdate(payload []byte) (*DeviceTwinUpdate, error) {
 			if value.Actual != nil {
 				if value.Actual.Value != nil {
 					if *value.Actual.Value != "" {
-						match := dtcommon.ValidateTwinValue(*value.Actual.Value)
+						match := validateTwinValueFormat(value.Metadata, *value.Actual.Value)
 						if !match {
 							return &deviceTwinUpdate, ErrorValue
 						}
@@ -297,6 +301,15 @@ func UnmarshalDeviceTwinUpdate(payload []byte) (*DeviceTwinUpdate, error) {
 	return &deviceTwinUpdate, nil
 }
 
+// validateTwinValueFormat checks the format of the twin value, the value of
+// object or array type is JSON and is not limited by the twin value pattern
+func validateTwinValueFormat(metadata *TypeMetadata, value string) bool {
+	if metadata != nil && dtcommon.IsStructuredType(metadata.Type) {
+		return len(value) <= dtcommon.MaxStructuredTwinValueLength && json.Valid([]byte(value))
+	}
+	return dtcommon.ValidateTwinValue(value)
+}
+
 // DealTwinResult the result of dealing twin
 type DealTwinResult struct {
 	Add        []dtclient.DeviceTwin
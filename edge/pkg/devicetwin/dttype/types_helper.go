@@ -67,9 +67,16 @@ func DeviceTwinToMsgTwin(deviceTwins []dtclient.DeviceTwin) map[string]*MsgTwin
 		expected := twin.Expected
 		actual := twin.Actual
 
+		typeMeta := &TypeMetadata{}
+		if twin.Metadata != "" {
+			if err := json.Unmarshal([]byte(twin.Metadata), typeMeta); err != nil {
+				klog.Error(err)
+			}
+		}
+		typeMeta.Type = twin.AttrType
 		msgTwin := &MsgTwin{
 			Optional: &optional,
-			Metadata: &TypeMetadata{Type: twin.AttrType}}
+			Metadata: typeMeta}
 		if expected != "" {
 			expectedValue := &TwinValue{Value: &expected}
 			if twin.ExpectedMeta != "" {
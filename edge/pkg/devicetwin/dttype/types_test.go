@@ -666,3 +666,48 @@ func TestUnmarshalDeviceTwinUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTwinValueFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata *TypeMetadata
+		value    string
+		want     bool
+	}{
+		{
+			name:  "string value",
+			value: "value",
+			want:  true,
+		},
+		{
+			name:  "JSON value without metadata",
+			value: `{"x":1}`,
+			want:  false,
+		},
+		{
+			name:     "object value",
+			metadata: &TypeMetadata{Type: "object"},
+			value:    `{"x": 1.5, "y": "a b"}`,
+			want:     true,
+		},
+		{
+			name:     "array value",
+			metadata: &TypeMetadata{Type: "array"},
+			value:    `[1, 2, 3]`,
+			want:     true,
+		},
+		{
+			name:     "invalid JSON value",
+			metadata: &TypeMetadata{Type: "array"},
+			value:    `[1, 2`,
+			want:     false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := validateTwinValueFormat(test.metadata, test.value); got != test.want {
+				t.Errorf("validateTwinValueFormat() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
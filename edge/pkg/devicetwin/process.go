@@ -90,6 +90,7 @@ func initEventActionMap() {
 	EventActionMap[dtcommon.DeviceETPrefix][dtcommon.DeviceETStateGetSuffix] = dtcommon.DeviceStateGet
 	EventActionMap[dtcommon.DeviceETPrefix][dtcommon.DeviceETUpdatedSuffix] = dtcommon.DeviceUpdated
 	EventActionMap[dtcommon.DeviceETPrefix][dtcommon.DeviceETStateUpdateSuffix] = dtcommon.DeviceStateUpdate
+	EventActionMap[dtcommon.DeviceETPrefix][dtcommon.DeviceETAlarmUpdateSuffix] = dtcommon.DeviceAlarmUpdate
 	EventActionMap[dtcommon.DeviceETPrefix][dtcommon.TwinETUpdateSuffix] = dtcommon.TwinUpdate
 	EventActionMap[dtcommon.DeviceETPrefix][dtcommon.TwinETCloudSyncSuffix] = dtcommon.TwinCloudSync
 	EventActionMap[dtcommon.DeviceETPrefix][dtcommon.TwinETGetSuffix] = dtcommon.TwinGet
@@ -107,6 +108,7 @@ func initActionModuleMap() {
 	ActionModuleMap[dtcommon.DeviceUpdated] = dtcommon.DeviceModule
 	ActionModuleMap[dtcommon.DeviceStateGet] = dtcommon.DeviceModule
 	ActionModuleMap[dtcommon.DeviceStateUpdate] = dtcommon.DeviceModule
+	ActionModuleMap[dtcommon.DeviceAlarmUpdate] = dtcommon.DeviceModule
 	ActionModuleMap[dtcommon.Connected] = dtcommon.CommModule
 	ActionModuleMap[dtcommon.Disconnected] = dtcommon.CommModule
 	ActionModuleMap[dtcommon.LifeCycle] = dtcommon.CommModule
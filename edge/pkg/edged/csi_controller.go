@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edged
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	registerapi "k8s.io/kubelet/pkg/apis/pluginregistration/v1"
+	"k8s.io/kubernetes/pkg/kubelet/config"
+
+	"github.com/kubeedge/api/apis/common/constants"
+)
+
+const csiTimeout = 2 * time.Minute
+
+// csiController calls the controller service of the CSI driver serving the volumes provisioned
+// by the CSI driver of the cloud. It's the equivalent of the csi controller of the kubelet, which
+// only supports creating, deleting, publishing and unpublishing volumes of the csi-hostpath driver.
+type csiController struct {
+	driverName      string
+	registrationDir string
+}
+
+func newCSIController(driverName, rootDirectory string) *csiController {
+	if driverName == "" {
+		driverName = constants.DefaultCSIDriverName
+	}
+	return &csiController{
+		driverName:      driverName,
+		registrationDir: filepath.Join(rootDirectory, config.DefaultKubeletPluginsRegistrationDirName),
+	}
+}
+
+func (c *csiController) CreateVolume(req *csi.CreateVolumeRequest) (*csi.CreateVolumeResponse, error) {
+	res := &csi.CreateVolumeResponse{}
+	err := c.call(csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME, false, func(ctx context.Context, client csi.ControllerClient) (err error) {
+		res, err = client.CreateVolume(ctx, req)
+		return err
+	})
+	return res, err
+}
+
+func (c *csiController) DeleteVolume(req *csi.DeleteVolumeRequest) (*csi.DeleteVolumeResponse, error) {
+	res := &csi.DeleteVolumeResponse{}
+	err := c.call(csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME, false, func(ctx context.Context, client csi.ControllerClient) (err error) {
+		res, err = client.DeleteVolume(ctx, req)
+		return err
+	})
+	return res, err
+}
+
+func (c *csiController) ControllerPublishVolume(req *csi.ControllerPublishVolumeRequest) (*csi.ControllerPublishVolumeResponse, error) {
+	res := &csi.ControllerPublishVolumeResponse{}
+	err := c.call(csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME, false, func(ctx context.Context, client csi.ControllerClient) (err error) {
+		res, err = client.ControllerPublishVolume(ctx, req)
+		return err
+	})
+	return res, err
+}
+
+func (c *csiController) ControllerUnpublishVolume(req *csi.ControllerUnpublishVolumeRequest) (*csi.ControllerUnpublishVolumeResponse, error) {
+	res := &csi.ControllerUnpublishVolumeResponse{}
+	err := c.call(csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME, false, func(ctx context.Context, client csi.ControllerClient) (err error) {
+		res, err = client.ControllerUnpublishVolume(ctx, req)
+		return err
+	})
+	return res, err
+}
+
+func (c *csiController) ControllerExpandVolume(req *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
+	var res *csi.ControllerExpandVolumeResponse
+	err := c.call(csi.ControllerServiceCapability_RPC_EXPAND_VOLUME, true, func(ctx context.Context, client csi.ControllerClient) (err error) {
+		res, err = client.ControllerExpandVolume(ctx, req)
+		return err
+	})
+	return res, err
+}
+
+func (c *csiController) GetCapacity(req *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
+	var res *csi.GetCapacityResponse
+	err := c.call(csi.ControllerServiceCapability_RPC_GET_CAPACITY, true, func(ctx context.Context, client csi.ControllerClient) (err error) {
+		res, err = client.GetCapacity(ctx, req)
+		return err
+	})
+	return res, err
+}
+
+func (c *csiController) ListVolumes(req *csi.ListVolumesRequest) (*csi.ListVolumesResponse, error) {
+	var res *csi.ListVolumesResponse
+	err := c.call(csi.ControllerServiceCapability_RPC_LIST_VOLUMES, true, func(ctx context.Context, client csi.ControllerClient) (err error) {
+		res, err = client.ListVolumes(ctx, req)
+		return err
+	})
+	return res, err
+}
+
+// call connects to the controller service of the driver and calls fn if the driver has the capability.
+// If the driver doesn't have it, an Unimplemented error is returned when the capability is required,
+// otherwise the call is skipped as the csi controller of the kubelet does.
+func (c *csiController) call(capability csi.ControllerServiceCapability_RPC_Type, required bool,
+	fn func(context.Context, csi.ControllerClient) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), csiTimeout)
+	defer cancel()
+
+	endpoint, err := c.driverEndpoint(ctx)
+	if err != nil {
+		return err
+	}
+	conn, err := dialCSISocket(endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to connect to CSI driver %s: %v", c.driverName, err)
+	}
+	defer conn.Close()
+	client := csi.NewControllerClient(conn)
+
+	res, err := client.ControllerGetCapabilities(ctx, &csi.ControllerGetCapabilitiesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to get controller capabilities of CSI driver %s: %v", c.driverName, err)
+	}
+	for _, cap := range res.GetCapabilities() {
+		if cap.GetRpc().GetType() == capability {
+			return fn(ctx, client)
+		}
+	}
+	if !required {
+		return nil
+	}
+	return status.Errorf(codes.Unimplemented, "CSI driver %s doesn't support %s", c.driverName, capability)
+}
+
+// driverEndpoint gets the endpoint the driver registers to the kubelet with from its registration socket
+func (c *csiController) driverEndpoint(ctx context.Context) (string, error) {
+	socket := filepath.Join(c.registrationDir, c.driverName+"-reg.sock")
+	conn, err := dialCSISocket(socket)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to registration socket %s of CSI driver %s: %v", socket, c.driverName, err)
+	}
+	defer conn.Close()
+	info, err := registerapi.NewRegistrationClient(conn).GetInfo(ctx, &registerapi.InfoRequest{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get registration info of CSI driver %s: %v", c.driverName, err)
+	}
+	if info.GetType() != registerapi.CSIPlugin || info.GetName() != c.driverName {
+		return "", fmt.Errorf("registration socket %s belongs to %s plugin %s", socket, info.GetType(), info.GetName())
+	}
+	return info.GetEndpoint(), nil
+}
+
+func dialCSISocket(socket string) (*grpc.ClientConn, error) {
+	return grpc.NewClient("unix:"+strings.TrimPrefix(socket, "unix://"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
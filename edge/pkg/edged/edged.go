@@ -31,6 +31,7 @@ import (
 	"net/http"
 	"os"
 	"reflect"
+	"sync/atomic"
 	"time"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
@@ -47,7 +48,6 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	"k8s.io/kubernetes/pkg/kubelet/nodestatus"
 	kubelettypes "k8s.io/kubernetes/pkg/kubelet/types"
-	csiplugin "k8s.io/kubernetes/pkg/volume/csi"
 
 	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
 	"github.com/kubeedge/beehive/pkg/core"
@@ -61,6 +61,7 @@ import (
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager"
 	metaclient "github.com/kubeedge/kubeedge/edge/pkg/metamanager/client"
 	kefeatures "github.com/kubeedge/kubeedge/pkg/features"
+	"github.com/kubeedge/kubeedge/pkg/tracing"
 	"github.com/kubeedge/kubeedge/pkg/version"
 )
 
@@ -91,9 +92,15 @@ type edged struct {
 	context       context.Context
 	nodeName      string
 	namespace     string
+	// whether the kubelet is ready and the pods are being synced
+	ready atomic.Bool
 }
 
-var _ core.Module = (*edged)(nil)
+var (
+	_ core.Module          = (*edged)(nil)
+	_ core.DependentModule = (*edged)(nil)
+	_ core.ReadinessProbe  = (*edged)(nil)
+)
 
 // Register register edged
 func Register(e *v1alpha2.Edged) {
@@ -119,6 +126,20 @@ func (e *edged) Enable() bool {
 	return edgedconfig.Config.Enable
 }
 
+// Dependencies returns the modules edged depends on, the kubelet gets the pods
+// and the other resources it needs from the metamanager
+func (e *edged) Dependencies() []string {
+	return []string{modules.MetaManagerModuleName}
+}
+
+// Ready returns nil once the kubelet is ready
+func (e *edged) Ready() error {
+	if !e.ready.Load() {
+		return fmt.Errorf("kubelet of %s is not ready", e.Name())
+	}
+	return nil
+}
+
 func (e *edged) Start() {
 	klog.Info("Starting edged...")
 	kubeletErrChan := make(chan error, 1)
@@ -156,6 +177,8 @@ func (e *edged) Start() {
 	case <-kubeletReadyChan:
 		klog.Info("Start sync pod")
 	}
+	e.ready.Store(true)
+	defer e.ready.Store(false)
 
 	e.syncPod(e.KubeletDeps.PodConfig)
 }
@@ -285,56 +308,62 @@ func (e *edged) syncPod(podCfg *config.PodConfig) {
 			klog.Errorf("failed to get pod: %v", err)
 			continue
 		}
+		e.handleMessage(result, podCfg, rawUpdateChan)
+	}
+}
 
-		_, resType, resID, err := commonmsg.ParseResourceEdge(result.GetResource(), result.GetOperation())
-		if err != nil {
-			klog.Errorf("failed to parse the Resource: %v", err)
-			continue
-		}
-		op := result.GetOperation()
+// handleMessage handles the pod or volume message received by edged
+func (e *edged) handleMessage(result model.Message, podCfg *config.PodConfig, rawUpdateChan chan<- interface{}) {
+	span := tracing.StartSpan(&result, e.Name())
+	defer span.End()
 
-		content, err := result.GetContentData()
-		if err != nil {
-			klog.Errorf("get message content data failed: %v", err)
-			continue
-		}
+	_, resType, resID, err := commonmsg.ParseResourceEdge(result.GetResource(), result.GetOperation())
+	if err != nil {
+		klog.Errorf("failed to parse the Resource: %v", err)
+		return
+	}
+	op := result.GetOperation()
+
+	content, err := result.GetContentData()
+	if err != nil {
+		klog.Errorf("get message content data failed: %v", err)
+		return
+	}
 
-		switch resType {
-		case model.ResourceTypePod:
-			if op == model.ResponseOperation && resID == "" && result.GetSource() == modules.MetaManagerModuleName {
-				err := e.handlePodListFromMetaManager(content, rawUpdateChan)
-				if err != nil {
-					klog.Errorf("handle podList failed: %v", err)
-					continue
-				}
-				podCfg.SetInitPodReady(true)
-			} else if op == model.ResponseOperation && resID == "" && result.GetSource() == metamanager.CloudControllerModel {
-				err := e.handlePodListFromEdgeController(content, rawUpdateChan)
-				if err != nil {
-					klog.Errorf("handle podList failed: %v", err)
-					continue
-				}
-				podCfg.SetInitPodReady(true)
-			} else {
-				err = e.handlePod(op, content, rawUpdateChan)
-				if err != nil {
-					klog.Errorf("handle pod failed: %v", err)
-					continue
-				}
+	switch resType {
+	case model.ResourceTypePod:
+		if op == model.ResponseOperation && resID == "" && result.GetSource() == modules.MetaManagerModuleName {
+			err := e.handlePodListFromMetaManager(content, rawUpdateChan)
+			if err != nil {
+				klog.Errorf("handle podList failed: %v", err)
+				return
 			}
-		case constants.CSIResourceTypeVolume:
-			klog.Infof("volume operation type: %s", op)
-			res, err := e.handleVolume(op, content)
+			podCfg.SetInitPodReady(true)
+		} else if op == model.ResponseOperation && resID == "" && result.GetSource() == metamanager.CloudControllerModel {
+			err := e.handlePodListFromEdgeController(content, rawUpdateChan)
 			if err != nil {
-				klog.Errorf("handle volume failed: %v", err)
-			} else {
-				resp := result.NewRespByMessage(&result, res)
-				beehiveContext.SendResp(*resp)
+				klog.Errorf("handle podList failed: %v", err)
+				return
 			}
-		default:
-			klog.Errorf("resType is not pod or configmap or secret or volume: resType is %s", resType)
-			continue
+			podCfg.SetInitPodReady(true)
+		} else {
+			err = e.handlePod(op, content, rawUpdateChan)
+			if err != nil {
+				klog.Errorf("handle pod failed: %v", err)
+				return
+			}
+		}
+	case constants.CSIResourceTypeVolume:
+		klog.Infof("volume operation type: %s", op)
+		res, err := e.handleVolume(op, content)
+		if err != nil {
+			klog.Errorf("handle volume failed: %v", err)
+		} else {
+			resp := result.NewRespByMessage(&result, res)
+			beehiveContext.SendResp(*resp)
 		}
+	default:
+		klog.Errorf("resType is not pod or configmap or secret or volume: resType is %s", resType)
 	}
 }
 
@@ -445,6 +474,12 @@ func (e *edged) handleVolume(op string, content []byte) (interface{}, error) {
 		return e.controllerPublishVolume(content)
 	case constants.CSIOperationTypeControllerUnpublishVolume:
 		return e.controllerUnpublishVolume(content)
+	case constants.CSIOperationTypeControllerExpandVolume:
+		return e.controllerExpandVolume(content)
+	case constants.CSIOperationTypeGetCapacity:
+		return e.getCapacity(content)
+	case constants.CSIOperationTypeListVolumes:
+		return e.listVolumes(content)
 	}
 	return nil, nil
 }
@@ -458,7 +493,7 @@ func (e *edged) createVolume(content []byte) (interface{}, error) {
 	}
 
 	klog.V(4).Infof("start create volume: %s", req.Name)
-	ctl := csiplugin.NewController()
+	ctl := newCSIController(edgedconfig.Config.CSIDriverName, edgedconfig.Config.RootDirectory)
 	res, err := ctl.CreateVolume(req)
 	if err != nil {
 		klog.Errorf("create volume error: %v", err)
@@ -476,7 +511,7 @@ func (e *edged) deleteVolume(content []byte) (interface{}, error) {
 		return nil, err
 	}
 	klog.V(4).Infof("start delete volume: %s", req.VolumeId)
-	ctl := csiplugin.NewController()
+	ctl := newCSIController(edgedconfig.Config.CSIDriverName, edgedconfig.Config.RootDirectory)
 	res, err := ctl.DeleteVolume(req)
 	if err != nil {
 		klog.Errorf("delete volume error: %v", err)
@@ -494,7 +529,7 @@ func (e *edged) controllerPublishVolume(content []byte) (interface{}, error) {
 		return nil, err
 	}
 	klog.V(4).Infof("start controller publish volume: %s", req.VolumeId)
-	ctl := csiplugin.NewController()
+	ctl := newCSIController(edgedconfig.Config.CSIDriverName, edgedconfig.Config.RootDirectory)
 	res, err := ctl.ControllerPublishVolume(req)
 	if err != nil {
 		klog.Errorf("controller publish volume error: %v", err)
@@ -512,7 +547,7 @@ func (e *edged) controllerUnpublishVolume(content []byte) (interface{}, error) {
 		return nil, err
 	}
 	klog.V(4).Infof("start controller unpublish volume: %s", req.VolumeId)
-	ctl := csiplugin.NewController()
+	ctl := newCSIController(edgedconfig.Config.CSIDriverName, edgedconfig.Config.RootDirectory)
 	res, err := ctl.ControllerUnpublishVolume(req)
 	if err != nil {
 		klog.Errorf("controller unpublish volume error: %v", err)
@@ -522,6 +557,60 @@ func (e *edged) controllerUnpublishVolume(content []byte) (interface{}, error) {
 	return res, nil
 }
 
+func (e *edged) controllerExpandVolume(content []byte) (interface{}, error) {
+	req := &csi.ControllerExpandVolumeRequest{}
+	err := jsonpb.Unmarshal(bytes.NewReader(content), req)
+	if err != nil {
+		klog.Errorf("unmarshal controller expand volume req error: %v", err)
+		return nil, err
+	}
+	klog.V(4).Infof("start controller expand volume: %s", req.VolumeId)
+	ctl := newCSIController(edgedconfig.Config.CSIDriverName, edgedconfig.Config.RootDirectory)
+	res, err := ctl.ControllerExpandVolume(req)
+	if err != nil {
+		klog.Errorf("controller expand volume error: %v", err)
+		return nil, err
+	}
+	klog.V(4).Infof("end controller expand volume: %s result: %v", req.VolumeId, res)
+	return res, nil
+}
+
+func (e *edged) getCapacity(content []byte) (interface{}, error) {
+	req := &csi.GetCapacityRequest{}
+	err := jsonpb.Unmarshal(bytes.NewReader(content), req)
+	if err != nil {
+		klog.Errorf("unmarshal get capacity req error: %v", err)
+		return nil, err
+	}
+	klog.V(4).Infof("start get capacity: %v", req.Parameters)
+	ctl := newCSIController(edgedconfig.Config.CSIDriverName, edgedconfig.Config.RootDirectory)
+	res, err := ctl.GetCapacity(req)
+	if err != nil {
+		klog.Errorf("get capacity error: %v", err)
+		return nil, err
+	}
+	klog.V(4).Infof("end get capacity: %v result: %v", req.Parameters, res)
+	return res, nil
+}
+
+func (e *edged) listVolumes(content []byte) (interface{}, error) {
+	req := &csi.ListVolumesRequest{}
+	err := jsonpb.Unmarshal(bytes.NewReader(content), req)
+	if err != nil {
+		klog.Errorf("unmarshal list volumes req error: %v", err)
+		return nil, err
+	}
+	klog.V(4).Infof("start list volumes from token: %s", req.StartingToken)
+	ctl := newCSIController(edgedconfig.Config.CSIDriverName, edgedconfig.Config.RootDirectory)
+	res, err := ctl.ListVolumes(req)
+	if err != nil {
+		klog.Errorf("list volumes error: %v", err)
+		return nil, err
+	}
+	klog.V(4).Infof("end list volumes from token: %s result: %v", req.StartingToken, res)
+	return res, nil
+}
+
 func filterPodByNodeName(pod *v1.Pod, nodeName string) bool {
 	return pod.Spec.NodeName == nodeName
 }
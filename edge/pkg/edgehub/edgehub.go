@@ -11,6 +11,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core"
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/monitor"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/certificate"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/config"
@@ -119,6 +120,7 @@ func (eh *EdgeHub) Start() {
 		// stop authinfo manager/websocket connection
 		<-eh.reconnectChan
 		eh.chClient.UnInit()
+		monitor.EdgeHubReconnects.Inc()
 
 		// execute hook fun after disconnect
 		eh.pubConnectInfo(false)
@@ -12,11 +12,13 @@ import (
 	connect "github.com/kubeedge/kubeedge/edge/pkg/common/cloudconnection"
 	messagepkg "github.com/kubeedge/kubeedge/edge/pkg/common/message"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/monitor"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/common/msghandler"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/config"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/task"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/taskv1alpha2"
+	"github.com/kubeedge/kubeedge/pkg/tracing"
 )
 
 var groupMap = map[string]string{
@@ -101,6 +103,8 @@ func (*defaultHandler) Process(message *model.Message, _ clients.Adapter) error
 }
 
 func (eh *EdgeHub) dispatch(message model.Message) error {
+	span := tracing.StartSpan(&message, modules.EdgeHubModuleName)
+	defer span.End()
 	return msghandler.ProcessHandler(message, eh.chClient)
 }
 
@@ -118,6 +122,7 @@ func (eh *EdgeHub) routeToEdge() {
 			eh.reconnectChan <- struct{}{}
 			return
 		}
+		monitor.EdgeHubMessages.WithLabelValues(monitor.DirectionReceive).Inc()
 		klog.V(4).Infof("[edgehub/routeToEdge] receive msg from cloud, msg: %+v", message)
 		if err = eh.dispatch(message); err != nil {
 			klog.Error(err)
@@ -133,6 +138,7 @@ func (eh *EdgeHub) sendToCloud(message model.Message) error {
 	if err != nil {
 		return fmt.Errorf("failed to send message, error: %v", err)
 	}
+	monitor.EdgeHubMessages.WithLabelValues(monitor.DirectionSend).Inc()
 
 	return nil
 }
@@ -195,6 +201,11 @@ func (eh *EdgeHub) keepalive() {
 func (eh *EdgeHub) pubConnectInfo(isConnected bool) {
 	// update connected info
 	connect.SetConnected(isConnected)
+	if isConnected {
+		monitor.EdgeHubConnected.Set(1)
+	} else {
+		monitor.EdgeHubConnected.Set(0)
+	}
 
 	// var info model.Message
 	content := connect.CloudConnected
@@ -219,6 +230,11 @@ func (eh *EdgeHub) ifRotationDone() {
 }
 
 func (eh *EdgeHub) tryThrottle(msgID string) error {
+	if eh.rateLimiter.TryAccept() {
+		return nil
+	}
+	monitor.EdgeHubThrottledMessages.Inc()
+
 	now := time.Now()
 
 	err := eh.rateLimiter.Wait(context.TODO())
@@ -13,6 +13,7 @@ import (
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
 	messagepkg "github.com/kubeedge/kubeedge/edge/pkg/common/message"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/monitor"
 	"github.com/kubeedge/kubeedge/edge/pkg/eventbus/common/util"
 	eventconfig "github.com/kubeedge/kubeedge/edge/pkg/eventbus/config"
 	"github.com/kubeedge/kubeedge/edge/pkg/eventbus/dao"
@@ -171,6 +172,7 @@ func (eb *eventbus) pubCloudMsgToEdge() {
 }
 
 func (eb *eventbus) publish(topic string, payload []byte) {
+	monitor.EventBusMessages.WithLabelValues(monitor.OperationPublish).Inc()
 	if eventconfig.Config.MqttMode >= v1alpha2.MqttModeBoth {
 		// pub msg to external mqtt broker.
 		pubMQTT(topic, payload)
@@ -183,6 +185,7 @@ func (eb *eventbus) publish(topic string, payload []byte) {
 }
 
 func (eb *eventbus) subscribe(topic string) {
+	monitor.EventBusMessages.WithLabelValues(monitor.OperationSubscribe).Inc()
 	if eventconfig.Config.MqttMode <= v1alpha2.MqttModeBoth {
 		// set topic to internal mqtt broker.
 		mqttServer.SetTopic(topic)
@@ -204,6 +207,7 @@ func (eb *eventbus) subscribe(topic string) {
 }
 
 func (eb *eventbus) unsubscribe(topic string) {
+	monitor.EventBusMessages.WithLabelValues(monitor.OperationUnsubscribe).Inc()
 	if eventconfig.Config.MqttMode <= v1alpha2.MqttModeBoth {
 		mqttServer.RemoveTopic(topic)
 	}
@@ -1,6 +1,11 @@
 package metamanager
 
 import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"github.com/beego/beego/v2/client/orm"
 	"k8s.io/klog/v2"
 
@@ -16,11 +21,37 @@ import (
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/kubernetes/storage/sqlite/imitator"
 )
 
+// processTimeout is how long a message can be processed before the metamanager is considered wedged
+const processTimeout = time.Minute
+
 type metaManager struct {
 	enable bool
+
+	ready atomic.Bool
+	// loop is the main loop started last, nil before the metamanager starts
+	loop atomic.Pointer[mainLoop]
+
+	stopLock sync.Mutex
+	stopCh   chan struct{}
 }
 
-var _ core.Module = (*metaManager)(nil)
+// mainLoop is a main loop of the metamanager, a new loop is started every time the metamanager starts
+type mainLoop struct {
+	// the time in UnixNano when the message being processed was received, 0 if no message is processed
+	processing atomic.Int64
+	// done is closed when the loop exits
+	done chan struct{}
+}
+
+var (
+	_ core.Module          = (*metaManager)(nil)
+	_ core.ReadinessProbe  = (*metaManager)(nil)
+	_ core.LivenessProbe   = (*metaManager)(nil)
+	_ core.StoppableModule = (*metaManager)(nil)
+)
+
+// the metaserver keeps running when the metamanager is restarted
+var startMetaServerOnce sync.Once
 
 func newMetaManager(enable bool) *metaManager {
 	return &metaManager{
@@ -60,10 +91,73 @@ func (m *metaManager) Enable() bool {
 }
 
 func (m *metaManager) Start() {
-	if metaserverconfig.Config.Enable {
-		imitator.StorageInit()
-		go metaserver.NewMetaServer().Start(beehiveContext.Done())
+	startMetaServerOnce.Do(func() {
+		if metaserverconfig.Config.Enable {
+			imitator.StorageInit()
+			go metaserver.NewMetaServer().Start(beehiveContext.Done())
+		}
+	})
+
+	stopCh := make(chan struct{})
+	m.stopLock.Lock()
+	m.stopCh = stopCh
+	m.stopLock.Unlock()
+
+	loop := &mainLoop{done: make(chan struct{})}
+	m.loop.Store(loop)
+	go m.runMetaManager(loop, stopCh)
+	m.ready.Store(true)
+
+	select {
+	case <-beehiveContext.Done():
+		m.ready.Store(false)
+		return
+	case <-stopCh:
+		klog.Warning("MetaManager is stopped")
+	}
+	m.ready.Store(false)
+
+	// the main loop may be wedged when it's stopped, it exits after the message in process, and
+	// a new loop isn't started before that, so the messages are still processed in order
+	select {
+	case <-beehiveContext.Done():
+	case <-loop.done:
 	}
+}
 
-	m.runMetaManager()
+// Ready returns nil once the metamanager is started
+func (m *metaManager) Ready() error {
+	if !m.ready.Load() {
+		return fmt.Errorf("%s is not running", m.Name())
+	}
+	return nil
+}
+
+// Healthy returns an error if a message is processed for too long,
+// for example, because of the database on a saturated disk
+func (m *metaManager) Healthy() error {
+	loop := m.loop.Load()
+	if loop == nil {
+		return nil
+	}
+	received := loop.processing.Load()
+	if received == 0 {
+		return nil
+	}
+	if elapsed := time.Since(time.Unix(0, received)); elapsed > processTimeout {
+		return fmt.Errorf("%s is processing a message for %s", m.Name(), elapsed.Round(time.Second))
+	}
+	return nil
+}
+
+// Stop makes Start return once the main loop exits, so the metamanager can be restarted
+func (m *metaManager) Stop() {
+	m.stopLock.Lock()
+	defer m.stopLock.Unlock()
+
+	if m.stopCh == nil {
+		return
+	}
+	close(m.stopCh)
+	m.stopCh = nil
 }
@@ -17,11 +17,16 @@ limitations under the License.
 package metamanager
 
 import (
+	"context"
 	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/kubeedge/beehive/pkg/common"
 	"github.com/kubeedge/beehive/pkg/core"
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/beehive/pkg/core/model"
 	commodule "github.com/kubeedge/kubeedge/edge/pkg/common/modules"
 )
 
@@ -60,3 +65,75 @@ func TestNameAndGroup(t *testing.T) {
 		}
 	})
 }
+
+func TestProbes(t *testing.T) {
+	meta := newMetaManager(true)
+	if err := meta.Ready(); err == nil {
+		t.Errorf("metamanager is ready before it starts")
+	}
+	if err := meta.Healthy(); err != nil {
+		t.Errorf("metamanager not started is unhealthy: %v", err)
+	}
+
+	loop := &mainLoop{done: make(chan struct{})}
+	meta.loop.Store(loop)
+	if err := meta.Healthy(); err != nil {
+		t.Errorf("idle metamanager is unhealthy: %v", err)
+	}
+	loop.processing.Store(time.Now().UnixNano())
+	if err := meta.Healthy(); err != nil {
+		t.Errorf("metamanager processing a message is unhealthy: %v", err)
+	}
+	loop.processing.Store(time.Now().Add(-2 * processTimeout).UnixNano())
+	if err := meta.Healthy(); err == nil {
+		t.Errorf("metamanager processing a message for %s is healthy", 2*processTimeout)
+	}
+
+	stopCh := make(chan struct{})
+	meta.stopCh = stopCh
+	meta.Stop()
+	meta.Stop()
+	select {
+	case <-stopCh:
+	default:
+		t.Errorf("metamanager is not stopped")
+	}
+}
+
+func TestRestart(t *testing.T) {
+	meta := newMetaManager(true)
+	stopped := make(chan struct{})
+	go func() {
+		meta.Start()
+		close(stopped)
+	}()
+	if err := wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 5*time.Second, true,
+		func(context.Context) (bool, error) { return meta.Ready() == nil, nil }); err != nil {
+		t.Fatalf("metamanager is not ready: %v", err)
+	}
+	loop := meta.loop.Load()
+
+	// the main loop waiting for a message doesn't exit, so Start doesn't return to be restarted
+	meta.Stop()
+	select {
+	case <-stopped:
+		t.Fatalf("metamanager is stopped before its main loop exits")
+	case <-time.After(100 * time.Millisecond):
+	}
+	if err := meta.Ready(); err == nil {
+		t.Errorf("stopped metamanager is ready")
+	}
+
+	// the main loop exits after the message is processed
+	beehiveContext.Send(commodule.MetaManagerModuleName, *model.NewMessage("").SetResourceOperation("test", "unsupported"))
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("metamanager is not stopped after its main loop exits")
+	}
+	select {
+	case <-loop.done:
+	default:
+		t.Errorf("main loop is not done")
+	}
+}
@@ -16,10 +16,12 @@ import (
 	"github.com/kubeedge/kubeedge/common/constants"
 	connect "github.com/kubeedge/kubeedge/edge/pkg/common/cloudconnection"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/monitor"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/client"
 	metaManagerConfig "github.com/kubeedge/kubeedge/edge/pkg/metamanager/config"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/kubernetes/storage/sqlite/imitator"
+	"github.com/kubeedge/kubeedge/pkg/tracing"
 )
 
 // Constants to check metamanager processes
@@ -431,6 +433,9 @@ func (m *metaManager) processVolume(message model.Message) {
 
 func (m *metaManager) process(message model.Message) {
 	operation := message.GetOperation()
+	start := time.Now()
+	span := tracing.StartSpan(&message, modules.MetaManagerModuleName)
+	defer span.End()
 
 	switch operation {
 	case model.InsertOperation:
@@ -448,19 +453,29 @@ func (m *metaManager) process(message model.Message) {
 	case constants.CSIOperationTypeCreateVolume,
 		constants.CSIOperationTypeDeleteVolume,
 		constants.CSIOperationTypeControllerPublishVolume,
-		constants.CSIOperationTypeControllerUnpublishVolume:
+		constants.CSIOperationTypeControllerUnpublishVolume,
+		constants.CSIOperationTypeControllerExpandVolume,
+		constants.CSIOperationTypeGetCapacity,
+		constants.CSIOperationTypeListVolumes:
 		m.processVolume(message)
 	default:
 		klog.Errorf("metamanager not supported operation: %v", operation)
+		return
 	}
+	monitor.MetaManagerOperations.WithLabelValues(operation).Inc()
+	monitor.MetaManagerOperationDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
 }
 
-func (m *metaManager) runMetaManager() {
+func (m *metaManager) runMetaManager(loop *mainLoop, stopCh <-chan struct{}) {
+	defer close(loop.done)
 	for {
 		select {
 		case <-beehiveContext.Done():
 			klog.Warning("MetaManager main loop stop")
 			return
+		case <-stopCh:
+			klog.Warning("MetaManager main loop stop")
+			return
 		default:
 		}
 		msg, err := beehiveContext.Receive(m.Name())
@@ -469,6 +484,8 @@ func (m *metaManager) runMetaManager() {
 			continue
 		}
 		klog.V(2).Infof("get a message %+v", msg)
+		loop.processing.Store(time.Now().UnixNano())
 		m.process(msg)
+		loop.processing.Store(0)
 	}
 }
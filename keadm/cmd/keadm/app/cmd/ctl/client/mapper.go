@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	"github.com/kubeedge/api/client/clientset/versioned/scheme"
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util/metaclient"
+)
+
+type MapperRequest struct {
+	NodeName      string
+	LabelSelector string
+}
+
+// GetMappers lists the mappers running on the node
+func (mapperRequest *MapperRequest) GetMappers(ctx context.Context) (*v1beta1.MapperList, error) {
+	selector, err := labels.Parse(mapperRequest.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %v", mapperRequest.LabelSelector, err)
+	}
+	requirement, err := labels.NewRequirement(v1beta1.MapperNodeNameLabel, selection.Equals, []string{mapperRequest.NodeName})
+	if err != nil {
+		return nil, err
+	}
+	selector = selector.Add(*requirement)
+
+	versionedClient, err := metaclient.VersionedKubeClient()
+	if err != nil {
+		return nil, err
+	}
+	mapperList, err := versionedClient.DevicesV1beta1().Mappers(constants.SystemNamespace).List(ctx, metaV1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mappers: %v", err)
+	}
+	kinds, _, err := scheme.Scheme.ObjectKinds(&v1beta1.Mapper{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range mapperList.Items {
+		mapperList.Items[i].GetObjectKind().SetGroupVersionKind(kinds[0])
+	}
+	return mapperList, nil
+}
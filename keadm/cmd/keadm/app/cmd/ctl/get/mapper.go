@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/klog/v2"
+	"k8s.io/kubectl/pkg/cmd/get"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/kubeedge/api/apis/common/constants"
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/ctl/client"
+	ctlcommon "github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/ctl/common"
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util"
+)
+
+var edgeMapperGetShortDescription = `Get mappers in edge node`
+
+type MapperGetOptions struct {
+	LabelSelector string
+	Output        string
+	// ExtPrintFlags holds the flags for printing resources
+	ctlcommon.ExtPrintFlags
+}
+
+// NewEdgeMapperGet returns KubeEdge get edge mapper command.
+func NewEdgeMapperGet() *cobra.Command {
+	mapperGetOptions := NewMapperGetOpts()
+	cmd := &cobra.Command{
+		Use:   "mapper",
+		Short: edgeMapperGetShortDescription,
+		Long:  edgeMapperGetShortDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdutil.CheckErr(mapperGetOptions.getMappers(args))
+			return nil
+		},
+		Aliases: []string{"mappers"},
+	}
+
+	AddGetMapperFlags(cmd, mapperGetOptions)
+	return cmd
+}
+
+func (o *MapperGetOptions) getMappers(args []string) error {
+	config, err := util.ParseEdgecoreConfig(constants.EdgecoreConfigPath)
+	if err != nil {
+		return fmt.Errorf("get edge config failed with err:%v", err)
+	}
+
+	mapperRequest := &client.MapperRequest{
+		NodeName:      config.Modules.Edged.HostnameOverride,
+		LabelSelector: o.LabelSelector,
+	}
+	mapperList, err := mapperRequest.GetMappers(context.Background())
+	if err != nil {
+		return err
+	}
+	mappers := filterMappers(mapperList.Items, args)
+	if len(mappers) == 0 {
+		if len(args) == 0 {
+			klog.Info("No mappers found.")
+		}
+		return nil
+	}
+
+	if *o.PrintFlags.OutputFormat == "" || *o.PrintFlags.OutputFormat == "wide" {
+		return printMappers(mappers, *o.PrintFlags.OutputFormat == "wide", time.Now(), os.Stdout)
+	}
+	runtimeObjects := make([]runtime.Object, 0, len(mappers))
+	for i := range mappers {
+		runtimeObjects = append(runtimeObjects, &mappers[i])
+	}
+	return o.PrintToJSONYaml(runtimeObjects)
+}
+
+// filterMappers returns the mappers with the names, or all the mappers if no name is specified
+func filterMappers(mappers []v1beta1.Mapper, names []string) []v1beta1.Mapper {
+	if len(names) == 0 {
+		return mappers
+	}
+	filtered := make([]v1beta1.Mapper, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, mapper := range mappers {
+			if mapper.Spec.MapperName == name {
+				filtered = append(filtered, mapper)
+				found = true
+				break
+			}
+		}
+		if !found {
+			klog.Errorf("mapper %q not found", name)
+		}
+	}
+	return filtered
+}
+
+func printMappers(mappers []v1beta1.Mapper, wide bool, now time.Time, out io.Writer) error {
+	w := printers.GetNewTabWriter(out)
+	header := "NAME\tPROTOCOL\tVERSION\tREGISTERED\tHEALTHY\tSTALE\tLAST HEARTBEAT"
+	if wide {
+		header += "\tAPI VERSION\tADDRESS"
+	}
+	fmt.Fprintln(w, header)
+	for _, mapper := range mappers {
+		lastHeartbeat := "<unknown>"
+		if !mapper.Status.LastHeartbeatTime.IsZero() {
+			lastHeartbeat = duration.HumanDuration(now.Sub(mapper.Status.LastHeartbeatTime.Time)) + " ago"
+		}
+		line := fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s", mapper.Spec.MapperName, mapper.Spec.Protocol, mapper.Status.Version,
+			conditionStatus(mapper.Status.Conditions, v1beta1.MapperConditionRegistered),
+			conditionStatus(mapper.Status.Conditions, v1beta1.MapperConditionHealthy),
+			conditionStatus(mapper.Status.Conditions, v1beta1.MapperConditionStale),
+			lastHeartbeat)
+		if wide {
+			line += fmt.Sprintf("\t%s\t%s", mapper.Status.APIVersion, mapper.Status.Address)
+		}
+		fmt.Fprintln(w, line)
+	}
+	return w.Flush()
+}
+
+func conditionStatus(conditions []metav1.Condition, conditionType string) string {
+	condition := meta.FindStatusCondition(conditions, conditionType)
+	if condition == nil {
+		return "Unknown"
+	}
+	return string(condition.Status)
+}
+
+func NewMapperGetOpts() *MapperGetOptions {
+	mapperGetOptions := &MapperGetOptions{}
+	mapperGetOptions.PrintFlags = get.NewGetPrintFlags()
+	mapperGetOptions.PrintFlags.OutputFormat = &mapperGetOptions.Output
+	return mapperGetOptions
+}
+
+func AddGetMapperFlags(cmd *cobra.Command, mapperGetOptions *MapperGetOptions) {
+	cmd.Flags().StringVarP(&mapperGetOptions.LabelSelector, common.FlagNameLabelSelector, "l", mapperGetOptions.LabelSelector,
+		"Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	cmd.Flags().StringVarP(&mapperGetOptions.Output, common.FlagNameOutput, "o", mapperGetOptions.Output,
+		"Indicate the output format. Currently supports formats such as yaml|json|wide")
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/api/apis/devices/v1beta1"
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+)
+
+func newTestMapper(name string, healthy metav1.ConditionStatus, lastHeartbeat time.Time) v1beta1.Mapper {
+	return v1beta1.Mapper{
+		Spec: v1beta1.MapperSpec{NodeName: "test-node", MapperName: name, Protocol: "modbus"},
+		Status: v1beta1.MapperStatus{
+			Version:           "v1.0.0",
+			APIVersion:        "v1beta1",
+			LastHeartbeatTime: metav1.NewTime(lastHeartbeat),
+			Conditions: []metav1.Condition{
+				{Type: v1beta1.MapperConditionRegistered, Status: metav1.ConditionTrue},
+				{Type: v1beta1.MapperConditionHealthy, Status: healthy},
+			},
+		},
+	}
+}
+
+func TestNewEdgeMapperGet(t *testing.T) {
+	assert := assert.New(t)
+	cmd := NewEdgeMapperGet()
+
+	assert.NotNil(cmd)
+	assert.Equal("mapper", cmd.Use)
+	assert.Equal(edgeMapperGetShortDescription, cmd.Short)
+	assert.NotNil(cmd.RunE)
+	assert.NotNil(cmd.Flags().Lookup(common.FlagNameLabelSelector))
+	assert.NotNil(cmd.Flags().Lookup(common.FlagNameOutput))
+}
+
+func TestFilterMappers(t *testing.T) {
+	now := time.Now()
+	mappers := []v1beta1.Mapper{
+		newTestMapper("modbus", metav1.ConditionTrue, now),
+		newTestMapper("opcua", metav1.ConditionTrue, now),
+	}
+
+	assert.Len(t, filterMappers(mappers, nil), 2)
+	filtered := filterMappers(mappers, []string{"opcua", "onvif"})
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "opcua", filtered[0].Spec.MapperName)
+}
+
+func TestPrintMappers(t *testing.T) {
+	now := time.Now()
+	mappers := []v1beta1.Mapper{
+		newTestMapper("modbus", metav1.ConditionTrue, now.Add(-30*time.Second)),
+		newTestMapper("opcua", metav1.ConditionFalse, now.Add(-5*time.Minute)),
+	}
+
+	out := &bytes.Buffer{}
+	assert.NoError(t, printMappers(mappers, false, now, out))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.Equal(t, []string{"NAME", "PROTOCOL", "VERSION", "REGISTERED", "HEALTHY", "STALE", "LAST", "HEARTBEAT"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"modbus", "modbus", "v1.0.0", "True", "True", "Unknown", "30s", "ago"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"opcua", "modbus", "v1.0.0", "True", "False", "Unknown", "5m", "ago"}, strings.Fields(lines[2]))
+
+	out.Reset()
+	assert.NoError(t, printMappers(mappers, true, now, out))
+	assert.Contains(t, out.String(), "API VERSION")
+}
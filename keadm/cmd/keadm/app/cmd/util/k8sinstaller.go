@@ -119,6 +119,9 @@ func installCRDs(ks *K8SInstTool) error {
 		"devices": {
 			"devices_v1beta1_device.yaml",
 			"devices_v1beta1_devicemodel.yaml",
+			"devices_v1beta1_discovereddevice.yaml",
+			"devices_v1beta1_mapper.yaml",
+			"devices_v1beta1_devicegroup.yaml",
 		},
 		"reliablesyncs": {
 			"cluster_objectsync_v1alpha1.yaml",
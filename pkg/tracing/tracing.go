@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing propagates the W3C trace context through the beehive messages,
+// and records an OpenTelemetry span for every module hop of a message.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/component-base/tracing"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+)
+
+const (
+	instrumentationScope = "github.com/kubeedge/kubeedge/pkg/tracing"
+
+	traceParentKey = "traceparent"
+	traceStateKey  = "tracestate"
+)
+
+var propagator = propagation.TraceContext{}
+
+// Init installs the global tracer provider which exports the spans to the OTLP collector
+// of config and returns the function flushing the spans when the process exits.
+// Tracing is disabled if config is nil, but the trace context of the messages is still forwarded.
+func Init(ctx context.Context, serviceName string, config *tracingapi.TracingConfiguration) (func(context.Context) error, error) {
+	if config == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+	tp, err := tracing.NewProvider(ctx, config, nil, []resource.Option{
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracer provider: %v", err)
+	}
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// StartSpan starts the span of the module handling msg as a child of the trace context in msg,
+// a new trace is started if msg has no trace context. The trace context of msg is replaced
+// with the new span, so the messages derived from msg are traced as children of the span.
+// The caller must end the returned span when the message is handled.
+func StartSpan(msg *model.Message, module string) trace.Span {
+	ctx := propagator.Extract(context.Background(), carrier{msg})
+	ctx, span := otel.Tracer(instrumentationScope).Start(ctx, module+" "+msg.GetOperation(),
+		trace.WithAttributes(
+			attribute.String("kubeedge.module", module),
+			attribute.String("kubeedge.message.id", msg.GetID()),
+			attribute.String("kubeedge.message.source", msg.GetSource()),
+			attribute.String("kubeedge.message.group", msg.GetGroup()),
+			attribute.String("kubeedge.message.resource", msg.GetResource()),
+			attribute.String("kubeedge.message.operation", msg.GetOperation()),
+		))
+	propagator.Inject(ctx, carrier{msg})
+	return span
+}
+
+// carrier adapts the message header to the carrier of the trace context propagator
+type carrier struct {
+	msg *model.Message
+}
+
+func (c carrier) Get(key string) string {
+	switch key {
+	case traceParentKey:
+		return c.msg.GetTraceParent()
+	case traceStateKey:
+		return c.msg.GetTraceState()
+	}
+	return ""
+}
+
+func (c carrier) Set(key, value string) {
+	switch key {
+	case traceParentKey:
+		c.msg.Header.TraceParent = value
+	case traceStateKey:
+		c.msg.Header.TraceState = value
+	}
+}
+
+func (c carrier) Keys() []string {
+	return []string{traceParentKey, traceStateKey}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+)
+
+func TestStartSpan(t *testing.T) {
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.AlwaysSample()))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	msg := model.NewMessage("").BuildRouter("edgecontroller", "resource", "default/pod/test", model.InsertOperation)
+	root := StartSpan(msg, "edgecontroller")
+	root.End()
+	require.NotEmpty(t, msg.GetTraceParent())
+	rootContext := root.SpanContext()
+
+	// the message is copied when it's sent to the next module
+	forwarded := *msg
+	hop := StartSpan(&forwarded, "cloudhub")
+	hop.End()
+	hopContext := hop.SpanContext()
+	assert.Equal(t, rootContext.TraceID(), hopContext.TraceID())
+	assert.NotEqual(t, rootContext.SpanID(), hopContext.SpanID())
+	assert.Equal(t, rootContext.SpanID(), hop.(sdktrace.ReadOnlySpan).Parent().SpanID())
+
+	resp := forwarded.NewRespByMessage(&forwarded, "OK")
+	respSpan := StartSpan(resp, "edgecontroller")
+	respSpan.End()
+	assert.Equal(t, rootContext.TraceID(), respSpan.SpanContext().TraceID())
+}
+
+func TestInitDisabled(t *testing.T) {
+	shutdown, err := Init(context.Background(), "edgecore", nil)
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	// the trace context is forwarded even though the spans are not recorded
+	msg := model.NewMessage("").SetTraceContext("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "")
+	span := StartSpan(msg, "metamanager")
+	span.End()
+	assert.False(t, span.IsRecording())
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", msg.GetTraceParent())
+}
@@ -99,7 +99,10 @@ type MessageHeader struct {
 	// the flag will be set in send sync
 	Sync bool `protobuf:"varint,4,opt,name=Sync,proto3" json:"Sync,omitempty"`
 	// message type
-	MessageType          string   `protobuf:"bytes,5,opt,name=MessageType,proto3" json:"MessageType,omitempty"`
+	MessageType string `protobuf:"bytes,5,opt,name=MessageType,proto3" json:"MessageType,omitempty"`
+	// the W3C trace context of the message
+	TraceParent          string   `protobuf:"bytes,6,opt,name=TraceParent,proto3" json:"TraceParent,omitempty"`
+	TraceState           string   `protobuf:"bytes,7,opt,name=TraceState,proto3" json:"TraceState,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -165,6 +168,20 @@ func (m *MessageHeader) GetMessageType() string {
 	return ""
 }
 
+func (m *MessageHeader) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
+	}
+	return ""
+}
+
+func (m *MessageHeader) GetTraceState() string {
+	if m != nil {
+		return m.TraceState
+	}
+	return ""
+}
+
 type Message struct {
 	Header               *MessageHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Router               *MessageRouter `protobuf:"bytes,2,opt,name=router,proto3" json:"router,omitempty"`
@@ -229,22 +246,23 @@ func init() {
 func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
+	// 282 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x95, 0xd0, 0x26, 0xed, 0x95, 0x32, 0x9c, 0x50, 0x65, 0x21, 0x86, 0x2a, 0x13, 0x53,
-	0x06, 0x78, 0x04, 0x22, 0x41, 0x06, 0x04, 0x72, 0xfb, 0x02, 0x26, 0x9c, 0xa0, 0x43, 0x6c, 0xcb,
-	0x76, 0x86, 0xcc, 0x3c, 0x00, 0xaf, 0x8c, 0x72, 0x71, 0x02, 0x48, 0x6c, 0xfe, 0xee, 0x7e, 0xdd,
-	0xff, 0xeb, 0x37, 0x6c, 0x5b, 0xf2, 0x5e, 0xbd, 0x53, 0x69, 0x9d, 0x09, 0x06, 0xf3, 0x88, 0x85,
-	0x87, 0xed, 0xd3, 0xf8, 0x94, 0xa6, 0x0b, 0xe4, 0x70, 0x07, 0xd9, 0xc1, 0x74, 0xae, 0x21, 0x91,
-	0xec, 0x93, 0x9b, 0xb5, 0x8c, 0x84, 0x97, 0xb0, 0x7c, 0x70, 0xa6, 0xb3, 0x22, 0xe5, 0xf1, 0x08,
-	0x78, 0x05, 0xab, 0x67, 0x4b, 0x4e, 0x9d, 0x8c, 0x16, 0x67, 0xbc, 0x98, 0x19, 0x05, 0xe4, 0x92,
-	0xbc, 0xe9, 0x1a, 0x12, 0x0b, 0x5e, 0x4d, 0x58, 0x7c, 0x25, 0xb3, 0xeb, 0x23, 0xa9, 0x37, 0x72,
-	0x78, 0x01, 0x69, 0x5d, 0x45, 0xc7, 0xb4, 0xae, 0x86, 0xbb, 0x2f, 0xca, 0x91, 0x0e, 0x75, 0x15,
-	0x0d, 0x67, 0xc6, 0x6b, 0x58, 0x1f, 0x4f, 0x2d, 0xf9, 0xa0, 0x5a, 0xcb, 0xa6, 0x28, 0x7f, 0x06,
-	0x88, 0xb0, 0x38, 0xf4, 0xba, 0x61, 0xcb, 0x95, 0xe4, 0x37, 0xee, 0x61, 0x13, 0xed, 0x8e, 0xbd,
-	0x25, 0xb1, 0xe4, 0x83, 0xbf, 0x47, 0xc5, 0x67, 0x02, 0x79, 0x64, 0x2c, 0x21, 0xfb, 0xe0, 0x54,
-	0x9c, 0x67, 0x73, 0xbb, 0x2b, 0xa7, 0xee, 0xfe, 0x64, 0x96, 0x51, 0x35, 0xe8, 0x1d, 0x77, 0xc7,
-	0x49, 0xff, 0xd1, 0x8f, 0xcd, 0xca, 0xa8, 0x1a, 0x7a, 0xb9, 0x37, 0x3a, 0x90, 0x0e, 0x9c, 0xfe,
-	0x5c, 0x4e, 0xf8, 0x9a, 0xf1, 0xe7, 0xdc, 0x7d, 0x07, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xe5, 0x28,
-	0xb7, 0xad, 0x01, 0x00, 0x00,
+	0x10, 0x86, 0x95, 0xd0, 0x26, 0xed, 0x95, 0x32, 0x9c, 0x50, 0x65, 0x21, 0x84, 0xaa, 0x4e, 0x4c,
+	0x19, 0xe0, 0x11, 0x88, 0x04, 0x19, 0x10, 0xc8, 0xc9, 0x0b, 0x98, 0x70, 0x82, 0x0e, 0x89, 0x23,
+	0xc7, 0x19, 0x3a, 0xf3, 0x84, 0xbc, 0x11, 0xf2, 0xd9, 0x09, 0x41, 0x62, 0xf3, 0xf7, 0xfb, 0xd7,
+	0xfd, 0xfe, 0xcf, 0xb0, 0x6d, 0xa8, 0xef, 0xd5, 0x07, 0x65, 0x9d, 0xd1, 0x56, 0x63, 0x1a, 0xf0,
+	0xd0, 0xc3, 0xf6, 0xd9, 0x1f, 0xa5, 0x1e, 0x2c, 0x19, 0xdc, 0x41, 0x52, 0xea, 0xc1, 0xd4, 0x24,
+	0xa2, 0x7d, 0x74, 0xbb, 0x96, 0x81, 0xf0, 0x12, 0x96, 0x8f, 0x46, 0x0f, 0x9d, 0x88, 0x59, 0xf6,
+	0x80, 0x57, 0xb0, 0x7a, 0xe9, 0xc8, 0xa8, 0xa3, 0x6e, 0xc5, 0x19, 0x5f, 0x4c, 0x8c, 0x02, 0x52,
+	0x49, 0xbd, 0x1e, 0x6a, 0x12, 0x0b, 0xbe, 0x1a, 0xf1, 0xf0, 0x1d, 0x4d, 0xa9, 0x4f, 0xa4, 0xde,
+	0xc9, 0xe0, 0x05, 0xc4, 0x45, 0x1e, 0x12, 0xe3, 0x22, 0x77, 0x73, 0x5f, 0x95, 0xa1, 0xd6, 0x16,
+	0x79, 0x08, 0x9c, 0x18, 0xaf, 0x61, 0x5d, 0x1d, 0x1b, 0xea, 0xad, 0x6a, 0x3a, 0x0e, 0x45, 0xf9,
+	0x2b, 0x20, 0xc2, 0xa2, 0x3c, 0xb5, 0x35, 0x47, 0xae, 0x24, 0x9f, 0x71, 0x0f, 0x9b, 0x10, 0x57,
+	0x9d, 0x3a, 0x12, 0x4b, 0x1e, 0x38, 0x97, 0x9c, 0xa3, 0x32, 0xaa, 0x26, 0x1f, 0x22, 0x12, 0xef,
+	0x98, 0x49, 0x78, 0x03, 0xc0, 0x58, 0x5a, 0x65, 0x49, 0xa4, 0x6c, 0x98, 0x29, 0x87, 0xaf, 0x08,
+	0xd2, 0x30, 0x11, 0x33, 0x48, 0x3e, 0xb9, 0x17, 0x37, 0xda, 0xdc, 0xed, 0xb2, 0x71, 0xfb, 0x7f,
+	0x5a, 0xcb, 0xe0, 0x72, 0x7e, 0xc3, 0xdb, 0x17, 0xf1, 0xff, 0x7e, 0xff, 0x37, 0x32, 0xb8, 0xdc,
+	0x66, 0x1f, 0x74, 0x6b, 0xdd, 0x4b, 0x5d, 0xff, 0x73, 0x39, 0xe2, 0x5b, 0xc2, 0xdf, 0x7b, 0xff,
+	0x33, 0x00, 0xe8, 0xd5, 0xf8, 0x86, 0xef, 0x01, 0x00, 0x00,
 }
@@ -21,6 +21,7 @@ func NewTran() *MessageTranslator {
 func (t *MessageTranslator) protoToModel(src *message.Message, dst *model.Message) error {
 	dst.BuildHeader(src.Header.ID, src.Header.ParentID, int64(src.Header.Timestamp)).
 		BuildRouter(src.Router.Source, src.Router.Group, src.Router.Resouce, src.Router.Operaion).
+		SetTraceContext(src.Header.TraceParent, src.Header.TraceState).
 		FillBody(src.Content)
 
 	// TODO:
@@ -34,6 +35,8 @@ func (t *MessageTranslator) modelToProto(src *model.Message, dst *message.Messag
 	dst.Header.ParentID = src.GetParentID()
 	dst.Header.Timestamp = int64(src.GetTimestamp())
 	dst.Header.Sync = src.IsSync()
+	dst.Header.TraceParent = src.GetTraceParent()
+	dst.Header.TraceState = src.GetTraceState()
 	dst.Router.Source = src.GetSource()
 	dst.Router.Group = src.GetGroup()
 	dst.Router.Resouce = src.GetResource()
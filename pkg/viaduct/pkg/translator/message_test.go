@@ -0,0 +1,31 @@
+package translator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+)
+
+// TestEncodeDecode is function to test the message surviving Encode() and Decode().
+func TestEncodeDecode(t *testing.T) {
+	msg := model.NewMessage("parent").
+		BuildRouter("edgecontroller", "resource", "default/pod/test", model.InsertOperation).
+		SetTraceContext("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "vendor=value").
+		FillBody([]byte("content"))
+	msg.Header.Sync = true
+
+	tran := NewTran()
+	raw, err := tran.Encode(msg)
+	if err != nil {
+		t.Fatalf("failed to encode message: %v", err)
+	}
+	decoded := model.NewRawMessage()
+	if err := tran.Decode(raw, decoded); err != nil {
+		t.Fatalf("failed to decode message: %v", err)
+	}
+
+	if !reflect.DeepEqual(msg, decoded) {
+		t.Errorf("decoded message %+v is not equal to %+v", decoded, msg)
+	}
+}
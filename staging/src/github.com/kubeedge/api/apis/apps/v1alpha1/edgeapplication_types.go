@@ -29,8 +29,66 @@ type EdgeApplicationSpec struct {
 	WorkloadTemplate ResourceTemplate `json:"workloadTemplate,omitempty"`
 	// WorkloadScope represents which node groups the workload will be deployed in.
 	WorkloadScope WorkloadScope `json:"workloadScope"`
+	// RolloutStrategy represents how changes of WorkloadTemplate are rolled out to the
+	// target node groups. Defaults to updating all the target node groups at once.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+}
+
+// RolloutStrategy describes how to roll out a new revision of the workload template.
+type RolloutStrategy struct {
+	// Type of the rollout. Can be "AllAtOnce" or "Progressive". Default is AllAtOnce.
+	// +kubebuilder:validation:Enum=AllAtOnce;Progressive
+	// +optional
+	Type RolloutStrategyType `json:"type,omitempty"`
+	// Progressive contains the parameters of the rollout when Type is Progressive.
+	// +optional
+	Progressive *ProgressiveRollout `json:"progressive,omitempty"`
+}
+
+// RolloutStrategyType is the type of the rollout strategy.
+type RolloutStrategyType string
+
+const (
+	// AllAtOnceRolloutStrategyType updates the workloads of all the target node groups at the same time.
+	AllAtOnceRolloutStrategyType RolloutStrategyType = "AllAtOnce"
+	// ProgressiveRolloutStrategyType updates the workloads of target node groups one batch after another,
+	// in the order of TargetNodeGroups.
+	ProgressiveRolloutStrategyType RolloutStrategyType = "Progressive"
+)
+
+// ProgressiveRollout contains the parameters of a progressive rollout.
+type ProgressiveRollout struct {
+	// MaxUnavailableGroups is the maximum number of node groups that can be updating
+	// and not yet available at the same time. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxUnavailableGroups *int32 `json:"maxUnavailableGroups,omitempty"`
+	// ProgressDeadlineSeconds is the maximum time in seconds for the workloads of an updated
+	// node group to become available, after which the node group is considered failed.
+	// Defaults to 600s.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+	// FailurePolicy represents what to do when a node group fails to become available.
+	// "Pause" stops updating further node groups until the failed node group becomes available
+	// or the workload template is changed. "Rollback" renders all the node groups with the last
+	// stable revision of the workload template. Defaults to Pause.
+	// +kubebuilder:validation:Enum=Pause;Rollback
+	// +optional
+	FailurePolicy RolloutFailurePolicy `json:"failurePolicy,omitempty"`
 }
 
+// RolloutFailurePolicy represents the action to take when a node group fails during the rollout.
+type RolloutFailurePolicy string
+
+const (
+	// RolloutFailurePolicyPause pauses the rollout when a node group fails.
+	RolloutFailurePolicyPause RolloutFailurePolicy = "Pause"
+	// RolloutFailurePolicyRollback rolls back all node groups to the stable revision when a node group fails.
+	RolloutFailurePolicyRollback RolloutFailurePolicy = "Rollback"
+)
+
 // WorkloadScope represents which node groups the workload should be deployed in.
 type WorkloadScope struct {
 	// TargetNodeGroups represents the target node groups of workload to be deployed.
@@ -61,6 +119,28 @@ type TargetNodeGroup struct {
 	Name string `json:"name"`
 	// Overriders represents the override rules that would apply on workload.
 	Overriders Overriders `json:"overriders,omitempty"`
+	// Failover represents the policy to move the workload to a backup node group
+	// when all the nodes in this node group are unavailable. Failover is disabled if it is not set.
+	// +optional
+	Failover *FailoverPolicy `json:"failover,omitempty"`
+}
+
+// FailoverPolicy represents the policy to move the workload of a node group to a backup
+// node group on site outage, and move it back when the node group recovers.
+type FailoverPolicy struct {
+	// BackupNodeGroup is the name of the node group the workload will be moved to.
+	// +required
+	BackupNodeGroup string `json:"backupNodeGroup"`
+	// GracePeriodSeconds is the time that all the nodes in the node group have to be
+	// unavailable before the workload is moved to the backup node group. Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	GracePeriodSeconds *int32 `json:"gracePeriodSeconds,omitempty"`
+	// FailbackDelaySeconds is the time that the node group has to be available again
+	// before the workload is moved back from the backup node group. Defaults to 60.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailbackDelaySeconds *int32 `json:"failbackDelaySeconds,omitempty"`
 }
 
 // ResourceTemplate represents original templates of resources to be deployed
@@ -91,6 +171,138 @@ type Overriders struct {
 	// ResourcesOverriders will override the resources field of the container
 	// +optional
 	ResourcesOverriders []ResourcesOverrider `json:"resourcesOverriders,omitempty"`
+	// VolumeOverriders represents the rules dedicated to handling volumes of the pod template.
+	// +optional
+	VolumeOverriders []VolumeOverrider `json:"volumeOverriders,omitempty"`
+	// TolerationOverriders represents the rules dedicated to handling tolerations of the pod template.
+	// +optional
+	TolerationOverriders []TolerationOverrider `json:"tolerationOverriders,omitempty"`
+	// LabelsOverriders represents the rules dedicated to handling labels of the resource
+	// and its pod template.
+	// +optional
+	LabelsOverriders []LabelAnnotationOverrider `json:"labelsOverriders,omitempty"`
+	// AnnotationsOverriders represents the rules dedicated to handling annotations of the resource
+	// and its pod template.
+	// +optional
+	AnnotationsOverriders []LabelAnnotationOverrider `json:"annotationsOverriders,omitempty"`
+	// AffinityOverrider will override the affinity of the pod template.
+	// +optional
+	AffinityOverrider *AffinityOverrider `json:"affinityOverrider,omitempty"`
+	// JSONPatchOverriders represents the rules dedicated to applying JSON patches (RFC 6902)
+	// to manifests of any kind. They are applied after all the other overriders.
+	// +optional
+	JSONPatchOverriders []JSONPatchOverrider `json:"jsonPatchOverriders,omitempty"`
+}
+
+// VolumeOverrider represents the rules dedicated to handling volumes overrides.
+type VolumeOverrider struct {
+	// Operator represents the operator which will apply on the volumes.
+	// +kubebuilder:validation:Enum=add;remove;replace
+	// +required
+	Operator OverriderOperator `json:"operator"`
+
+	// Value to be applied to volumes.
+	// Volumes in Value will be appended when Operator is 'add'.
+	// Volumes with the same names as those in Value will be deleted when Operator is 'remove'.
+	// Volumes with the same names as those in Value will be replaced when Operator is 'replace',
+	// and volumes which do not exist will be appended.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Value []corev1.Volume `json:"value,omitempty"`
+}
+
+// TolerationOverrider represents the rules dedicated to handling tolerations overrides.
+type TolerationOverrider struct {
+	// Operator represents the operator which will apply on the tolerations.
+	// +kubebuilder:validation:Enum=add;remove;replace
+	// +required
+	Operator OverriderOperator `json:"operator"`
+
+	// Value to be applied to tolerations.
+	// Tolerations in Value will be appended when Operator is 'add'.
+	// Tolerations with the same key and effect as those in Value will be deleted when Operator is 'remove',
+	// an empty effect matches all effects.
+	// All the tolerations will be replaced with Value when Operator is 'replace'.
+	// +optional
+	Value []corev1.Toleration `json:"value,omitempty"`
+}
+
+// LabelAnnotationOverrider represents the rules dedicated to handling labels/annotations overrides.
+type LabelAnnotationOverrider struct {
+	// Operator represents the operator which will apply on the labels/annotations.
+	// +kubebuilder:validation:Enum=add;remove;replace
+	// +required
+	Operator OverriderOperator `json:"operator"`
+
+	// Value to be applied to labels/annotations of the resource and its pod template.
+	// Items in Value will be added or will overwrite the existing ones when Operator is 'add' or 'replace'.
+	// Items whose keys are in Value will be deleted when Operator is 'remove', the values are ignored.
+	// Labels used by the selector of a workload should not be removed.
+	// +optional
+	Value map[string]string `json:"value,omitempty"`
+}
+
+// AffinityOverrider represents the rules dedicated to handling affinity overrides.
+type AffinityOverrider struct {
+	// Value to be applied to the affinity of the pod template.
+	// Each of NodeAffinity, PodAffinity and PodAntiAffinity that is set in Value
+	// replaces the corresponding one in the pod template. The node affinity generated
+	// for TargetNodeLabels takes precedence over the NodeAffinity in Value.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +required
+	Value corev1.Affinity `json:"value"`
+}
+
+// JSONPatchOverrider represents the rules dedicated to applying JSON patches to manifests.
+type JSONPatchOverrider struct {
+	// Target selects the manifests to apply the patches to. Defaults to all the manifests
+	// whose kinds can be overridden, currently only Deployment.
+	// Manifests of other kinds selected by Target will be rendered as a copy for the node group,
+	// named in the same way as the overridden Deployments, e.g. '<name>-<nodegroup>', while the
+	// node groups without patches for them keep using the original ones. References of the
+	// Deployments of the node group to the copied ConfigMaps, Secrets, PersistentVolumeClaims
+	// and ServiceAccounts are rewritten to the copies. Services cannot be selected since they
+	// are shared by all the node groups.
+	// +optional
+	Target *ManifestSelector `json:"target,omitempty"`
+
+	// Patch is the list of JSON patch operations to apply to the selected manifests.
+	// +required
+	Patch []JSONPatchOperation `json:"patch"`
+}
+
+// ManifestSelector selects manifests in the workload template.
+type ManifestSelector struct {
+	// APIVersion of the manifest, e.g. "v1" or "apps/v1".
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Kind of the manifest.
+	// +required
+	Kind string `json:"kind"`
+	// Name of the manifest. Defaults to all the manifests of the kind.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// JSONPatchOperation represents a single operation of a JSON patch.
+type JSONPatchOperation struct {
+	// Op is the operation to perform.
+	// +kubebuilder:validation:Enum=add;remove;replace;move;copy;test
+	// +required
+	Op string `json:"op"`
+	// Path is a JSON pointer to the location of the manifest to perform the operation on.
+	// +required
+	Path string `json:"path"`
+	// From is a JSON pointer to the source location of the 'move' and 'copy' operations.
+	// +optional
+	From string `json:"from,omitempty"`
+	// Value is the value used by the 'add', 'replace' and 'test' operations.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Value *runtime.RawExtension `json:"value,omitempty"`
 }
 
 // CommandArgsOverrider represents the rules dedicated to handling command/args overrides.
@@ -231,8 +443,155 @@ type EdgeApplicationStatus struct {
 	// WorkloadStatus contains running statuses of generated resources.
 	// +optional
 	WorkloadStatus []ManifestStatus `json:"workloadStatus,omitempty"`
+	// RolloutStatus contains the progress of the progressive rollout of the workload template.
+	// It is only set when the rollout strategy is Progressive.
+	// +optional
+	RolloutStatus *RolloutStatus `json:"rolloutStatus,omitempty"`
+	// ObservedGeneration is the most recent generation of the EdgeApplication observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions contain the aggregated conditions of the workloads in all the targets.
+	// Valid condition types are Available, Progressing and Degraded.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// TargetStatus contains the aggregated status of the workloads rendered for each target.
+	// +optional
+	TargetStatus []TargetStatus `json:"targetStatus,omitempty"`
+	// Placements contain the active placement of the workloads of the target node groups
+	// that have a failover policy.
+	// +optional
+	Placements []NodeGroupPlacement `json:"placements,omitempty"`
 }
 
+// NodeGroupPlacement describes where the workload of a target node group is running.
+type NodeGroupPlacement struct {
+	// NodeGroup is the name of the target node group.
+	// +required
+	NodeGroup string `json:"nodeGroup"`
+	// ActiveNodeGroup is the name of the node group the workload is placed in, which is
+	// either the target node group or its backup node group.
+	// +required
+	ActiveNodeGroup string `json:"activeNodeGroup"`
+	// Reason is a brief CamelCase reason for the current placement.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message is a human-readable message indicating details about the current placement.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is the last time the active node group changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// TargetStatus contains the aggregated status of the workloads rendered for a target
+// in TargetNodeGroups or TargetNodeLabels.
+type TargetStatus struct {
+	// Name is the name of the target node group, or the suffix generated from the
+	// label selector, e.g. 'ls-1a2b3c4d', for targets in TargetNodeLabels.
+	// +required
+	Name string `json:"name"`
+	// DesiredReplicas is the total number of desired replicas of the workloads in this target.
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+	// UpdatedReplicas is the total number of replicas of the workloads in this target
+	// which are running the latest pod template.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+	// ReadyReplicas is the total number of ready replicas of the workloads in this target.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// AvailableReplicas is the total number of available replicas of the workloads in this target.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// Conditions contain the conditions of the workloads in this target.
+	// Valid condition types are Available, Progressing and Degraded.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+const (
+	// EdgeAppConditionAvailable means that all the workloads are available.
+	EdgeAppConditionAvailable = "Available"
+	// EdgeAppConditionProgressing means that some of the workloads are being created or updated.
+	EdgeAppConditionProgressing = "Progressing"
+	// EdgeAppConditionDegraded means that some of the workloads failed to run, the reason of the
+	// condition is taken from the failure of the pods or of the workloads.
+	EdgeAppConditionDegraded = "Degraded"
+)
+
+// RolloutStatus contains the progress of a progressive rollout.
+type RolloutStatus struct {
+	// StableRevision is the revision of the workload template which has been rolled out to
+	// all the target node groups successfully.
+	// +optional
+	StableRevision string `json:"stableRevision,omitempty"`
+	// UpdateRevision is the revision of the workload template that is being rolled out.
+	// +optional
+	UpdateRevision string `json:"updateRevision,omitempty"`
+	// Phase is the phase of the rollout.
+	// +kubebuilder:validation:Enum=Progressing;Paused;RolledBack;Completed
+	// +optional
+	Phase RolloutPhase `json:"phase,omitempty"`
+	// Message is a human-readable message indicating details about the rollout.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// NodeGroups contains the rollout status of each target node group.
+	// +optional
+	NodeGroups []NodeGroupRolloutStatus `json:"nodeGroups,omitempty"`
+}
+
+// NodeGroupRolloutStatus contains the rollout status of a target node group.
+type NodeGroupRolloutStatus struct {
+	// Name is the name of the node group.
+	// +required
+	Name string `json:"name"`
+	// Revision is the revision of the workload template rendered into this node group.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+	// Phase is the rollout phase of this node group.
+	// +kubebuilder:validation:Enum=Pending;Updating;Available;Failed
+	// +optional
+	Phase NodeGroupRolloutPhase `json:"phase,omitempty"`
+	// LastTransitionTime is the last time the phase transitioned.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// RolloutPhase is the phase of a progressive rollout.
+type RolloutPhase string
+
+const (
+	// RolloutProgressing means that the update revision is being rolled out to the node groups.
+	RolloutProgressing RolloutPhase = "Progressing"
+	// RolloutPaused means that a node group failed and the rollout stopped updating further node groups.
+	RolloutPaused RolloutPhase = "Paused"
+	// RolloutRolledBack means that a node group failed and all node groups have been rendered
+	// with the stable revision.
+	RolloutRolledBack RolloutPhase = "RolledBack"
+	// RolloutCompleted means that the update revision is available in all the node groups.
+	RolloutCompleted RolloutPhase = "Completed"
+)
+
+// NodeGroupRolloutPhase is the rollout phase of a node group.
+type NodeGroupRolloutPhase string
+
+const (
+	// NodeGroupRolloutPending means that the node group still runs the stable revision.
+	NodeGroupRolloutPending NodeGroupRolloutPhase = "Pending"
+	// NodeGroupRolloutUpdating means that the node group has been rendered with the update revision
+	// and its workloads are not available yet.
+	NodeGroupRolloutUpdating NodeGroupRolloutPhase = "Updating"
+	// NodeGroupRolloutAvailable means that the workloads of the update revision are available in the node group.
+	NodeGroupRolloutAvailable NodeGroupRolloutPhase = "Available"
+	// NodeGroupRolloutFailed means that the workloads of the update revision did not become available
+	// in the node group within the progress deadline.
+	NodeGroupRolloutFailed NodeGroupRolloutPhase = "Failed"
+)
+
 // ManifestStatus contains running status of a specific manifest in spec.
 type ManifestStatus struct {
 	// Identifier represents the identity of a resource linking to manifests in spec.
@@ -297,6 +656,10 @@ const (
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:shortName=eapp
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type==\"Available\")].status"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type==\"Progressing\")].status"
+// +kubebuilder:printcolumn:name="Degraded",type="string",JSONPath=".status.conditions[?(@.type==\"Degraded\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // EdgeApplication is the Schema for the edgeapplications API
 type EdgeApplication struct {
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -30,6 +31,11 @@ type NodeGroupSpec struct {
 	// +optional
 	MatchLabels map[string]string `json:"matchLabels,omitempty"`
 
+	// LabelSelector is used to select nodes by label selector requirements, such as
+	// In, NotIn, Exists and DoesNotExist. It is ANDed with MatchLabels if both are set.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
 	// topologyEnabled indicates whether the topology is enabled for this NodeGroup.
 	// +optional
 	TopologyEnabled bool `json:"topologyEnabled,omitempty"`
@@ -42,6 +48,19 @@ type NodeGroupStatus struct {
 	// NodeStatuses is a status list of all selected nodes.
 	// +optional
 	NodeStatuses []NodeStatus `json:"nodeStatuses,omitempty"`
+
+	// TotalNodes is the number of nodes which have been selected as members of this NodeGroup.
+	// +optional
+	TotalNodes int32 `json:"totalNodes,omitempty"`
+
+	// ReadyNodes is the number of ready nodes in this NodeGroup.
+	// +optional
+	ReadyNodes int32 `json:"readyNodes,omitempty"`
+
+	// Allocatable is the sum of the allocatable cpu, memory and pods of the ready nodes
+	// in this NodeGroup, which represents the capacity available for workloads.
+	// +optional
+	Allocatable corev1.ResourceList `json:"allocatable,omitempty"`
 }
 
 // NodeStatus contains status of node that selected by this NodeGroup.
@@ -90,6 +109,9 @@ const (
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName=ng
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyNodes"
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.totalNodes"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // NodeGroup is the Schema for the nodegroups API
 type NodeGroup struct {
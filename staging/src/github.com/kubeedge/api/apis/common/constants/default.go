@@ -22,6 +22,7 @@ const (
 	DefaultMaximumDeadContainersPerPod = 1
 	DefaultHostnameOverride            = "default-edge-node"
 	DefaultRegisterNodeNamespace       = "default"
+	DefaultCSIDriverName               = "csi-hostpath"
 	DefaultNetworkPluginMTU            = 1500
 	DefaultConcurrentConsumers         = 5
 	DefaultCgroupRoot                  = ""
@@ -36,6 +37,9 @@ const (
 	DefaultMetaServerAddr     = "127.0.0.1:10550"
 	DefaultDummyServerAddr    = "169.254.30.10:10550"
 
+	// MonitorServer
+	DefaultEdgeMonitorServerAddr = "127.0.0.1:10551"
+
 	// Config
 	DefaultKubeContentType = "application/vnd.kubernetes.protobuf"
 	DefaultNodeLimit       = 500
@@ -88,6 +92,7 @@ const (
 	DefaultDeviceEventBuffer         = 1
 	DefaultDeviceModelEventBuffer    = 1
 	DefaultUpdateDeviceStatusWorkers = 1
+	DefaultDeviceGroupUpdatesPerNode = 10
 
 	// TaskManager
 	DefaultNodeUpgradeJobStatusBuffer = 1024
@@ -108,6 +108,7 @@ func NewDefaultCloudCoreConfig() *CloudCoreConfig {
 				},
 				Load: &DeviceControllerLoad{
 					UpdateDeviceStatusWorkers: constants.DefaultUpdateDeviceStatusWorkers,
+					DeviceGroupUpdatesPerNode: constants.DefaultDeviceGroupUpdatesPerNode,
 				},
 			},
 			TaskManager: &TaskManager{
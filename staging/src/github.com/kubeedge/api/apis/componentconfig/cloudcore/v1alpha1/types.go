@@ -20,6 +20,7 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 )
 
 // CloudCoreConfig indicates the config of cloudCore which get from cloudCore config file
@@ -45,6 +46,10 @@ type CommonConfig struct {
 
 	// MonitorServer holds config that exposes prometheus metrics and pprof
 	MonitorServer MonitorServer `json:"monitorServer,omitempty"`
+
+	// Tracing holds config that exports the OpenTelemetry spans of the messages
+	// handled by the cloudcore modules over OTLP, tracing is disabled if not set
+	Tracing *tracingapi.TracingConfiguration `json:"tracing,omitempty"`
 }
 
 // MonitorServer indicates MonitorServer config
@@ -153,6 +158,9 @@ type CloudHub struct {
 	TokenRefreshDuration time.Duration `json:"tokenRefreshDuration,omitempty"`
 	// Authorization authz configurations
 	Authorization *CloudHubAuthorization `json:"authorization,omitempty"`
+	// MessageRouting routes the downstream messages between the CloudCore replicas
+	// +optional
+	MessageRouting *CloudHubMessageRouting `json:"messageRouting,omitempty"`
 }
 
 // CloudHubQUIC indicates the quic server config
@@ -207,6 +215,24 @@ type CloudHubHTTPS struct {
 	Port uint32 `json:"port,omitempty"`
 }
 
+// CloudHubMessageRouting indicates the config of routing the downstream messages between the CloudCore replicas.
+// With it enabled, every replica records the edge nodes connected to it in Leases, and forwards the downstream
+// messages of the nodes connected to other replicas to them through their HTTPS servers, so the messages are
+// delivered without waiting for the resync of the ObjectSyncs.
+type CloudHubMessageRouting struct {
+	// Enable indicates whether the downstream messages are routed between the replicas
+	// default false
+	Enable bool `json:"enable"`
+	// AdvertiseAddress is the IP address the other replicas use to reach the HTTPS server of this replica
+	// default the IP address of the host
+	// +optional
+	AdvertiseAddress string `json:"advertiseAddress,omitempty"`
+	// LeaseDurationSeconds is the duration of the Leases registering the replicas, a replica is considered
+	// gone if it doesn't renew its Lease in the duration
+	// default 40
+	LeaseDurationSeconds int32 `json:"leaseDurationSeconds,omitempty"`
+}
+
 // CloudHubAuthorization CloudHub authz configurations
 type CloudHubAuthorization struct {
 	// Enable indicates whether enable CloudHub Authorization
@@ -398,6 +424,29 @@ type DeviceController struct {
 	Buffer *DeviceControllerBuffer `json:"buffer,omitempty"`
 	// Load indicates DeviceController Load
 	Load *DeviceControllerLoad `json:"load,omitempty"`
+	// DiscoveryPolicies indicates the policies to approve the discovered devices automatically,
+	// the discovered devices matching no policy wait for the approval of an operator
+	DiscoveryPolicies []DeviceDiscoveryPolicy `json:"discoveryPolicies,omitempty"`
+	// DiscoveredDeviceNamespace indicates the namespace of the discovered devices, the namespace
+	// reported by the mapper is used only if it is the namespace of a policy applying to the node
+	// default "default"
+	DiscoveredDeviceNamespace string `json:"discoveredDeviceNamespace,omitempty"`
+}
+
+// DeviceDiscoveryPolicy indicates the discovered devices approved automatically
+type DeviceDiscoveryPolicy struct {
+	// Name indicates the name of the policy
+	Name string `json:"name"`
+	// Protocol indicates the protocol name of the discovered devices, empty matches all protocols
+	Protocol string `json:"protocol,omitempty"`
+	// DeviceModel indicates the device model of the discovered devices, empty matches all models.
+	// The discovered devices without a device model are never approved automatically
+	DeviceModel string `json:"deviceModel,omitempty"`
+	// Namespace indicates the namespace of the discovered devices, empty matches all namespaces.
+	// The mappers on the nodes of the policy are allowed to report devices in the namespace
+	Namespace string `json:"namespace,omitempty"`
+	// NodeNames indicates the nodes the devices are discovered on, empty matches all nodes
+	NodeNames []string `json:"nodeNames,omitempty"`
 }
 
 // DeviceControllerBuffer indicates deviceController buffer
@@ -421,6 +470,10 @@ type DeviceControllerLoad struct {
 	// UpdateDeviceStatusWorkers indicates the load of update device status workers
 	// default 1
 	UpdateDeviceStatusWorkers int32 `json:"updateDeviceStatusWorkers,omitempty"`
+	// DeviceGroupUpdatesPerNode indicates how many devices of an edge node are updated per second
+	// when applying the desired state of device groups
+	// default 10
+	DeviceGroupUpdatesPerNode int32 `json:"deviceGroupUpdatesPerNode,omitempty"`
 }
 
 // TaskManager indicates the operations controller
@@ -528,6 +581,28 @@ type CloudStream struct {
 	// StreamPort set open port for stream server
 	// default 10003
 	StreamPort uint32 `json:"streamPort,omitempty"`
+	// TunnelRouting indicates the config of routing the stream requests between the CloudCore replicas
+	// +optional
+	TunnelRouting *CloudStreamTunnelRouting `json:"tunnelRouting,omitempty"`
+}
+
+// CloudStreamTunnelRouting indicates the config of routing the stream requests between the CloudCore replicas.
+// With it enabled, every replica registers the tunnels it holds in Leases, and proxies the stream requests
+// of the nodes whose tunnels are held by other replicas to them. The kubelet endpoint port of the nodes is
+// the stream port, and the kube-apiserver reaches it through the HTTP CONNECT egress proxy of the stream
+// server, so the IptablesManager isn't required.
+type CloudStreamTunnelRouting struct {
+	// Enable indicates whether the stream requests are routed between the replicas
+	// default false
+	Enable bool `json:"enable"`
+	// AdvertiseAddress is the IP address the other replicas use to reach the stream server of this replica
+	// default the IP address of the host
+	// +optional
+	AdvertiseAddress string `json:"advertiseAddress,omitempty"`
+	// LeaseDurationSeconds is the duration of the Leases registering the replicas, a replica is considered
+	// gone if it doesn't renew its Lease in the duration
+	// default 40
+	LeaseDurationSeconds int32 `json:"leaseDurationSeconds,omitempty"`
 }
 
 type Router struct {
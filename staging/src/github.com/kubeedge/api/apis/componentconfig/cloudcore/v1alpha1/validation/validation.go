@@ -26,6 +26,7 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 	"k8s.io/klog/v2"
 	netutils "k8s.io/utils/net"
 
@@ -48,7 +49,9 @@ func ValidateCloudCoreConfiguration(c *v1alpha1.CloudCoreConfig) field.ErrorList
 }
 
 func ValidateCommonConfig(c v1alpha1.CommonConfig) field.ErrorList {
-	return validateHostPort(c.MonitorServer.BindAddress, field.NewPath("monitorServer.bindAddress"))
+	allErrs := validateHostPort(c.MonitorServer.BindAddress, field.NewPath("monitorServer.bindAddress"))
+	allErrs = append(allErrs, tracingapi.ValidateTracingConfiguration(c.Tracing, nil, field.NewPath("tracing"))...)
+	return allErrs
 }
 
 func validateHostPort(input string, fldPath *field.Path) field.ErrorList {
@@ -127,6 +130,17 @@ func ValidateModuleCloudHub(c v1alpha1.CloudHub) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("TokenRefreshDuration"),
 			c.TokenRefreshDuration, "TokenRefreshDuration must be positive"))
 	}
+	if r := c.MessageRouting; r != nil && r.Enable {
+		if r.AdvertiseAddress != "" {
+			for _, m := range utilvalidation.IsValidIP(r.AdvertiseAddress) {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("MessageRouting", "AdvertiseAddress"), r.AdvertiseAddress, m))
+			}
+		}
+		if r.LeaseDurationSeconds < 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("MessageRouting", "LeaseDurationSeconds"),
+				r.LeaseDurationSeconds, "LeaseDurationSeconds must not be negative"))
+		}
+	}
 	return allErrs
 }
 
@@ -199,6 +213,17 @@ func ValidateModuleCloudStream(d v1alpha1.CloudStream) field.ErrorList {
 	if !utilvalidation.FileIsExist(d.TLSStreamCAFile) {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("TLSStreamCAFile"), d.TLSStreamCAFile, "TLSStreamCAFile not exist"))
 	}
+	if r := d.TunnelRouting; r != nil && r.Enable {
+		if r.AdvertiseAddress != "" {
+			for _, m := range utilvalidation.IsValidIP(r.AdvertiseAddress) {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("TunnelRouting", "AdvertiseAddress"), r.AdvertiseAddress, m))
+			}
+		}
+		if r.LeaseDurationSeconds < 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("TunnelRouting", "LeaseDurationSeconds"),
+				r.LeaseDurationSeconds, "LeaseDurationSeconds must not be negative"))
+		}
+	}
 
 	return allErrs
 }
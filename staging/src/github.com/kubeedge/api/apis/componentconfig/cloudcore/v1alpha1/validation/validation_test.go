@@ -24,6 +24,8 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
+	utilpointer "k8s.io/utils/pointer"
 
 	"github.com/kubeedge/api/apis/componentconfig/cloudcore/v1alpha1"
 )
@@ -224,6 +226,37 @@ func TestValidateModuleCloudHub(t *testing.T) {
 			},
 			expected: field.ErrorList{field.Invalid(field.NewPath("TokenRefreshDuration"),
 				time.Duration(0), "TokenRefreshDuration must be positive")},
+		}, {
+			name: "case9 invalid MessageRouting",
+			input: v1alpha1.CloudHub{
+				Enable: true,
+				HTTPS: &v1alpha1.CloudHubHTTPS{
+					Port: 10000,
+				},
+				WebSocket: &v1alpha1.CloudHubWebSocket{
+					Port:    10002,
+					Address: "127.0.0.1",
+				},
+				Quic: &v1alpha1.CloudHubQUIC{
+					Port:    10002,
+					Address: "127.0.0.1",
+				},
+				UnixSocket: &v1alpha1.CloudHubUnixSocket{
+					Address: unixAddr,
+				},
+				TokenRefreshDuration: 1,
+				MessageRouting: &v1alpha1.CloudHubMessageRouting{
+					Enable:               true,
+					AdvertiseAddress:     "xxx.xxx.xxx.xxx",
+					LeaseDurationSeconds: -1,
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("MessageRouting", "AdvertiseAddress"), "xxx.xxx.xxx.xxx",
+					"must be a valid IP address, (e.g. 10.9.8.7)"),
+				field.Invalid(field.NewPath("MessageRouting", "LeaseDurationSeconds"), int32(-1),
+					"LeaseDurationSeconds must not be negative"),
+			},
 		},
 	}
 
@@ -428,6 +461,26 @@ func TestValidateModuleCloudStream(t *testing.T) {
 			},
 			expected: field.ErrorList{},
 		},
+		{
+			name: "case6 invalid tunnel routing",
+			input: v1alpha1.CloudStream{
+				Enable:                  true,
+				TLSStreamPrivateKeyFile: ef.Name(),
+				TLSStreamCertFile:       ef.Name(),
+				TLSStreamCAFile:         ef.Name(),
+				TunnelRouting: &v1alpha1.CloudStreamTunnelRouting{
+					Enable:               true,
+					AdvertiseAddress:     "1.1.1",
+					LeaseDurationSeconds: -1,
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("TunnelRouting", "AdvertiseAddress"), "1.1.1",
+					"must be a valid IP address, (e.g. 10.9.8.7)"),
+				field.Invalid(field.NewPath("TunnelRouting", "LeaseDurationSeconds"), int32(-1),
+					"LeaseDurationSeconds must not be negative"),
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -519,6 +572,31 @@ func TestValidateCommonConfig(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "invalid tracing sampling rate",
+			commonConfig: v1alpha1.CommonConfig{
+				MonitorServer: v1alpha1.MonitorServer{
+					BindAddress: "127.0.0.1:9091",
+				},
+				Tracing: &tracingapi.TracingConfiguration{
+					SamplingRatePerMillion: utilpointer.Int32(2000000),
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid tracing config",
+			commonConfig: v1alpha1.CommonConfig{
+				MonitorServer: v1alpha1.MonitorServer{
+					BindAddress: "127.0.0.1:9091",
+				},
+				Tracing: &tracingapi.TracingConfiguration{
+					Endpoint:               utilpointer.String("localhost:4317"),
+					SamplingRatePerMillion: utilpointer.Int32(10000),
+				},
+			},
+			expectedErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
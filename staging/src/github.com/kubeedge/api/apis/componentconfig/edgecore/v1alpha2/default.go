@@ -68,6 +68,7 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 				},
 				CustomInterfaceName:   "",
 				RegisterNodeNamespace: constants.DefaultRegisterNodeNamespace,
+				CSIDriverName:         constants.DefaultCSIDriverName,
 			},
 			EdgeHub: &EdgeHub{
 				Enable:            true,
@@ -158,6 +159,10 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 			},
 		},
 		EdgeCoreVersion: version.Get().String(),
+		MonitorServer: &MonitorServer{
+			Enable:      false,
+			BindAddress: constants.DefaultEdgeMonitorServerAddr,
+		},
 	}
 	return
 }
@@ -200,6 +205,7 @@ func NewMinEdgeCoreConfig() (config *EdgeCoreConfig) {
 				},
 				CustomInterfaceName:   "",
 				RegisterNodeNamespace: constants.DefaultRegisterNodeNamespace,
+				CSIDriverName:         constants.DefaultCSIDriverName,
 			},
 			EdgeHub: &EdgeHub{
 				Heartbeat:         15,
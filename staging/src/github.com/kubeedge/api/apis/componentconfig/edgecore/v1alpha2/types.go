@@ -20,6 +20,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	logsapi "k8s.io/component-base/logs/api/v1"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 	tailoredkubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 
 	metaconfig "github.com/kubeedge/api/apis/componentconfig/meta/v1alpha1"
@@ -54,6 +55,25 @@ type EdgeCoreConfig struct {
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
 	// EdgeCoreVersion records the latest version of edgecore
 	EdgeCoreVersion string `json:"edgecoreVersion"`
+	// MonitorServer holds config that exposes prometheus metrics and pprof
+	MonitorServer *MonitorServer `json:"monitorServer,omitempty"`
+	// Tracing holds config that exports the OpenTelemetry spans of the messages
+	// handled by the edgecore modules over OTLP, tracing is disabled if not set
+	Tracing *tracingapi.TracingConfiguration `json:"tracing,omitempty"`
+}
+
+// MonitorServer indicates MonitorServer config
+type MonitorServer struct {
+	// Enable indicates whether the monitor server is enabled,
+	// if set to false, edgecore does not serve the metrics
+	// default false
+	Enable bool `json:"enable"`
+	// BindAddress is the IP address and port for the monitor server to serve on,
+	// defaulting to 127.0.0.1:10551 (set to 0.0.0.0 for all interfaces)
+	BindAddress string `json:"bindAddress,omitempty"`
+	// EnableProfiling enables profiling via web interface on /debug/pprof handler.
+	// Profiling handlers will be handled by monitor server.
+	EnableProfiling bool `json:"enableProfiling,omitempty"`
 }
 
 // DataBase indicates the database info
@@ -117,6 +137,10 @@ type Edged struct {
 	// RegisterNodeNamespace indicates register node namespace
 	// default "default"
 	RegisterNodeNamespace string `json:"registerNodeNamespace,omitempty"`
+	// CSIDriverName indicates the CSI driver on the node serving the volumes provisioned by the
+	// CSI driver of the cloud, the driver must have registered to the kubelet.
+	// default "csi-hostpath"
+	CSIDriverName string `json:"csiDriverName,omitempty"`
 }
 
 // TailoredKubeletConfiguration indicates the tailored kubelet configuration.
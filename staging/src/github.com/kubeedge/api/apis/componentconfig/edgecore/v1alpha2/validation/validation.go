@@ -18,12 +18,16 @@ package validation
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"path"
+	"strconv"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/apis/core/validation"
+	netutils "k8s.io/utils/net"
 
 	"github.com/kubeedge/api/apis/componentconfig/edgecore/v1alpha2"
 	utilvalidation "github.com/kubeedge/api/apis/util/validation"
@@ -41,6 +45,8 @@ func ValidateEdgeCoreConfiguration(c *v1alpha2.EdgeCoreConfig) field.ErrorList {
 	allErrs = append(allErrs, ValidateModuleDeviceTwin(*c.Modules.DeviceTwin)...)
 	allErrs = append(allErrs, ValidateModuleDBTest(*c.Modules.DBTest)...)
 	allErrs = append(allErrs, ValidateModuleEdgeStream(*c.Modules.EdgeStream)...)
+	allErrs = append(allErrs, ValidateMonitorServer(c.MonitorServer)...)
+	allErrs = append(allErrs, tracingapi.ValidateTracingConfiguration(c.Tracing, nil, field.NewPath("tracing"))...)
 	return allErrs
 }
 
@@ -159,3 +165,24 @@ func ValidateModuleEdgeStream(m v1alpha2.EdgeStream) field.ErrorList {
 	}
 	return allErrs
 }
+
+// ValidateMonitorServer validates `m` and returns an errorList if it is invalid
+func ValidateMonitorServer(m *v1alpha2.MonitorServer) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if m == nil || !m.Enable {
+		return allErrs
+	}
+	fldPath := field.NewPath("monitorServer.bindAddress")
+	hostIP, port, err := net.SplitHostPort(m.BindAddress)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, m.BindAddress, "must be IP:port"))
+		return allErrs
+	}
+	if ip := netutils.ParseIPSloppy(hostIP); ip == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, hostIP, "must be a valid IP"))
+	}
+	if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath, port, "must be a valid port"))
+	}
+	return allErrs
+}
@@ -396,3 +396,59 @@ func TestValidateModuleEdgeStream(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateMonitorServer(t *testing.T) {
+	fldPath := field.NewPath("monitorServer.bindAddress")
+	cases := []struct {
+		name     string
+		input    *v1alpha2.MonitorServer
+		expected field.ErrorList
+	}{
+		{
+			name:     "case1 not set",
+			input:    nil,
+			expected: field.ErrorList{},
+		},
+		{
+			name: "case2 not enabled",
+			input: &v1alpha2.MonitorServer{
+				Enable:      false,
+				BindAddress: "invalid",
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "case3 valid bind address",
+			input: &v1alpha2.MonitorServer{
+				Enable:      true,
+				BindAddress: "127.0.0.1:10551",
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "case4 missing port",
+			input: &v1alpha2.MonitorServer{
+				Enable:      true,
+				BindAddress: "127.0.0.1",
+			},
+			expected: field.ErrorList{field.Invalid(fldPath, "127.0.0.1", "must be IP:port")},
+		},
+		{
+			name: "case5 invalid IP and port",
+			input: &v1alpha2.MonitorServer{
+				Enable:      true,
+				BindAddress: "localhost:0",
+			},
+			expected: field.ErrorList{
+				field.Invalid(fldPath, "localhost", "must be a valid IP"),
+				field.Invalid(fldPath, "0", "must be a valid port"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		if result := ValidateMonitorServer(c.input); !reflect.DeepEqual(result, c.expected) {
+			t.Errorf("%v: expected %v, but got %v", c.name, c.expected, result)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeviceGroupSpec selects the devices in the namespace of the group and the desired state
+// applied to them. A device is selected if it matches both the selector and the device model,
+// the group selects no device if neither of them is set.
+type DeviceGroupSpec struct {
+	// Selector selects the devices by labels.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// DeviceModelRef selects the devices of the device model.
+	// +optional
+	DeviceModelRef *v1.LocalObjectReference `json:"deviceModelRef,omitempty"`
+	// Properties are the desired values and push methods applied to the selected devices,
+	// the properties the device doesn't have are ignored.
+	// +optional
+	Properties []DeviceGroupProperty `json:"properties,omitempty"`
+}
+
+// DeviceGroupProperty is the desired state of a property applied to the devices of a group.
+type DeviceGroupProperty struct {
+	// Name of the property.
+	Name string `json:"name"`
+	// Desired is the desired value of the property.
+	// +optional
+	Desired *TwinProperty `json:"desired,omitempty"`
+	// PushMethod overrides the push method of the property.
+	// +optional
+	PushMethod *PushMethod `json:"pushMethod,omitempty"`
+}
+
+// DeviceGroupStatus is the aggregated status of the devices of a group.
+type DeviceGroupStatus struct {
+	// ObservedGeneration is the generation of the group the status is aggregated for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// MatchedDevices is the number of the devices selected by the group.
+	// +optional
+	MatchedDevices int32 `json:"matchedDevices"`
+	// AppliedDevices is the number of the devices whose desired values have been
+	// received by the edge and reported back as is.
+	// +optional
+	AppliedDevices int32 `json:"appliedDevices"`
+	// PendingDevices is the number of the devices waiting to be updated or for the edge
+	// to receive the desired values.
+	// +optional
+	PendingDevices int32 `json:"pendingDevices"`
+	// DriftedDevices is the number of the devices which have received the desired values,
+	// but report different values.
+	// +optional
+	DriftedDevices int32 `json:"driftedDevices"`
+	// ConflictedDevices is the number of the devices on which another group sets different
+	// desired values or push methods of the same properties, the group later by name takes
+	// precedence. The conflicted devices aren't counted as applied, pending or drifted.
+	// +optional
+	ConflictedDevices int32 `json:"conflictedDevices"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeviceGroup applies the same desired state to many devices and tracks how many of them
+// have applied it.
+// +k8s:openapi-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Matched",type=integer,JSONPath=`.status.matchedDevices`
+// +kubebuilder:printcolumn:name="Applied",type=integer,JSONPath=`.status.appliedDevices`
+// +kubebuilder:printcolumn:name="Pending",type=integer,JSONPath=`.status.pendingDevices`
+// +kubebuilder:printcolumn:name="Drifted",type=integer,JSONPath=`.status.driftedDevices`
+// +kubebuilder:printcolumn:name="Conflicted",type=integer,JSONPath=`.status.conflictedDevices`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type DeviceGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              DeviceGroupSpec   `json:"spec,omitempty"`
+	Status            DeviceGroupStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeviceGroupList contains a list of DeviceGroup
+type DeviceGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeviceGroup `json:"items"`
+}
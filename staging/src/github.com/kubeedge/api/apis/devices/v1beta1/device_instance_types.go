@@ -63,6 +63,27 @@ type DeviceStatus struct {
 	// Optional: Define how frequent mapper will report the device status.
 	// +optional
 	ReportCycle int64 `json:"reportCycle,omitempty"`
+	// Optional: The active alarms of the device raised by the edge node.
+	// +optional
+	Alarms []DeviceAlarm `json:"alarms,omitempty"`
+}
+
+// DeviceAlarm is an active alarm raised by an alarm rule of a device property.
+type DeviceAlarm struct {
+	// The name of the device property.
+	PropertyName string `json:"propertyName"`
+	// The name of the alarm rule.
+	RuleName string `json:"ruleName"`
+	// The severity of the alarm.
+	Severity AlarmSeverity `json:"severity,omitempty"`
+	// The reported value that raised the alarm.
+	// +optional
+	Value string `json:"value,omitempty"`
+	// The message of the alarm.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// The time the alarm was raised.
+	RaisedAt metav1.Time `json:"raisedAt,omitempty"`
 }
 
 // Twin provides a logical representation of control properties (writable properties in the
@@ -140,6 +161,9 @@ type DeviceProperty struct {
 	// please ensure that the mapper can access the destination address.
 	// +optional
 	PushMethod *PushMethod `json:"pushMethod,omitempty"`
+	// AlarmRules of the property, they override the rules with the same name in the device model.
+	// +optional
+	AlarmRules []AlarmRule `json:"alarmRules,omitempty"`
 }
 
 type PushMethod struct {
@@ -152,6 +176,9 @@ type PushMethod struct {
 	// OTEL Push Method configuration for otel
 	// +optional
 	OTEL *PushMethodOTEL `json:"otel,omitempty"`
+	// Prometheus Push Method configuration for prometheus
+	// +optional
+	Prometheus *PushMethodPrometheus `json:"prometheus,omitempty"`
 	// DBMethod represents the method used to push data to database,
 	// please ensure that the mapper can access the destination address.
 	// +optional
@@ -189,6 +216,24 @@ type PushMethodOTEL struct {
 	EndpointURL string `protobuf:"bytes,1,opt,name=endpointURL,proto3" json:"endpointURL,omitempty"`
 }
 
+// PushMethodPrometheus exposes the property as a gauge to Prometheus. The gauge is written to
+// the remote write endpoint if RemoteWriteURL is set, otherwise it's scraped from the /metrics
+// endpoint served by the mapper.
+type PushMethodPrometheus struct {
+	// Port of the /metrics endpoint served by the mapper, the properties with the same port share the endpoint.
+	// +optional
+	Port int64 `json:"port,omitempty"`
+	// URL of the remote write endpoint, like http://localhost:9090/api/v1/write
+	// +optional
+	RemoteWriteURL string `json:"remoteWriteURL,omitempty"`
+	// Name of the gauge, default the name of the property
+	// +optional
+	MetricName string `json:"metricName,omitempty"`
+	// Labels of the gauge besides the namespace and the name of the device
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
 type DBMethodConfig struct {
 	// method configuration for database
 	// +optional
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeviceModelSpec defines the model for a device.It is a blueprint which describes the device
@@ -37,7 +38,7 @@ type ModelProperty struct {
 	// The device property description.
 	// +optional
 	Description string `json:"description,omitempty"`
-	// Required: Type of device property, ENUM: INT,FLOAT,DOUBLE,STRING,BOOLEAN,BYTES,STREAM
+	// Required: Type of device property, ENUM: INT,FLOAT,DOUBLE,STRING,BOOLEAN,BYTES,STREAM,ENUM,OBJECT,ARRAY
 	Type PropertyType `json:"type,omitempty"`
 	// Required: Access mode of property, ReadWrite or ReadOnly.
 	AccessMode PropertyAccessMode `json:"accessMode,omitempty"`
@@ -48,10 +49,69 @@ type ModelProperty struct {
 	// The unit of the property
 	// +optional
 	Unit string `json:"unit,omitempty"`
+	// EnumValues are the allowed values of an ENUM property.
+	// +optional
+	EnumValues []string `json:"enumValues,omitempty"`
+	// Schema is a JSON schema fragment describing the structure of an OBJECT or ARRAY property,
+	// e.g. {"type":"object","properties":{"x":{"type":"number"}}}.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Schema *runtime.RawExtension `json:"schema,omitempty"`
+	// AlarmRules are evaluated by the edge node on each reported value of the property.
+	// +optional
+	AlarmRules []AlarmRule `json:"alarmRules,omitempty"`
+}
+
+// AlarmRule defines when an alarm of a device property is raised and cleared.
+type AlarmRule struct {
+	// Required: The name of the alarm rule, unique within the property.
+	// A rule of a device property overrides the rule with the same name in the device model.
+	Name string `json:"name"`
+	// Required: The operator to compare the reported value with the threshold.
+	Operator AlarmOperator `json:"operator"`
+	// Required: The threshold the reported value is compared with.
+	Threshold string `json:"threshold"`
+	// The alarm is raised only after the condition has been met for DurationSeconds.
+	// +optional
+	DurationSeconds int64 `json:"durationSeconds,omitempty"`
+	// Hysteresis is the distance the value must move back past the threshold before
+	// a raised alarm is cleared, it only applies to numeric comparisons.
+	// +optional
+	Hysteresis string `json:"hysteresis,omitempty"`
+	// Severity of the alarm, defaults to Warning.
+	// +optional
+	Severity AlarmSeverity `json:"severity,omitempty"`
+	// The message of the alarm.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
+// AlarmOperator is the operator of an alarm rule.
+// +kubebuilder:validation:Enum=GreaterThan;GreaterThanOrEqual;LessThan;LessThanOrEqual;Equal;NotEqual
+type AlarmOperator string
+
+const (
+	AlarmOperatorGreaterThan        AlarmOperator = "GreaterThan"
+	AlarmOperatorGreaterThanOrEqual AlarmOperator = "GreaterThanOrEqual"
+	AlarmOperatorLessThan           AlarmOperator = "LessThan"
+	AlarmOperatorLessThanOrEqual    AlarmOperator = "LessThanOrEqual"
+	AlarmOperatorEqual              AlarmOperator = "Equal"
+	AlarmOperatorNotEqual           AlarmOperator = "NotEqual"
+)
+
+// AlarmSeverity is the severity of an alarm.
+// +kubebuilder:validation:Enum=Info;Warning;Critical
+type AlarmSeverity string
+
+const (
+	AlarmSeverityInfo     AlarmSeverity = "Info"
+	AlarmSeverityWarning  AlarmSeverity = "Warning"
+	AlarmSeverityCritical AlarmSeverity = "Critical"
+)
+
 // The type of device property.
-// +kubebuilder:validation:Enum=INT;FLOAT;DOUBLE;STRING;BOOLEAN;BYTES;STREAM
+// +kubebuilder:validation:Enum=INT;FLOAT;DOUBLE;STRING;BOOLEAN;BYTES;STREAM;ENUM;OBJECT;ARRAY
 type PropertyType string
 
 const (
@@ -62,6 +122,9 @@ const (
 	BOOLEAN PropertyType = "BOOLEAN"
 	BYTES   PropertyType = "BYTES"
 	STREAM  PropertyType = "STREAM"
+	ENUM    PropertyType = "ENUM"
+	OBJECT  PropertyType = "OBJECT"
+	ARRAY   PropertyType = "ARRAY"
 )
 
 // The access mode for  a device property.
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DiscoveredDeviceSpec is the device found by a mapper through the discovery of its protocol.
+type DiscoveredDeviceSpec struct {
+	// NodeName is the name of the edge node where the device is discovered.
+	NodeName string `json:"nodeName"`
+	// MapperName is the name of the mapper which discovered the device.
+	// +optional
+	MapperName string `json:"mapperName,omitempty"`
+	// DeviceModelRef is the device model the device matches, it's reported by the mapper
+	// or set by the operator before approving the device.
+	// +optional
+	DeviceModelRef *v1.LocalObjectReference `json:"deviceModelRef,omitempty"`
+	// Protocol is the protocol configuration used to connect to the device.
+	Protocol ProtocolConfig `json:"protocol,omitempty"`
+	// Properties are the properties of the device with the visitors to access them.
+	// +optional
+	Properties []DeviceProperty `json:"properties,omitempty"`
+	// Attributes of the device, e.g. manufacturer, serial number and firmware version.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// Approved is set by the operator to provision the discovered device as a Device.
+	// +optional
+	Approved bool `json:"approved,omitempty"`
+}
+
+// DiscoveredDevicePhase is the phase of a discovered device.
+// +kubebuilder:validation:Enum=Pending;Provisioned;Failed
+type DiscoveredDevicePhase string
+
+const (
+	// DiscoveredDevicePending means the discovered device is waiting for approval.
+	DiscoveredDevicePending DiscoveredDevicePhase = "Pending"
+	// DiscoveredDeviceProvisioned means the Device of the discovered device has been created.
+	DiscoveredDeviceProvisioned DiscoveredDevicePhase = "Provisioned"
+	// DiscoveredDeviceFailed means the Device of the discovered device failed to be created.
+	DiscoveredDeviceFailed DiscoveredDevicePhase = "Failed"
+)
+
+// DiscoveredDeviceStatus is the provisioning status of a discovered device.
+type DiscoveredDeviceStatus struct {
+	// Phase of the discovered device.
+	// +optional
+	Phase DiscoveredDevicePhase `json:"phase,omitempty"`
+	// DeviceName is the name of the Device provisioned from the discovered device.
+	// +optional
+	DeviceName string `json:"deviceName,omitempty"`
+	// ApprovedBy is "Operator" if the device is approved manually, or the name of the
+	// discovery policy which approved the device automatically.
+	// +optional
+	ApprovedBy string `json:"approvedBy,omitempty"`
+	// LastSeenTime is the last time the device was reported by the mapper.
+	// +optional
+	LastSeenTime metav1.Time `json:"lastSeenTime,omitempty"`
+	// Message is a human readable message about the provisioning of the device.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DiscoveredDevice is a device candidate reported by a mapper, it can be approved
+// by an operator or a discovery policy to be provisioned as a Device.
+// +k8s:openapi-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Node",type=string,JSONPath=`.spec.nodeName`
+// +kubebuilder:printcolumn:name="Protocol",type=string,JSONPath=`.spec.protocol.protocolName`
+// +kubebuilder:printcolumn:name="Model",type=string,JSONPath=`.spec.deviceModelRef.name`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type DiscoveredDevice struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              DiscoveredDeviceSpec   `json:"spec,omitempty"`
+	Status            DiscoveredDeviceStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DiscoveredDeviceList contains a list of DiscoveredDevice
+type DiscoveredDeviceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DiscoveredDevice `json:"items"`
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MapperNodeNameLabel is the label of the Mapper with the name of the node where the mapper runs.
+	MapperNodeNameLabel = "devices.kubeedge.io/node-name"
+
+	// MapperConditionRegistered means the mapper has registered to edgecore.
+	MapperConditionRegistered = "Registered"
+	// MapperConditionHealthy means the heartbeats of the mapper are received in time.
+	MapperConditionHealthy = "Healthy"
+	// MapperConditionStale means nothing has been heard from the mapper for a long time,
+	// the mapper or the edge node may be gone.
+	MapperConditionStale = "Stale"
+)
+
+// MapperSpec identifies a mapper running on an edge node.
+type MapperSpec struct {
+	// NodeName is the name of the edge node where the mapper runs.
+	NodeName string `json:"nodeName"`
+	// MapperName is the name the mapper registers with.
+	MapperName string `json:"mapperName"`
+	// Protocol is the device protocol the mapper serves.
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// MapperStatus is the status of a mapper reported by edgecore.
+type MapperStatus struct {
+	// Version of the mapper.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// APIVersion is the DMI version the mapper implements.
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Address is the address of the gRPC server of the mapper.
+	// +optional
+	Address string `json:"address,omitempty"`
+	// State is the state reported by the mapper.
+	// +optional
+	State string `json:"state,omitempty"`
+	// RegisteredTime is the last time the mapper registered to edgecore.
+	// +optional
+	RegisteredTime metav1.Time `json:"registeredTime,omitempty"`
+	// LastHeartbeatTime is the last time edgecore heard from the mapper.
+	// +optional
+	LastHeartbeatTime metav1.Time `json:"lastHeartbeatTime,omitempty"`
+	// Conditions of the mapper, including Registered, Healthy and Stale.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Mapper is the inventory and health of a mapper running on an edge node.
+// +k8s:openapi-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Node",type=string,JSONPath=`.spec.nodeName`
+// +kubebuilder:printcolumn:name="Mapper",type=string,JSONPath=`.spec.mapperName`
+// +kubebuilder:printcolumn:name="Protocol",type=string,JSONPath=`.spec.protocol`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Healthy",type=string,JSONPath=`.status.conditions[?(@.type=="Healthy")].status`
+// +kubebuilder:printcolumn:name="Last Heartbeat",type=date,JSONPath=`.status.lastHeartbeatTime`
+type Mapper struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MapperSpec   `json:"spec,omitempty"`
+	Status            MapperStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MapperList contains a list of Mapper
+type MapperList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Mapper `json:"items"`
+}
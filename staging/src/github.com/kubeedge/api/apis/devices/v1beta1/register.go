@@ -67,6 +67,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&DeviceList{},
 		&DeviceModel{},
 		&DeviceModelList{},
+		&DiscoveredDevice{},
+		&DiscoveredDeviceList{},
+		&Mapper{},
+		&MapperList{},
+		&DeviceGroup{},
+		&DeviceGroupList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -22,10 +22,27 @@ limitations under the License.
 package v1beta1
 
 import (
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmRule) DeepCopyInto(out *AlarmRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmRule.
+func (in *AlarmRule) DeepCopy() *AlarmRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBMethodConfig) DeepCopyInto(out *DBMethodConfig) {
 	*out = *in
@@ -179,6 +196,159 @@ func (in *Device) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceAlarm) DeepCopyInto(out *DeviceAlarm) {
+	*out = *in
+	in.RaisedAt.DeepCopyInto(&out.RaisedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceAlarm.
+func (in *DeviceAlarm) DeepCopy() *DeviceAlarm {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAlarm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceGroup) DeepCopyInto(out *DeviceGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceGroup.
+func (in *DeviceGroup) DeepCopy() *DeviceGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceGroupList) DeepCopyInto(out *DeviceGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeviceGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceGroupList.
+func (in *DeviceGroupList) DeepCopy() *DeviceGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeviceGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceGroupProperty) DeepCopyInto(out *DeviceGroupProperty) {
+	*out = *in
+	if in.Desired != nil {
+		in, out := &in.Desired, &out.Desired
+		*out = new(TwinProperty)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PushMethod != nil {
+		in, out := &in.PushMethod, &out.PushMethod
+		*out = new(PushMethod)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceGroupProperty.
+func (in *DeviceGroupProperty) DeepCopy() *DeviceGroupProperty {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceGroupProperty)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceGroupSpec) DeepCopyInto(out *DeviceGroupSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeviceModelRef != nil {
+		in, out := &in.DeviceModelRef, &out.DeviceModelRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]DeviceGroupProperty, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceGroupSpec.
+func (in *DeviceGroupSpec) DeepCopy() *DeviceGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceGroupStatus) DeepCopyInto(out *DeviceGroupStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceGroupStatus.
+func (in *DeviceGroupStatus) DeepCopy() *DeviceGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceList) DeepCopyInto(out *DeviceList) {
 	*out = *in
@@ -299,7 +469,9 @@ func (in *DeviceModelSpec) DeepCopyInto(out *DeviceModelSpec) {
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
 		*out = make([]ModelProperty, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -324,6 +496,11 @@ func (in *DeviceProperty) DeepCopyInto(out *DeviceProperty) {
 		*out = new(PushMethod)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AlarmRules != nil {
+		in, out := &in.AlarmRules, &out.AlarmRules
+		*out = make([]AlarmRule, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -342,7 +519,7 @@ func (in *DeviceSpec) DeepCopyInto(out *DeviceSpec) {
 	*out = *in
 	if in.DeviceModelRef != nil {
 		in, out := &in.DeviceModelRef, &out.DeviceModelRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
 	if in.Properties != nil {
@@ -383,6 +560,13 @@ func (in *DeviceStatus) DeepCopyInto(out *DeviceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Alarms != nil {
+		in, out := &in.Alarms, &out.Alarms
+		*out = make([]DeviceAlarm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -396,6 +580,120 @@ func (in *DeviceStatus) DeepCopy() *DeviceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredDevice) DeepCopyInto(out *DiscoveredDevice) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredDevice.
+func (in *DiscoveredDevice) DeepCopy() *DiscoveredDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiscoveredDevice) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredDeviceList) DeepCopyInto(out *DiscoveredDeviceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DiscoveredDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredDeviceList.
+func (in *DiscoveredDeviceList) DeepCopy() *DiscoveredDeviceList {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredDeviceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiscoveredDeviceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredDeviceSpec) DeepCopyInto(out *DiscoveredDeviceSpec) {
+	*out = *in
+	if in.DeviceModelRef != nil {
+		in, out := &in.DeviceModelRef, &out.DeviceModelRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	in.Protocol.DeepCopyInto(&out.Protocol)
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]DeviceProperty, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredDeviceSpec.
+func (in *DiscoveredDeviceSpec) DeepCopy() *DiscoveredDeviceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredDeviceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredDeviceStatus) DeepCopyInto(out *DiscoveredDeviceStatus) {
+	*out = *in
+	in.LastSeenTime.DeepCopyInto(&out.LastSeenTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredDeviceStatus.
+func (in *DiscoveredDeviceStatus) DeepCopy() *DiscoveredDeviceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredDeviceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Influxdb2ClientConfig) DeepCopyInto(out *Influxdb2ClientConfig) {
 	*out = *in
@@ -435,9 +733,126 @@ func (in *Influxdb2DataConfig) DeepCopy() *Influxdb2DataConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Mapper) DeepCopyInto(out *Mapper) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mapper.
+func (in *Mapper) DeepCopy() *Mapper {
+	if in == nil {
+		return nil
+	}
+	out := new(Mapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Mapper) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MapperList) DeepCopyInto(out *MapperList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Mapper, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MapperList.
+func (in *MapperList) DeepCopy() *MapperList {
+	if in == nil {
+		return nil
+	}
+	out := new(MapperList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MapperList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MapperSpec) DeepCopyInto(out *MapperSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MapperSpec.
+func (in *MapperSpec) DeepCopy() *MapperSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MapperSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MapperStatus) DeepCopyInto(out *MapperStatus) {
+	*out = *in
+	in.RegisteredTime.DeepCopyInto(&out.RegisteredTime)
+	in.LastHeartbeatTime.DeepCopyInto(&out.LastHeartbeatTime)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MapperStatus.
+func (in *MapperStatus) DeepCopy() *MapperStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MapperStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelProperty) DeepCopyInto(out *ModelProperty) {
 	*out = *in
+	if in.EnumValues != nil {
+		in, out := &in.EnumValues, &out.EnumValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlarmRules != nil {
+		in, out := &in.AlarmRules, &out.AlarmRules
+		*out = make([]AlarmRule, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -505,6 +920,11 @@ func (in *PushMethod) DeepCopyInto(out *PushMethod) {
 		*out = new(PushMethodOTEL)
 		**out = **in
 	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PushMethodPrometheus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DBMethod != nil {
 		in, out := &in.DBMethod, &out.DBMethod
 		*out = new(DBMethodConfig)
@@ -571,6 +991,29 @@ func (in *PushMethodOTEL) DeepCopy() *PushMethodOTEL {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PushMethodPrometheus) DeepCopyInto(out *PushMethodPrometheus) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushMethodPrometheus.
+func (in *PushMethodPrometheus) DeepCopy() *PushMethodPrometheus {
+	if in == nil {
+		return nil
+	}
+	out := new(PushMethodPrometheus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisClientConfig) DeepCopyInto(out *RedisClientConfig) {
 	*out = *in
@@ -36,9 +36,10 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	DeviceManagerService_MapperRegister_FullMethodName     = "/v1beta1.DeviceManagerService/MapperRegister"
-	DeviceManagerService_ReportDeviceStatus_FullMethodName = "/v1beta1.DeviceManagerService/ReportDeviceStatus"
-	DeviceManagerService_ReportDeviceStates_FullMethodName = "/v1beta1.DeviceManagerService/ReportDeviceStates"
+	DeviceManagerService_MapperRegister_FullMethodName          = "/v1beta1.DeviceManagerService/MapperRegister"
+	DeviceManagerService_ReportDeviceStatus_FullMethodName      = "/v1beta1.DeviceManagerService/ReportDeviceStatus"
+	DeviceManagerService_ReportDeviceStates_FullMethodName      = "/v1beta1.DeviceManagerService/ReportDeviceStates"
+	DeviceManagerService_ReportDiscoveredDevices_FullMethodName = "/v1beta1.DeviceManagerService/ReportDiscoveredDevices"
 )
 
 // DeviceManagerServiceClient is the client API for DeviceManagerService service.
@@ -62,6 +63,10 @@ type DeviceManagerServiceClient interface {
 	// TODO Rename ReportDeviceStatus to ReportDeviceTwins
 	// ReportDeviceStates reports the state of devices to device manager.
 	ReportDeviceStates(ctx context.Context, in *ReportDeviceStatesRequest, opts ...grpc.CallOption) (*ReportDeviceStatesResponse, error)
+	// ReportDiscoveredDevices reports the devices discovered by the mapper to device manager.
+	// The mapper enumerates the devices through the discovery of its protocol, e.g. modbus scan,
+	// ONVIF probe or OPC UA browse, and reports them with the protocol config to access them.
+	ReportDiscoveredDevices(ctx context.Context, in *ReportDiscoveredDevicesRequest, opts ...grpc.CallOption) (*ReportDiscoveredDevicesResponse, error)
 }
 
 type deviceManagerServiceClient struct {
@@ -102,6 +107,16 @@ func (c *deviceManagerServiceClient) ReportDeviceStates(ctx context.Context, in
 	return out, nil
 }
 
+func (c *deviceManagerServiceClient) ReportDiscoveredDevices(ctx context.Context, in *ReportDiscoveredDevicesRequest, opts ...grpc.CallOption) (*ReportDiscoveredDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportDiscoveredDevicesResponse)
+	err := c.cc.Invoke(ctx, DeviceManagerService_ReportDiscoveredDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceManagerServiceServer is the server API for DeviceManagerService service.
 // All implementations must embed UnimplementedDeviceManagerServiceServer
 // for forward compatibility
@@ -123,6 +138,10 @@ type DeviceManagerServiceServer interface {
 	// TODO Rename ReportDeviceStatus to ReportDeviceTwins
 	// ReportDeviceStates reports the state of devices to device manager.
 	ReportDeviceStates(context.Context, *ReportDeviceStatesRequest) (*ReportDeviceStatesResponse, error)
+	// ReportDiscoveredDevices reports the devices discovered by the mapper to device manager.
+	// The mapper enumerates the devices through the discovery of its protocol, e.g. modbus scan,
+	// ONVIF probe or OPC UA browse, and reports them with the protocol config to access them.
+	ReportDiscoveredDevices(context.Context, *ReportDiscoveredDevicesRequest) (*ReportDiscoveredDevicesResponse, error)
 	mustEmbedUnimplementedDeviceManagerServiceServer()
 }
 
@@ -139,6 +158,9 @@ func (UnimplementedDeviceManagerServiceServer) ReportDeviceStatus(context.Contex
 func (UnimplementedDeviceManagerServiceServer) ReportDeviceStates(context.Context, *ReportDeviceStatesRequest) (*ReportDeviceStatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportDeviceStates not implemented")
 }
+func (UnimplementedDeviceManagerServiceServer) ReportDiscoveredDevices(context.Context, *ReportDiscoveredDevicesRequest) (*ReportDiscoveredDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDiscoveredDevices not implemented")
+}
 func (UnimplementedDeviceManagerServiceServer) mustEmbedUnimplementedDeviceManagerServiceServer() {}
 
 // UnsafeDeviceManagerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -206,6 +228,24 @@ func _DeviceManagerService_ReportDeviceStates_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceManagerService_ReportDiscoveredDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDiscoveredDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceManagerServiceServer).ReportDiscoveredDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceManagerService_ReportDiscoveredDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceManagerServiceServer).ReportDiscoveredDevices(ctx, req.(*ReportDiscoveredDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceManagerService_ServiceDesc is the grpc.ServiceDesc for DeviceManagerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,6 +265,10 @@ var DeviceManagerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportDeviceStates",
 			Handler:    _DeviceManagerService_ReportDeviceStates_Handler,
 		},
+		{
+			MethodName: "ReportDiscoveredDevices",
+			Handler:    _DeviceManagerService_ReportDiscoveredDevices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
@@ -29,7 +29,10 @@ import (
 type DevicesV1beta1Interface interface {
 	RESTClient() rest.Interface
 	DevicesGetter
+	DeviceGroupsGetter
 	DeviceModelsGetter
+	DiscoveredDevicesGetter
+	MappersGetter
 }
 
 // DevicesV1beta1Client is used to interact with features provided by the devices group.
@@ -41,10 +44,22 @@ func (c *DevicesV1beta1Client) Devices(namespace string) DeviceInterface {
 	return newDevices(c, namespace)
 }
 
+func (c *DevicesV1beta1Client) DeviceGroups(namespace string) DeviceGroupInterface {
+	return newDeviceGroups(c, namespace)
+}
+
 func (c *DevicesV1beta1Client) DeviceModels(namespace string) DeviceModelInterface {
 	return newDeviceModels(c, namespace)
 }
 
+func (c *DevicesV1beta1Client) DiscoveredDevices(namespace string) DiscoveredDeviceInterface {
+	return newDiscoveredDevices(c, namespace)
+}
+
+func (c *DevicesV1beta1Client) Mappers(namespace string) MapperInterface {
+	return newMappers(c, namespace)
+}
+
 // NewForConfig creates a new DevicesV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
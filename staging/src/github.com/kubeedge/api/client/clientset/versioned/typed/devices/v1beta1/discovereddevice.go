@@ -0,0 +1,195 @@
+/*
+Copyright The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/kubeedge/api/apis/devices/v1beta1"
+	scheme "github.com/kubeedge/api/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// DiscoveredDevicesGetter has a method to return a DiscoveredDeviceInterface.
+// A group's client should implement this interface.
+type DiscoveredDevicesGetter interface {
+	DiscoveredDevices(namespace string) DiscoveredDeviceInterface
+}
+
+// DiscoveredDeviceInterface has methods to work with DiscoveredDevice resources.
+type DiscoveredDeviceInterface interface {
+	Create(ctx context.Context, discoveredDevice *v1beta1.DiscoveredDevice, opts v1.CreateOptions) (*v1beta1.DiscoveredDevice, error)
+	Update(ctx context.Context, discoveredDevice *v1beta1.DiscoveredDevice, opts v1.UpdateOptions) (*v1beta1.DiscoveredDevice, error)
+	UpdateStatus(ctx context.Context, discoveredDevice *v1beta1.DiscoveredDevice, opts v1.UpdateOptions) (*v1beta1.DiscoveredDevice, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.DiscoveredDevice, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.DiscoveredDeviceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.DiscoveredDevice, err error)
+	DiscoveredDeviceExpansion
+}
+
+// discoveredDevices implements DiscoveredDeviceInterface
+type discoveredDevices struct {
+	client rest.Interface
+	ns     string
+}
+
+// newDiscoveredDevices returns a DiscoveredDevices
+func newDiscoveredDevices(c *DevicesV1beta1Client, namespace string) *discoveredDevices {
+	return &discoveredDevices{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the discoveredDevice, and returns the corresponding discoveredDevice object, and an error if there is any.
+func (c *discoveredDevices) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.DiscoveredDevice, err error) {
+	result = &v1beta1.DiscoveredDevice{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("discovereddevices").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of DiscoveredDevices that match those selectors.
+func (c *discoveredDevices) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.DiscoveredDeviceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.DiscoveredDeviceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("discovereddevices").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested discoveredDevices.
+func (c *discoveredDevices) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("discovereddevices").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a discoveredDevice and creates it.  Returns the server's representation of the discoveredDevice, and an error, if there is any.
+func (c *discoveredDevices) Create(ctx context.Context, discoveredDevice *v1beta1.DiscoveredDevice, opts v1.CreateOptions) (result *v1beta1.DiscoveredDevice, err error) {
+	result = &v1beta1.DiscoveredDevice{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("discovereddevices").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(discoveredDevice).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a discoveredDevice and updates it. Returns the server's representation of the discoveredDevice, and an error, if there is any.
+func (c *discoveredDevices) Update(ctx context.Context, discoveredDevice *v1beta1.DiscoveredDevice, opts v1.UpdateOptions) (result *v1beta1.DiscoveredDevice, err error) {
+	result = &v1beta1.DiscoveredDevice{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("discovereddevices").
+		Name(discoveredDevice.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(discoveredDevice).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *discoveredDevices) UpdateStatus(ctx context.Context, discoveredDevice *v1beta1.DiscoveredDevice, opts v1.UpdateOptions) (result *v1beta1.DiscoveredDevice, err error) {
+	result = &v1beta1.DiscoveredDevice{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("discovereddevices").
+		Name(discoveredDevice.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(discoveredDevice).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the discoveredDevice and deletes it. Returns an error if one occurs.
+func (c *discoveredDevices) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("discovereddevices").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *discoveredDevices) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("discovereddevices").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched discoveredDevice.
+func (c *discoveredDevices) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.DiscoveredDevice, err error) {
+	result = &v1beta1.DiscoveredDevice{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("discovereddevices").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -32,10 +32,22 @@ func (c *FakeDevicesV1beta1) Devices(namespace string) v1beta1.DeviceInterface {
 	return &FakeDevices{c, namespace}
 }
 
+func (c *FakeDevicesV1beta1) DeviceGroups(namespace string) v1beta1.DeviceGroupInterface {
+	return &FakeDeviceGroups{c, namespace}
+}
+
 func (c *FakeDevicesV1beta1) DeviceModels(namespace string) v1beta1.DeviceModelInterface {
 	return &FakeDeviceModels{c, namespace}
 }
 
+func (c *FakeDevicesV1beta1) DiscoveredDevices(namespace string) v1beta1.DiscoveredDeviceInterface {
+	return &FakeDiscoveredDevices{c, namespace}
+}
+
+func (c *FakeDevicesV1beta1) Mappers(namespace string) v1beta1.MapperInterface {
+	return &FakeMappers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeDevicesV1beta1) RESTClient() rest.Interface {
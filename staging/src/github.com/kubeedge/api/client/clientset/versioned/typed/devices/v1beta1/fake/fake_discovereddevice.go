@@ -0,0 +1,141 @@
+/*
+Copyright The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/kubeedge/api/apis/devices/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDiscoveredDevices implements DiscoveredDeviceInterface
+type FakeDiscoveredDevices struct {
+	Fake *FakeDevicesV1beta1
+	ns   string
+}
+
+var discovereddevicesResource = v1beta1.SchemeGroupVersion.WithResource("discovereddevices")
+
+var discovereddevicesKind = v1beta1.SchemeGroupVersion.WithKind("DiscoveredDevice")
+
+// Get takes name of the discoveredDevice, and returns the corresponding discoveredDevice object, and an error if there is any.
+func (c *FakeDiscoveredDevices) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.DiscoveredDevice, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(discovereddevicesResource, c.ns, name), &v1beta1.DiscoveredDevice{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.DiscoveredDevice), err
+}
+
+// List takes label and field selectors, and returns the list of DiscoveredDevices that match those selectors.
+func (c *FakeDiscoveredDevices) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.DiscoveredDeviceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(discovereddevicesResource, discovereddevicesKind, c.ns, opts), &v1beta1.DiscoveredDeviceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.DiscoveredDeviceList{ListMeta: obj.(*v1beta1.DiscoveredDeviceList).ListMeta}
+	for _, item := range obj.(*v1beta1.DiscoveredDeviceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested discoveredDevices.
+func (c *FakeDiscoveredDevices) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(discovereddevicesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a discoveredDevice and creates it.  Returns the server's representation of the discoveredDevice, and an error, if there is any.
+func (c *FakeDiscoveredDevices) Create(ctx context.Context, discoveredDevice *v1beta1.DiscoveredDevice, opts v1.CreateOptions) (result *v1beta1.DiscoveredDevice, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(discovereddevicesResource, c.ns, discoveredDevice), &v1beta1.DiscoveredDevice{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.DiscoveredDevice), err
+}
+
+// Update takes the representation of a discoveredDevice and updates it. Returns the server's representation of the discoveredDevice, and an error, if there is any.
+func (c *FakeDiscoveredDevices) Update(ctx context.Context, discoveredDevice *v1beta1.DiscoveredDevice, opts v1.UpdateOptions) (result *v1beta1.DiscoveredDevice, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(discovereddevicesResource, c.ns, discoveredDevice), &v1beta1.DiscoveredDevice{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.DiscoveredDevice), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDiscoveredDevices) UpdateStatus(ctx context.Context, discoveredDevice *v1beta1.DiscoveredDevice, opts v1.UpdateOptions) (*v1beta1.DiscoveredDevice, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(discovereddevicesResource, "status", c.ns, discoveredDevice), &v1beta1.DiscoveredDevice{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.DiscoveredDevice), err
+}
+
+// Delete takes name of the discoveredDevice and deletes it. Returns an error if one occurs.
+func (c *FakeDiscoveredDevices) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(discovereddevicesResource, c.ns, name, opts), &v1beta1.DiscoveredDevice{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDiscoveredDevices) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(discovereddevicesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.DiscoveredDeviceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched discoveredDevice.
+func (c *FakeDiscoveredDevices) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.DiscoveredDevice, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(discovereddevicesResource, c.ns, name, pt, data, subresources...), &v1beta1.DiscoveredDevice{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.DiscoveredDevice), err
+}
@@ -0,0 +1,141 @@
+/*
+Copyright The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/kubeedge/api/apis/devices/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeMappers implements MapperInterface
+type FakeMappers struct {
+	Fake *FakeDevicesV1beta1
+	ns   string
+}
+
+var mappersResource = v1beta1.SchemeGroupVersion.WithResource("mappers")
+
+var mappersKind = v1beta1.SchemeGroupVersion.WithKind("Mapper")
+
+// Get takes name of the mapper, and returns the corresponding mapper object, and an error if there is any.
+func (c *FakeMappers) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.Mapper, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(mappersResource, c.ns, name), &v1beta1.Mapper{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Mapper), err
+}
+
+// List takes label and field selectors, and returns the list of Mappers that match those selectors.
+func (c *FakeMappers) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.MapperList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(mappersResource, mappersKind, c.ns, opts), &v1beta1.MapperList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.MapperList{ListMeta: obj.(*v1beta1.MapperList).ListMeta}
+	for _, item := range obj.(*v1beta1.MapperList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested mappers.
+func (c *FakeMappers) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(mappersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a mapper and creates it.  Returns the server's representation of the mapper, and an error, if there is any.
+func (c *FakeMappers) Create(ctx context.Context, mapper *v1beta1.Mapper, opts v1.CreateOptions) (result *v1beta1.Mapper, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(mappersResource, c.ns, mapper), &v1beta1.Mapper{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Mapper), err
+}
+
+// Update takes the representation of a mapper and updates it. Returns the server's representation of the mapper, and an error, if there is any.
+func (c *FakeMappers) Update(ctx context.Context, mapper *v1beta1.Mapper, opts v1.UpdateOptions) (result *v1beta1.Mapper, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(mappersResource, c.ns, mapper), &v1beta1.Mapper{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Mapper), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeMappers) UpdateStatus(ctx context.Context, mapper *v1beta1.Mapper, opts v1.UpdateOptions) (*v1beta1.Mapper, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(mappersResource, "status", c.ns, mapper), &v1beta1.Mapper{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Mapper), err
+}
+
+// Delete takes name of the mapper and deletes it. Returns an error if one occurs.
+func (c *FakeMappers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(mappersResource, c.ns, name, opts), &v1beta1.Mapper{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeMappers) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(mappersResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.MapperList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched mapper.
+func (c *FakeMappers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Mapper, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(mappersResource, c.ns, name, pt, data, subresources...), &v1beta1.Mapper{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Mapper), err
+}
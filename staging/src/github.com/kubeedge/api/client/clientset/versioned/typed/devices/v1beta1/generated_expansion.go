@@ -20,4 +20,10 @@ package v1beta1
 
 type DeviceExpansion interface{}
 
+type DeviceGroupExpansion interface{}
+
 type DeviceModelExpansion interface{}
+
+type DiscoveredDeviceExpansion interface{}
+
+type MapperExpansion interface{}
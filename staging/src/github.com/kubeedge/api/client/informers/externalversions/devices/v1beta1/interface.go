@@ -26,8 +26,14 @@ import (
 type Interface interface {
 	// Devices returns a DeviceInformer.
 	Devices() DeviceInformer
+	// DeviceGroups returns a DeviceGroupInformer.
+	DeviceGroups() DeviceGroupInformer
 	// DeviceModels returns a DeviceModelInformer.
 	DeviceModels() DeviceModelInformer
+	// DiscoveredDevices returns a DiscoveredDeviceInformer.
+	DiscoveredDevices() DiscoveredDeviceInformer
+	// Mappers returns a MapperInformer.
+	Mappers() MapperInformer
 }
 
 type version struct {
@@ -46,7 +52,22 @@ func (v *version) Devices() DeviceInformer {
 	return &deviceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// DeviceGroups returns a DeviceGroupInformer.
+func (v *version) DeviceGroups() DeviceGroupInformer {
+	return &deviceGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // DeviceModels returns a DeviceModelInformer.
 func (v *version) DeviceModels() DeviceModelInformer {
 	return &deviceModelInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// DiscoveredDevices returns a DiscoveredDeviceInformer.
+func (v *version) DiscoveredDevices() DiscoveredDeviceInformer {
+	return &discoveredDeviceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Mappers returns a MapperInformer.
+func (v *version) Mappers() MapperInformer {
+	return &mapperInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
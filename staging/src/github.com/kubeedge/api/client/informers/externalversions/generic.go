@@ -67,8 +67,14 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=devices, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithResource("devices"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Devices().V1beta1().Devices().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("devicegroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Devices().V1beta1().DeviceGroups().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("devicemodels"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Devices().V1beta1().DeviceModels().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("discovereddevices"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Devices().V1beta1().DiscoveredDevices().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("mappers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Devices().V1beta1().Mappers().Informer()}, nil
 
 		// Group=operations, Version=v1alpha1
 	case operationsv1alpha1.SchemeGroupVersion.WithResource("imageprepulljobs"):
@@ -0,0 +1,99 @@
+/*
+Copyright The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/kubeedge/api/apis/devices/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DiscoveredDeviceLister helps list DiscoveredDevices.
+// All objects returned here must be treated as read-only.
+type DiscoveredDeviceLister interface {
+	// List lists all DiscoveredDevices in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.DiscoveredDevice, err error)
+	// DiscoveredDevices returns an object that can list and get DiscoveredDevices.
+	DiscoveredDevices(namespace string) DiscoveredDeviceNamespaceLister
+	DiscoveredDeviceListerExpansion
+}
+
+// discoveredDeviceLister implements the DiscoveredDeviceLister interface.
+type discoveredDeviceLister struct {
+	indexer cache.Indexer
+}
+
+// NewDiscoveredDeviceLister returns a new DiscoveredDeviceLister.
+func NewDiscoveredDeviceLister(indexer cache.Indexer) DiscoveredDeviceLister {
+	return &discoveredDeviceLister{indexer: indexer}
+}
+
+// List lists all DiscoveredDevices in the indexer.
+func (s *discoveredDeviceLister) List(selector labels.Selector) (ret []*v1beta1.DiscoveredDevice, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.DiscoveredDevice))
+	})
+	return ret, err
+}
+
+// DiscoveredDevices returns an object that can list and get DiscoveredDevices.
+func (s *discoveredDeviceLister) DiscoveredDevices(namespace string) DiscoveredDeviceNamespaceLister {
+	return discoveredDeviceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DiscoveredDeviceNamespaceLister helps list and get DiscoveredDevices.
+// All objects returned here must be treated as read-only.
+type DiscoveredDeviceNamespaceLister interface {
+	// List lists all DiscoveredDevices in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.DiscoveredDevice, err error)
+	// Get retrieves the DiscoveredDevice from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.DiscoveredDevice, error)
+	DiscoveredDeviceNamespaceListerExpansion
+}
+
+// discoveredDeviceNamespaceLister implements the DiscoveredDeviceNamespaceLister
+// interface.
+type discoveredDeviceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all DiscoveredDevices in the indexer for a given namespace.
+func (s discoveredDeviceNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.DiscoveredDevice, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.DiscoveredDevice))
+	})
+	return ret, err
+}
+
+// Get retrieves the DiscoveredDevice from the indexer for a given namespace and name.
+func (s discoveredDeviceNamespaceLister) Get(name string) (*v1beta1.DiscoveredDevice, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("discovereddevice"), name)
+	}
+	return obj.(*v1beta1.DiscoveredDevice), nil
+}
@@ -26,6 +26,14 @@ type DeviceListerExpansion interface{}
 // DeviceNamespaceLister.
 type DeviceNamespaceListerExpansion interface{}
 
+// DeviceGroupListerExpansion allows custom methods to be added to
+// DeviceGroupLister.
+type DeviceGroupListerExpansion interface{}
+
+// DeviceGroupNamespaceListerExpansion allows custom methods to be added to
+// DeviceGroupNamespaceLister.
+type DeviceGroupNamespaceListerExpansion interface{}
+
 // DeviceModelListerExpansion allows custom methods to be added to
 // DeviceModelLister.
 type DeviceModelListerExpansion interface{}
@@ -33,3 +41,19 @@ type DeviceModelListerExpansion interface{}
 // DeviceModelNamespaceListerExpansion allows custom methods to be added to
 // DeviceModelNamespaceLister.
 type DeviceModelNamespaceListerExpansion interface{}
+
+// DiscoveredDeviceListerExpansion allows custom methods to be added to
+// DiscoveredDeviceLister.
+type DiscoveredDeviceListerExpansion interface{}
+
+// DiscoveredDeviceNamespaceListerExpansion allows custom methods to be added to
+// DiscoveredDeviceNamespaceLister.
+type DiscoveredDeviceNamespaceListerExpansion interface{}
+
+// MapperListerExpansion allows custom methods to be added to
+// MapperLister.
+type MapperListerExpansion interface{}
+
+// MapperNamespaceListerExpansion allows custom methods to be added to
+// MapperNamespaceLister.
+type MapperNamespaceListerExpansion interface{}
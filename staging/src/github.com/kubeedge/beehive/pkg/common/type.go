@@ -1,5 +1,7 @@
 package common
 
+import "time"
+
 // define channel type
 const (
 	// MsgCtxTypeChannel message type channel
@@ -17,6 +19,8 @@ const (
 type ModuleInfo struct {
 	ModuleName string
 	ModuleType string
+	// QueueOptions customizes the message queue of the module, it's only used by the channel context.
+	QueueOptions QueueOptions
 	// the below field ModuleSocket is only required for using socket.
 	ModuleSocket
 }
@@ -25,3 +29,36 @@ type ModuleSocket struct {
 	IsRemote   bool
 	Connection interface{} // only for socket remote mode
 }
+
+// OverflowPolicy decides what to do with the message sent to a module whose queue is full
+type OverflowPolicy string
+
+const (
+	// OverflowBlock blocks the sender until the queue has room or the send deadline is exceeded
+	OverflowBlock OverflowPolicy = "Block"
+	// OverflowDropOldest drops the oldest message in the queue to make room for the message
+	OverflowDropOldest OverflowPolicy = "DropOldest"
+	// OverflowDropNewest drops the message being sent
+	OverflowDropNewest OverflowPolicy = "DropNewest"
+	// OverflowSpill writes the message to disk, the spilled messages are queued again
+	// in order when the queue has room, and the content of them is restored as the
+	// []byte returned by GetContentData.
+	OverflowSpill OverflowPolicy = "Spill"
+)
+
+// QueueOptions is the options of the message queue of a module
+type QueueOptions struct {
+	// Size is the capacity of the queue, defaults to 1024 if it's not positive
+	Size int
+	// OverflowPolicy defaults to OverflowBlock
+	OverflowPolicy OverflowPolicy
+	// SendTimeout is the deadline of blocking the sender with OverflowBlock,
+	// the sender is blocked until the queue has room if it's not positive
+	SendTimeout time.Duration
+	// SpillDir is the directory to spill the messages to with OverflowSpill,
+	// defaults to beehive/<module> under the temporary directory
+	SpillDir string
+}
+
+// QueueFullHandler is called when a message is sent to a module whose queue is full
+type QueueFullHandler func(module string, policy OverflowPolicy)
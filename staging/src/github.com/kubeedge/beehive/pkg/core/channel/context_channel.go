@@ -1,6 +1,7 @@
 package channel
 
 import (
+	gocontext "context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -25,6 +26,7 @@ const (
 type Context struct {
 	//ConfigFactory goarchaius.ConfigurationFactory
 	channels     map[string]chan model.Message
+	queues       map[string]*queue
 	chsLock      sync.RWMutex
 	typeChannels map[string]map[string]chan model.Message
 	typeChsLock  sync.RWMutex
@@ -43,6 +45,7 @@ func NewChannelContext() *Context {
 		anonChannels := make(map[string]chan model.Message)
 		channelContext = &Context{
 			channels:     channelMap,
+			queues:       make(map[string]*queue),
 			typeChannels: moduleChannels,
 			anonChannels: anonChannels,
 		}
@@ -53,6 +56,9 @@ func NewChannelContext() *Context {
 // Cleanup close modules
 func (ctx *Context) Cleanup(module string) {
 	if channel := ctx.getChannel(module); channel != nil {
+		if q := ctx.getQueue(module); q != nil {
+			q.close()
+		}
 		ctx.delChannel(module)
 		// decrease probable exception of channel closing
 		time.Sleep(20 * time.Millisecond)
@@ -60,21 +66,27 @@ func (ctx *Context) Cleanup(module string) {
 	}
 }
 
-// Send send msg to a module. Todo: do not stuck
+// Send send msg to a module, the overflow policy of the module applies if its queue is full
 func (ctx *Context) Send(module string, message model.Message) {
+	if err := ctx.SendWithContext(gocontext.Background(), module, message); err != nil {
+		klog.Warningf("Failed to send message: %v", err)
+	}
+}
+
+// SendWithContext sends msg to a module, the overflow policy of the module applies if its queue
+// is full, and the sender is blocked until the ctx is done at most with common.OverflowBlock.
+func (ctx *Context) SendWithContext(c gocontext.Context, module string, message model.Message) (err error) {
 	// avoid exception because of channel closing
-	// TODO: need reconstruction
 	defer func() {
 		if exception := recover(); exception != nil {
-			klog.Warningf("Recover when send message, exception: %+v", exception)
+			err = fmt.Errorf("module %s is cleaned up when send message %s: %v", module, message.GetID(), exception)
 		}
 	}()
 
-	if channel := ctx.getChannel(module); channel != nil {
-		channel <- message
-		return
+	if q := ctx.getQueue(module); q != nil {
+		return q.send(c, message)
 	}
-	klog.Warningf("Get bad module name :%s when send message, do nothing", module)
+	return fmt.Errorf("bad module name %s when send message %s", module, message.GetID())
 }
 
 // Receive msg from channel of module
@@ -164,26 +176,16 @@ func (ctx *Context) SendResp(message model.Message) {
 	klog.Warningf("Get bad anonName:%s when sendresp message, do nothing", anonName)
 }
 
-// SendToGroup send msg to modules. Todo: do not stuck
+// SendToGroup send msg to modules, the overflow policy of each module applies if its queue is full
 func (ctx *Context) SendToGroup(moduleType string, message model.Message) {
-	send := func(module string, ch chan model.Message) {
-		// avoid exception because of channel closing
-		// TODO: need reconstruction
-		defer func() {
-			if exception := recover(); exception != nil {
-				klog.Warningf("Recover when sendToGroup message, exception: %+v", exception)
-			}
-		}()
-		select {
-		case ch <- message:
-		default:
-			klog.Warningf("The module %s message channel is full, message: %+v", module, message)
-			ch <- message
+	send := func(module string) {
+		if err := ctx.SendWithContext(gocontext.Background(), module, message); err != nil {
+			klog.Warningf("Failed to sendToGroup message: %v", err)
 		}
 	}
 	if channelList := ctx.getTypeChannel(moduleType); channelList != nil {
-		for module, channel := range channelList {
-			go send(module, channel)
+		for module := range channelList {
+			go send(module)
 		}
 		return
 	}
@@ -281,10 +283,16 @@ func (ctx *Context) SendToGroupSync(moduleType string, message model.Message, ti
 	return cleanup()
 }
 
-// New Channel
-func (ctx *Context) newChannel() chan model.Message {
-	channel := make(chan model.Message, ChannelSizeDefault)
-	return channel
+// QueueLengths returns the number of messages waiting in the channel of each module
+func (ctx *Context) QueueLengths() map[string]int {
+	ctx.chsLock.RLock()
+	defer ctx.chsLock.RUnlock()
+
+	lengths := make(map[string]int, len(ctx.channels))
+	for module, channel := range ctx.channels {
+		lengths[module] = len(channel)
+	}
+	return lengths
 }
 
 // getChannel return chan
@@ -300,12 +308,21 @@ func (ctx *Context) getChannel(module string) chan model.Message {
 	return nil
 }
 
-// addChannel return chan
-func (ctx *Context) addChannel(module string, moduleCh chan model.Message) {
+// getQueue return the queue of module
+func (ctx *Context) getQueue(module string) *queue {
+	ctx.chsLock.RLock()
+	defer ctx.chsLock.RUnlock()
+
+	return ctx.queues[module]
+}
+
+// addChannel adds the queue of module
+func (ctx *Context) addChannel(module string, q *queue) {
 	ctx.chsLock.Lock()
 	defer ctx.chsLock.Unlock()
 
-	ctx.channels[module] = moduleCh
+	ctx.channels[module] = q.ch
+	ctx.queues[module] = q
 }
 
 // deleteChannel by module name
@@ -318,6 +335,7 @@ func (ctx *Context) delChannel(module string) {
 		return
 	}
 	delete(ctx.channels, module)
+	delete(ctx.queues, module)
 	ctx.chsLock.Unlock()
 
 	// delete module channel from typechannels map
@@ -371,8 +389,7 @@ func (ctx *Context) addTypeChannel(module, group string, moduleCh chan model.Mes
 
 // AddModule adds module into module context
 func (ctx *Context) AddModule(info *common.ModuleInfo) {
-	channel := ctx.newChannel()
-	ctx.addChannel(info.ModuleName, channel)
+	ctx.addChannel(info.ModuleName, newQueue(info.ModuleName, info.QueueOptions))
 }
 
 // AddModuleGroup adds modules into module context group
@@ -0,0 +1,283 @@
+package channel
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/beehive/pkg/common"
+	"github.com/kubeedge/beehive/pkg/core/model"
+)
+
+var (
+	queueFullHandlers    []common.QueueFullHandler
+	queueFullHandlerLock sync.RWMutex
+)
+
+// OnQueueFull registers the handler called when a message is sent to a module whose queue is full
+func OnQueueFull(handler common.QueueFullHandler) {
+	queueFullHandlerLock.Lock()
+	defer queueFullHandlerLock.Unlock()
+	queueFullHandlers = append(queueFullHandlers, handler)
+}
+
+func reportQueueFull(module string, policy common.OverflowPolicy) {
+	queueFullHandlerLock.RLock()
+	defer queueFullHandlerLock.RUnlock()
+	for _, handler := range queueFullHandlers {
+		handler(module, policy)
+	}
+}
+
+// queue is the message queue of a module, which applies the overflow policy of the module
+type queue struct {
+	module  string
+	ch      chan model.Message
+	options common.QueueOptions
+	// spill is only set with common.OverflowSpill
+	spill *spiller
+}
+
+func newQueue(module string, options common.QueueOptions) *queue {
+	if options.Size <= 0 {
+		options.Size = ChannelSizeDefault
+	}
+	if options.OverflowPolicy == "" {
+		options.OverflowPolicy = common.OverflowBlock
+	}
+	q := &queue{
+		module:  module,
+		ch:      make(chan model.Message, options.Size),
+		options: options,
+	}
+	if options.OverflowPolicy == common.OverflowSpill {
+		dir := options.SpillDir
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "beehive", module)
+		}
+		spill, err := newSpiller(dir, q.ch)
+		if err != nil {
+			klog.Errorf("failed to spill messages of module %s to %s, block the sender when the queue is full: %v", module, dir, err)
+			q.options.OverflowPolicy = common.OverflowBlock
+		} else {
+			q.spill = spill
+		}
+	}
+	return q
+}
+
+// send sends the message to the queue, ctx is only used with common.OverflowBlock
+func (q *queue) send(ctx gocontext.Context, message model.Message) error {
+	// keep the order of the messages while there are spilled ones
+	if q.spill != nil && q.spill.pending() {
+		return q.spill.write(message)
+	}
+
+	select {
+	case q.ch <- message:
+		return nil
+	default:
+	}
+
+	reportQueueFull(q.module, q.options.OverflowPolicy)
+	switch q.options.OverflowPolicy {
+	case common.OverflowDropNewest:
+		return fmt.Errorf("queue of module %s is full, drop message %s", q.module, message.GetID())
+	case common.OverflowDropOldest:
+		for {
+			select {
+			case q.ch <- message:
+				return nil
+			default:
+			}
+			select {
+			case dropped := <-q.ch:
+				klog.Warningf("queue of module %s is full, drop the oldest message %s", q.module, dropped.GetID())
+			default:
+			}
+		}
+	case common.OverflowSpill:
+		return q.spill.write(message)
+	default:
+		if q.options.SendTimeout > 0 {
+			var cancel gocontext.CancelFunc
+			ctx, cancel = gocontext.WithTimeout(ctx, q.options.SendTimeout)
+			defer cancel()
+		}
+		select {
+		case q.ch <- message:
+			return nil
+		case <-ctx.Done():
+			return fmt.Errorf("queue of module %s is full, failed to send message %s: %v", q.module, message.GetID(), ctx.Err())
+		}
+	}
+}
+
+// close stops queuing the spilled messages, the spilled messages which are
+// not queued yet are kept on disk and queued again when the module is added
+func (q *queue) close() {
+	if q.spill != nil {
+		q.spill.stop()
+	}
+}
+
+// spilledMessage is the message written to disk
+type spilledMessage struct {
+	Header  model.MessageHeader `json:"header"`
+	Router  model.MessageRoute  `json:"route,omitempty"`
+	Content []byte              `json:"content,omitempty"`
+}
+
+// spiller writes the messages to the files named by their sequence in dir,
+// and moves them back to the queue in order when the queue has room
+type spiller struct {
+	dir string
+	ch  chan model.Message
+
+	lock sync.Mutex
+	// the sequence of the next message to write and to read
+	writeSeq, readSeq uint64
+
+	wake    chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+}
+
+func newSpiller(dir string, ch chan model.Message) (*spiller, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	// continue with the messages spilled before the module was cleaned up
+	var seqs []uint64
+	for _, entry := range entries {
+		seq, err := strconv.ParseUint(strings.TrimSuffix(entry.Name(), ".json"), 10, 64)
+		if err == nil && strings.HasSuffix(entry.Name(), ".json") {
+			seqs = append(seqs, seq)
+		}
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	s := &spiller{
+		dir:     dir,
+		ch:      ch,
+		wake:    make(chan struct{}, 1),
+		stopped: make(chan struct{}),
+	}
+	if len(seqs) > 0 {
+		s.readSeq, s.writeSeq = seqs[0], seqs[len(seqs)-1]+1
+		s.wake <- struct{}{}
+	}
+	go s.run()
+	return s, nil
+}
+
+func (s *spiller) file(seq uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d.json", seq))
+}
+
+func (s *spiller) pending() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.readSeq != s.writeSeq
+}
+
+func (s *spiller) write(message model.Message) error {
+	content, err := message.GetContentData()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(spilledMessage{Header: message.Header, Router: message.Router, Content: content})
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := os.WriteFile(s.file(s.writeSeq), data, 0600); err != nil {
+		return fmt.Errorf("failed to spill message %s: %v", message.GetID(), err)
+	}
+	s.writeSeq++
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (s *spiller) run() {
+	// avoid exception because of channel closing
+	defer func() {
+		if exception := recover(); exception != nil {
+			klog.Warningf("Recover when queue spilled message, exception: %+v", exception)
+		}
+	}()
+
+	for {
+		select {
+		case <-s.stopped:
+			return
+		case <-s.wake:
+		}
+		for s.pending() {
+			if !s.queueNext() {
+				return
+			}
+		}
+	}
+}
+
+// queueNext moves the oldest spilled message to the queue, it returns false if the spiller is stopped
+func (s *spiller) queueNext() bool {
+	select {
+	case <-s.stopped:
+		return false
+	default:
+	}
+
+	s.lock.Lock()
+	seq := s.readSeq
+	s.lock.Unlock()
+
+	data, err := os.ReadFile(s.file(seq))
+	var spilled spilledMessage
+	if err == nil {
+		err = json.Unmarshal(data, &spilled)
+	}
+	if err != nil {
+		klog.Errorf("failed to read spilled message %s, skip it: %v", s.file(seq), err)
+	} else {
+		message := model.Message{Header: spilled.Header, Router: spilled.Router, Content: spilled.Content}
+		select {
+		case s.ch <- message:
+		case <-s.stopped:
+			return false
+		}
+	}
+
+	if err := os.Remove(s.file(seq)); err != nil && !os.IsNotExist(err) {
+		klog.Errorf("failed to remove spilled message %s: %v", s.file(seq), err)
+	}
+	s.lock.Lock()
+	s.readSeq++
+	s.lock.Unlock()
+	return true
+}
+
+func (s *spiller) stop() {
+	s.once.Do(func() {
+		close(s.stopped)
+	})
+}
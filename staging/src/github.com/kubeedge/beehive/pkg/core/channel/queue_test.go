@@ -0,0 +1,149 @@
+package channel
+
+import (
+	gocontext "context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kubeedge/beehive/pkg/common"
+	"github.com/kubeedge/beehive/pkg/core/model"
+)
+
+func newTestMessage(i int) model.Message {
+	return *model.NewMessage("").BuildRouter("source", "group", "resource", fmt.Sprintf("op%d", i)).FillBody([]byte("content"))
+}
+
+func drain(q *queue) []string {
+	var operations []string
+	for {
+		select {
+		case message := <-q.ch:
+			operations = append(operations, message.GetOperation())
+		default:
+			return operations
+		}
+	}
+}
+
+func TestQueueOverflowPolicies(t *testing.T) {
+	var full []common.OverflowPolicy
+	OnQueueFull(func(module string, policy common.OverflowPolicy) {
+		if module == "queuetest" {
+			full = append(full, policy)
+		}
+	})
+
+	cases := []struct {
+		policy   common.OverflowPolicy
+		expected []string
+		errors   int
+	}{
+		{
+			policy:   common.OverflowDropNewest,
+			expected: []string{"op0", "op1"},
+			errors:   1,
+		},
+		{
+			policy:   common.OverflowDropOldest,
+			expected: []string{"op1", "op2"},
+		},
+		{
+			policy:   common.OverflowBlock,
+			expected: []string{"op0", "op1"},
+			errors:   1,
+		},
+	}
+	for _, c := range cases {
+		t.Run(string(c.policy), func(t *testing.T) {
+			full = nil
+			q := newQueue("queuetest", common.QueueOptions{
+				Size:           2,
+				OverflowPolicy: c.policy,
+				SendTimeout:    10 * time.Millisecond,
+			})
+			var errors int
+			for i := 0; i < 3; i++ {
+				if err := q.send(gocontext.Background(), newTestMessage(i)); err != nil {
+					errors++
+				}
+			}
+			if errors != c.errors {
+				t.Errorf("got %d errors, expected %d", errors, c.errors)
+			}
+			if operations := drain(q); !reflect.DeepEqual(operations, c.expected) {
+				t.Errorf("queued messages %v, expected %v", operations, c.expected)
+			}
+			if !reflect.DeepEqual(full, []common.OverflowPolicy{c.policy}) {
+				t.Errorf("queue full events %v, expected one of %s", full, c.policy)
+			}
+		})
+	}
+}
+
+func TestQueueSendWithContext(t *testing.T) {
+	q := newQueue("queuetest", common.QueueOptions{Size: 1})
+	if err := q.send(gocontext.Background(), newTestMessage(0)); err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := q.send(ctx, newTestMessage(1)); err == nil {
+		t.Errorf("expected the send to the full queue to fail when the context is done")
+	}
+}
+
+func TestQueueSpill(t *testing.T) {
+	options := common.QueueOptions{
+		Size:           2,
+		OverflowPolicy: common.OverflowSpill,
+		SpillDir:       t.TempDir(),
+	}
+	sendAll := func(q *queue) {
+		for i := 0; i < 5; i++ {
+			if err := q.send(gocontext.Background(), newTestMessage(i)); err != nil {
+				t.Fatalf("failed to send message: %v", err)
+			}
+		}
+	}
+	receive := func(q *queue, n int) []string {
+		var operations []string
+		for len(operations) < n {
+			select {
+			case message := <-q.ch:
+				operations = append(operations, message.GetOperation())
+				if content, _ := message.GetContentData(); string(content) != "content" {
+					t.Errorf("content of message %s is %q", message.GetOperation(), content)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("timeout to receive the spilled messages, received %v", operations)
+			}
+		}
+		return operations
+	}
+
+	q := newQueue("queuetest", options)
+	sendAll(q)
+	expected := []string{"op0", "op1", "op2", "op3", "op4"}
+	if operations := receive(q, 5); !reflect.DeepEqual(operations, expected) {
+		t.Errorf("received messages %v, expected %v", operations, expected)
+	}
+	q.close()
+
+	// the spilled messages left on disk are queued by the queue of the module added again
+	q = newQueue("queuetest", options)
+	sendAll(q)
+	q.close()
+	// wait for the spilled messages to stop being queued, as Cleanup does
+	time.Sleep(20 * time.Millisecond)
+	if operations := drain(q); !reflect.DeepEqual(operations, expected[:2]) {
+		t.Errorf("queued messages %v, expected %v", operations, expected[:2])
+	}
+	q = newQueue("queuetest", options)
+	defer q.close()
+	if operations := receive(q, 3); !reflect.DeepEqual(operations, expected[2:]) {
+		t.Errorf("received messages %v, expected %v", operations, expected[2:])
+	}
+}
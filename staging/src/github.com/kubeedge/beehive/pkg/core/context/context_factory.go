@@ -56,18 +56,24 @@ func InitContext(contextTypes []string) {
 		switch contextType {
 		case common.MsgCtxTypeChannel:
 			channelContext := channel.NewChannelContext()
-			globalContext.moduleContext[contextType] = channelContext
-			globalContext.messageContext[contextType] = channelContext
+			setContext(contextType, channelContext, channelContext)
 		case common.MsgCtxTypeUS:
 			socketContext := socket.InitSocketContext()
-			globalContext.moduleContext[contextType] = socketContext
-			globalContext.messageContext[contextType] = socketContext
+			setContext(contextType, socketContext, socketContext)
 		default:
 			klog.Exitf("unsupported context type: %s", contextType)
 		}
 	}
 }
 
+func setContext(contextType string, moduleContext ModuleContext, messageContext MessageContext) {
+	globalContext.ctxLock.Lock()
+	defer globalContext.ctxLock.Unlock()
+
+	globalContext.moduleContext[contextType] = moduleContext
+	globalContext.messageContext[contextType] = messageContext
+}
+
 func GetContext() gocontext.Context {
 	return globalContext.ctx
 }
@@ -124,10 +130,41 @@ func Send(module string, message model.Message) {
 	if err != nil {
 		return
 	}
+	if err := intercept(DirectionSend, module, &message); err != nil {
+		klog.Warningf("failed to send message to module %s: %v", module, err)
+		return
+	}
 
 	messageContext.Send(module, message)
 }
 
+// SendWithContext sends the message, the sender is blocked until the ctx is done at most
+// if the queue of the module is full, and the error is returned if the message is not sent.
+// The message contexts which don't support it send the message by Send.
+func SendWithContext(ctx gocontext.Context, module string, message model.Message) error {
+	messageContext, err := getMessageContext(module)
+	if err != nil {
+		return err
+	}
+	if err := intercept(DirectionSend, module, &message); err != nil {
+		return err
+	}
+
+	sender, ok := messageContext.(interface {
+		SendWithContext(ctx gocontext.Context, module string, message model.Message) error
+	})
+	if !ok {
+		messageContext.Send(module, message)
+		return nil
+	}
+	return sender.SendWithContext(ctx, module, message)
+}
+
+// OnQueueFull registers the handler called when a message is sent to a module whose queue is full
+func OnQueueFull(handler common.QueueFullHandler) {
+	channel.OnQueueFull(handler)
+}
+
 // Receive the message
 // module : local module name
 func Receive(module string) (model.Message, error) {
@@ -136,7 +173,17 @@ func Receive(module string) (model.Message, error) {
 		return model.Message{}, err
 	}
 
-	return messageContext.Receive(module)
+	for {
+		message, err := messageContext.Receive(module)
+		if err != nil {
+			return message, err
+		}
+		if err := intercept(DirectionReceive, module, &message); err != nil {
+			klog.Warningf("drop the message received by module %s: %v", module, err)
+			continue
+		}
+		return message, nil
+	}
 }
 
 // SendSync sends message in sync mode
@@ -148,8 +195,18 @@ func SendSync(module string,
 	if err != nil {
 		return model.Message{}, err
 	}
+	if err := intercept(DirectionSend, module, &message); err != nil {
+		return model.Message{}, err
+	}
 
-	return messageContext.SendSync(module, message, timeout)
+	resp, err := messageContext.SendSync(module, message, timeout)
+	if err != nil {
+		return resp, err
+	}
+	if err := intercept(DirectionReceive, message.GetSource(), &resp); err != nil {
+		return model.Message{}, err
+	}
+	return resp, nil
 }
 
 // SendResp sends response
@@ -160,6 +217,10 @@ func SendResp(resp model.Message) {
 		klog.Errorf("message context for module doesn't exist, module name: %s", resp.GetSource())
 		return
 	}
+	if err := intercept(DirectionSend, "", &resp); err != nil {
+		klog.Warningf("failed to send response: %v", err)
+		return
+	}
 
 	messageContext.SendResp(resp)
 }
@@ -171,6 +232,10 @@ func SendToGroup(group string, message model.Message) {
 		klog.Errorf("message context for group doesn't exist, group name: %s", group)
 		return
 	}
+	if err := intercept(DirectionSend, group, &message); err != nil {
+		klog.Warningf("failed to send message to group %s: %v", group, err)
+		return
+	}
 
 	messageContext.SendToGroup(group, message)
 }
@@ -181,10 +246,32 @@ func SendToGroupSync(group string, message model.Message, timeout time.Duration)
 	if err != nil {
 		return fmt.Errorf("message context for group doesn't exist, group name: %s", group)
 	}
+	if err := intercept(DirectionSend, group, &message); err != nil {
+		return err
+	}
 
 	return messageContext.SendToGroupSync(group, message, timeout)
 }
 
+// QueueLengths returns the number of messages waiting to be received by each module,
+// modules of the contexts which don't queue messages are not included
+func QueueLengths() map[string]int {
+	globalContext.ctxLock.RLock()
+	defer globalContext.ctxLock.RUnlock()
+
+	lengths := make(map[string]int)
+	for _, moduleContext := range globalContext.moduleContext {
+		queue, ok := moduleContext.(interface{ QueueLengths() map[string]int })
+		if !ok {
+			continue
+		}
+		for module, length := range queue.QueueLengths() {
+			lengths[module] = length
+		}
+	}
+	return lengths
+}
+
 func getModuleContext(moduleName string) (ModuleContext, error) {
 	globalContext.ctxLock.RLock()
 	defer globalContext.ctxLock.RUnlock()
@@ -0,0 +1,108 @@
+package context
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+)
+
+// Direction indicates whether the intercepted message is being sent or received
+type Direction string
+
+const (
+	// DirectionSend is the direction of the messages sent by Send, SendSync, SendResp,
+	// SendToGroup and SendToGroupSync
+	DirectionSend Direction = "send"
+	// DirectionReceive is the direction of the messages returned by Receive and
+	// the responses returned by SendSync
+	DirectionReceive Direction = "receive"
+)
+
+// Interceptor intercepts the messages going through the beehive context,
+// whichever context type (channel or unixsocket) delivers them.
+type Interceptor interface {
+	// Name identifies the interceptor, registering an interceptor with the same name replaces it
+	Name() string
+	// Intercept is called with the module or group the message is sent to or received by,
+	// which is empty for the responses sent by SendResp. The interceptor may observe or
+	// mutate the message, returning an error rejects the message and the rest of
+	// the interceptors are skipped.
+	Intercept(direction Direction, target string, message *model.Message) error
+}
+
+// InterceptorFunc adapts a function to the Interceptor interface
+type InterceptorFunc struct {
+	InterceptorName string
+	Func            func(direction Direction, target string, message *model.Message) error
+}
+
+// Name returns the interceptor name
+func (f InterceptorFunc) Name() string {
+	return f.InterceptorName
+}
+
+// Intercept calls the function
+func (f InterceptorFunc) Intercept(direction Direction, target string, message *model.Message) error {
+	return f.Func(direction, target, message)
+}
+
+type registeredInterceptor struct {
+	interceptor Interceptor
+	priority    int
+}
+
+var (
+	// sorted by priority in descending order, the slice is replaced instead of
+	// being modified, so it can be iterated without holding the lock
+	interceptors    []registeredInterceptor
+	interceptorLock sync.RWMutex
+)
+
+// RegisterInterceptor registers the interceptor of the messages, interceptors with a higher
+// priority run first, and those with the same priority run in the order of registration.
+func RegisterInterceptor(interceptor Interceptor, priority int) {
+	interceptorLock.Lock()
+	defer interceptorLock.Unlock()
+
+	registered := make([]registeredInterceptor, 0, len(interceptors)+1)
+	for _, r := range interceptors {
+		if r.interceptor.Name() != interceptor.Name() {
+			registered = append(registered, r)
+		}
+	}
+	registered = append(registered, registeredInterceptor{interceptor: interceptor, priority: priority})
+	sort.SliceStable(registered, func(i, j int) bool {
+		return registered[i].priority > registered[j].priority
+	})
+	interceptors = registered
+}
+
+// UnregisterInterceptor removes the interceptor of name
+func UnregisterInterceptor(name string) {
+	interceptorLock.Lock()
+	defer interceptorLock.Unlock()
+
+	registered := make([]registeredInterceptor, 0, len(interceptors))
+	for _, r := range interceptors {
+		if r.interceptor.Name() != name {
+			registered = append(registered, r)
+		}
+	}
+	interceptors = registered
+}
+
+// intercept runs the message through the interceptors
+func intercept(direction Direction, target string, message *model.Message) error {
+	interceptorLock.RLock()
+	registered := interceptors
+	interceptorLock.RUnlock()
+
+	for _, r := range registered {
+		if err := r.interceptor.Intercept(direction, target, message); err != nil {
+			return fmt.Errorf("message %s is rejected by interceptor %s: %v", message.GetID(), r.interceptor.Name(), err)
+		}
+	}
+	return nil
+}
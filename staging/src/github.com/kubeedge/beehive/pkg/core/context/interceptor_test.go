@@ -0,0 +1,80 @@
+package context
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kubeedge/beehive/pkg/common"
+	"github.com/kubeedge/beehive/pkg/core/model"
+)
+
+func TestInterceptors(t *testing.T) {
+	const module = "interceptortest"
+	InitContext([]string{common.MsgCtxTypeChannel})
+	AddModule(&common.ModuleInfo{
+		ModuleName: module,
+		ModuleType: common.MsgCtxTypeChannel,
+	})
+	defer Cleanup(module)
+
+	var calls []string
+	record := func(name string) InterceptorFunc {
+		return InterceptorFunc{
+			InterceptorName: name,
+			Func: func(direction Direction, target string, message *model.Message) error {
+				calls = append(calls, name+" "+string(direction)+" "+target)
+				return nil
+			},
+		}
+	}
+	RegisterInterceptor(record("low"), 1)
+	RegisterInterceptor(record("high"), 10)
+	RegisterInterceptor(InterceptorFunc{
+		InterceptorName: "filter",
+		Func: func(direction Direction, target string, message *model.Message) error {
+			if message.GetOperation() == "reject-"+string(direction) {
+				return errors.New("rejected")
+			}
+			message.Router.Resource = "intercepted"
+			return nil
+		},
+	}, 5)
+	defer func() {
+		for _, name := range []string{"low", "high", "filter"} {
+			UnregisterInterceptor(name)
+		}
+	}()
+
+	Send(module, *model.NewMessage("").SetResourceOperation("resource", "reject-send"))
+	if lengths := QueueLengths(); lengths[module] != 0 {
+		t.Errorf("the message rejected on send is delivered, queue length is %d", lengths[module])
+	}
+	expected := []string{"high send " + module}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("interceptors called %v, expected %v", calls, expected)
+	}
+
+	calls = nil
+	Send(module, *model.NewMessage("").SetResourceOperation("resource", "reject-receive"))
+	Send(module, *model.NewMessage("").SetResourceOperation("resource", "accept"))
+	message, err := Receive(module)
+	if err != nil {
+		t.Fatalf("failed to receive message: %v", err)
+	}
+	if message.GetOperation() != "accept" {
+		t.Errorf("received message %s, the message rejected on receive is not dropped", message.GetOperation())
+	}
+	if message.GetResource() != "intercepted" {
+		t.Errorf("the message is not mutated by the interceptor, resource is %s", message.GetResource())
+	}
+	expected = []string{
+		"high send " + module, "low send " + module,
+		"high send " + module, "low send " + module,
+		"high receive " + module,
+		"high receive " + module, "low receive " + module,
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("interceptors called %v, expected %v", calls, expected)
+	}
+}
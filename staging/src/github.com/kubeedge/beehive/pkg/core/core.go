@@ -18,6 +18,9 @@ func StartModules() {
 	beehiveContext.InitContext([]string{common.MsgCtxTypeChannel})
 
 	modules := GetModules()
+	if err := checkDependencies(); err != nil {
+		klog.Exitf("failed to start modules: %v", err)
+	}
 
 	for name, module := range modules {
 		var m common.ModuleInfo
@@ -27,6 +30,9 @@ func StartModules() {
 				ModuleName: name,
 				ModuleType: module.contextType,
 			}
+			if queueModule, ok := module.module.(QueueModule); ok {
+				m.QueueOptions = queueModule.QueueOptions()
+			}
 		case common.MsgCtxTypeUS:
 			m = common.ModuleInfo{
 				ModuleName: name,
@@ -43,13 +49,19 @@ func StartModules() {
 		beehiveContext.AddModule(&m)
 		beehiveContext.AddModuleGroup(name, module.module.Group())
 
-		if module.remote {
-			go moduleKeeper(name, module, m)
-		} else {
-			go localModuleKeeper(module)
-		}
-
-		klog.Infof("starting module %s", name)
+		go func(name string, module *ModuleInfo, m common.ModuleInfo) {
+			// the messages sent to the module are queued until it starts
+			if !module.waitForDependencies() {
+				return
+			}
+			klog.Infof("starting module %s", name)
+			module.started.Store(true)
+			if module.remote {
+				moduleKeeper(name, module, m)
+			} else {
+				localModuleKeeper(module)
+			}
+		}(name, module, m)
 	}
 }
 
@@ -91,13 +103,15 @@ func moduleKeeper(name string, moduleInfo *ModuleInfo, m common.ModuleInfo) {
 	}
 }
 
-// localModuleKeeper starts and tries to keep module running when module exited.
+// localModuleKeeper starts and tries to keep module running when module exited,
+// or when it fails the liveness probes if it implements LivenessProbe and StoppableModule.
 // Call EnableModuleRestart() to enable auto-restarting feature in alpha version.
 func localModuleKeeper(m *ModuleInfo) {
 	if !moduleRestartEnabled {
 		m.module.Start()
 		return
 	}
+	go m.probeLiveness()
 
 	ctx := beehiveContext.GetContext()
 	backoffDuration := time.Second
@@ -82,6 +82,10 @@ type MessageHeader struct {
 	// message type indicates the context type that delivers the message, such as channel, unixsocket, etc.
 	// if the value is empty, the channel context type will be used.
 	MessageType string `json:"type,omitempty"`
+	// the W3C trace context of the message, see https://www.w3.org/TR/trace-context/.
+	// it's set by the sender and kept in the response, so the message can be traced across modules.
+	TraceParent string `json:"traceparent,omitempty"`
+	TraceState  string `json:"tracestate,omitempty"`
 }
 
 // BuildRouter sets route and resource operation in message
@@ -133,6 +137,13 @@ func (msg *Message) SetResourceVersion(resourceVersion string) *Message {
 	return msg
 }
 
+// SetTraceContext sets the W3C trace context in message header
+func (msg *Message) SetTraceContext(traceParent, traceState string) *Message {
+	msg.Header.TraceParent = traceParent
+	msg.Header.TraceState = traceState
+	return msg
+}
+
 // IsSync : msg.Header.Sync will be set in sendsync
 func (msg *Message) IsSync() bool {
 	return msg.Header.Sync
@@ -200,6 +211,16 @@ func (msg *Message) GetResourceVersion() string {
 	return msg.Header.ResourceVersion
 }
 
+// GetTraceParent returns the traceparent of the message trace context
+func (msg *Message) GetTraceParent() string {
+	return msg.Header.TraceParent
+}
+
+// GetTraceState returns the tracestate of the message trace context
+func (msg *Message) GetTraceState() string {
+	return msg.Header.TraceState
+}
+
 // UpdateID returns message object updating its ID
 func (msg *Message) UpdateID() *Message {
 	msg.Header.ID = uuid.New().String()
@@ -242,6 +263,7 @@ func (msg *Message) Clone(message *Message) *Message {
 	msgID := uuid.New().String()
 	return NewRawMessage().BuildHeader(msgID, message.GetParentID(), message.GetTimestamp()).
 		BuildRouter(message.GetSource(), message.GetGroup(), message.GetResource(), message.GetOperation()).
+		SetTraceContext(message.GetTraceParent(), message.GetTraceState()).
 		FillBody(message.GetContent())
 }
 
@@ -250,6 +272,7 @@ func (msg *Message) NewRespByMessage(message *Message, content interface{}) *Mes
 	return NewMessage(message.GetID()).SetRoute(message.GetSource(), message.GetGroup()).
 		SetResourceOperation(message.GetResource(), ResponseOperation).
 		SetType(message.GetType()).
+		SetTraceContext(message.GetTraceParent(), message.GetTraceState()).
 		FillBody(content)
 }
 
@@ -257,6 +280,7 @@ func (msg *Message) NewRespByMessage(message *Message, content interface{}) *Mes
 func NewErrorMessage(message *Message, errContent string) *Message {
 	return NewMessage(message.GetID()).
 		SetResourceOperation(message.Router.Resource, ResponseErrorOperation).
+		SetTraceContext(message.GetTraceParent(), message.GetTraceState()).
 		FillBody(errContent)
 }
 
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"sync/atomic"
+
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/beehive/pkg/common"
@@ -15,6 +17,37 @@ type Module interface {
 	Enable() bool
 }
 
+// QueueModule is implemented by the modules which customize their message queue,
+// such as the queue size and what to do with the messages when the queue is full
+type QueueModule interface {
+	QueueOptions() common.QueueOptions
+}
+
+// DependentModule is implemented by the modules which start after the modules they depend on are ready
+type DependentModule interface {
+	// Dependencies returns the names of the modules it depends on, the disabled ones are ignored
+	Dependencies() []string
+}
+
+// ReadinessProbe is implemented by the modules which are not ready as soon as they start
+type ReadinessProbe interface {
+	// Ready returns nil if the module is ready to serve
+	Ready() error
+}
+
+// LivenessProbe is implemented by the modules which can tell whether they are wedged while running
+type LivenessProbe interface {
+	// Healthy returns an error if the module is running but not working
+	Healthy() error
+}
+
+// StoppableModule is implemented by the modules which can be stopped, so the module can be
+// restarted when it fails the liveness probes
+type StoppableModule interface {
+	// Stop makes Start return, the module must be able to Start again after it's stopped
+	Stop()
+}
+
 var (
 	// Modules map
 	modules         map[string]*ModuleInfo
@@ -33,6 +66,8 @@ type ModuleInfo struct {
 	contextType string
 	remote      bool
 	module      Module
+	// whether the module is started after its dependencies are ready
+	started atomic.Bool
 }
 
 // Register register module
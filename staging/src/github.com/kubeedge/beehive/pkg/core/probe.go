@@ -0,0 +1,191 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+)
+
+var (
+	// DependencyPollPeriod is how often the readiness of the dependencies is checked before a module starts
+	DependencyPollPeriod = time.Second
+	// LivenessProbePeriod is how often the liveness of the modules is probed
+	LivenessProbePeriod = 10 * time.Second
+	// LivenessFailureThreshold is the number of consecutive failed liveness probes to stop a module
+	LivenessFailureThreshold = 3
+)
+
+var errNotStarted = errors.New("module is not started")
+
+// Readiness returns the readiness of the modules registered, the error of a module is nil if it's ready.
+// A module is ready once it starts unless it implements ReadinessProbe.
+func Readiness() map[string]error {
+	readiness := make(map[string]error, len(modules))
+	for name, m := range modules {
+		readiness[name] = m.ready()
+	}
+	return readiness
+}
+
+// Health returns the liveness of the modules registered, the error of a module is nil if it's healthy.
+// A module is healthy unless it implements LivenessProbe.
+func Health() map[string]error {
+	health := make(map[string]error, len(modules))
+	for name, m := range modules {
+		health[name] = m.healthy()
+	}
+	return health
+}
+
+func (m *ModuleInfo) ready() error {
+	if !m.started.Load() {
+		return errNotStarted
+	}
+	if probe, ok := m.module.(ReadinessProbe); ok {
+		return probe.Ready()
+	}
+	return nil
+}
+
+func (m *ModuleInfo) healthy() error {
+	if !m.started.Load() {
+		return nil
+	}
+	if probe, ok := m.module.(LivenessProbe); ok {
+		return probe.Healthy()
+	}
+	return nil
+}
+
+// dependencies returns the enabled modules which the module depends on
+func (m *ModuleInfo) dependencies() []string {
+	dependent, ok := m.module.(DependentModule)
+	if !ok {
+		return nil
+	}
+	var dependencies []string
+	for _, name := range dependent.Dependencies() {
+		if _, ok := modules[name]; !ok {
+			klog.Warningf("module %s depends on module %s which is not enabled, ignore it", m.module.Name(), name)
+			continue
+		}
+		dependencies = append(dependencies, name)
+	}
+	return dependencies
+}
+
+// checkDependencies returns an error if there is a dependency cycle among the modules
+func checkDependencies() error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	states := make(map[string]int, len(modules))
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch states[name] {
+		case visiting:
+			return fmt.Errorf("dependency cycle among modules: %s", strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+		states[name] = visiting
+		for _, dependency := range modules[name].dependencies() {
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+		states[name] = visited
+		return nil
+	}
+
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForDependencies waits for the dependencies of the module to be ready,
+// it returns false if the beehive context is done before that.
+func (m *ModuleInfo) waitForDependencies() bool {
+	dependencies := m.dependencies()
+	if len(dependencies) == 0 {
+		return true
+	}
+	klog.Infof("module %s waits for modules %v to be ready", m.module.Name(), dependencies)
+
+	ticker := time.NewTicker(DependencyPollPeriod)
+	defer ticker.Stop()
+	for {
+		ready := true
+		for _, dependency := range dependencies {
+			if err := modules[dependency].ready(); err != nil {
+				klog.V(4).Infof("module %s waits for module %s: %v", m.module.Name(), dependency, err)
+				ready = false
+				break
+			}
+		}
+		if ready {
+			return true
+		}
+
+		select {
+		case <-beehiveContext.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// probeLiveness stops the module when it fails LivenessFailureThreshold consecutive liveness probes,
+// so the module keeper restarts it.
+func (m *ModuleInfo) probeLiveness() {
+	probe, ok := m.module.(LivenessProbe)
+	if !ok {
+		return
+	}
+
+	ticker := time.NewTicker(LivenessProbePeriod)
+	defer ticker.Stop()
+	var failures int
+	for {
+		select {
+		case <-beehiveContext.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := probe.Healthy()
+		if err == nil {
+			failures = 0
+			continue
+		}
+		failures++
+		klog.Warningf("module %s failed the liveness probe %d times: %v", m.module.Name(), failures, err)
+		if failures < LivenessFailureThreshold {
+			continue
+		}
+
+		failures = 0
+		stoppable, ok := m.module.(StoppableModule)
+		if !ok {
+			klog.Errorf("module %s is unhealthy but can't be stopped to restart", m.module.Name())
+			continue
+		}
+		klog.Errorf("module %s is unhealthy, stop it to restart", m.module.Name())
+		stoppable.Stop()
+	}
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeModule struct {
+	name         string
+	dependencies []string
+	ready        atomic.Bool
+	healthy      atomic.Bool
+	stopped      chan struct{}
+}
+
+func newFakeModule(name string, dependencies ...string) *fakeModule {
+	m := &fakeModule{name: name, dependencies: dependencies, stopped: make(chan struct{}, 1)}
+	m.healthy.Store(true)
+	return m
+}
+
+func (m *fakeModule) Name() string           { return m.name }
+func (m *fakeModule) Group() string          { return "fake" }
+func (m *fakeModule) Start()                 {}
+func (m *fakeModule) Enable() bool           { return true }
+func (m *fakeModule) Dependencies() []string { return m.dependencies }
+func (m *fakeModule) Stop()                  { m.stopped <- struct{}{} }
+
+func (m *fakeModule) Ready() error {
+	if !m.ready.Load() {
+		return errors.New("not ready")
+	}
+	return nil
+}
+
+func (m *fakeModule) Healthy() error {
+	if !m.healthy.Load() {
+		return errors.New("wedged")
+	}
+	return nil
+}
+
+func setModules(t *testing.T, fakes ...*fakeModule) map[string]*ModuleInfo {
+	origModules := modules
+	t.Cleanup(func() { modules = origModules })
+
+	modules = make(map[string]*ModuleInfo)
+	for _, m := range fakes {
+		modules[m.name] = &ModuleInfo{module: m}
+	}
+	return modules
+}
+
+func TestCheckDependencies(t *testing.T) {
+	cases := []struct {
+		name    string
+		modules []*fakeModule
+		wantErr bool
+	}{
+		{
+			name: "no cycle",
+			modules: []*fakeModule{
+				newFakeModule("edged", "metamanager"),
+				newFakeModule("metamanager"),
+				newFakeModule("edgehub", "metamanager", "disabled"),
+			},
+		},
+		{
+			name: "cycle",
+			modules: []*fakeModule{
+				newFakeModule("a", "b"),
+				newFakeModule("b", "c"),
+				newFakeModule("c", "a"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			setModules(t, c.modules...)
+			if err := checkDependencies(); (err != nil) != c.wantErr {
+				t.Errorf("checkDependencies() error = %v, wantErr %v", err, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaitForDependencies(t *testing.T) {
+	origPeriod := DependencyPollPeriod
+	DependencyPollPeriod = 10 * time.Millisecond
+	defer func() { DependencyPollPeriod = origPeriod }()
+
+	metamanager := newFakeModule("metamanager")
+	infos := setModules(t, newFakeModule("edged", "metamanager"), metamanager)
+
+	done := make(chan bool)
+	go func() {
+		done <- infos["edged"].waitForDependencies()
+	}()
+
+	select {
+	case <-done:
+		t.Fatalf("edged started before metamanager is started")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if err := Readiness()["metamanager"]; err != errNotStarted {
+		t.Errorf("readiness of metamanager is %v, expected %v", err, errNotStarted)
+	}
+
+	infos["metamanager"].started.Store(true)
+	select {
+	case <-done:
+		t.Fatalf("edged started before metamanager is ready")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	metamanager.ready.Store(true)
+	select {
+	case ok := <-done:
+		if !ok {
+			t.Errorf("failed to wait for the dependencies of edged")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("edged doesn't start after metamanager is ready")
+	}
+	if err := Readiness()["metamanager"]; err != nil {
+		t.Errorf("readiness of metamanager is %v, expected it to be ready", err)
+	}
+}
+
+func TestProbeLiveness(t *testing.T) {
+	origPeriod := LivenessProbePeriod
+	LivenessProbePeriod = 10 * time.Millisecond
+	defer func() { LivenessProbePeriod = origPeriod }()
+
+	metamanager := newFakeModule("metamanager")
+	infos := setModules(t, metamanager)
+	infos["metamanager"].started.Store(true)
+	go infos["metamanager"].probeLiveness()
+
+	select {
+	case <-metamanager.stopped:
+		t.Fatalf("healthy module is stopped")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	metamanager.healthy.Store(false)
+	if err := Health()["metamanager"]; err == nil {
+		t.Errorf("expected metamanager to be unhealthy")
+	}
+	select {
+	case <-metamanager.stopped:
+	case <-time.After(time.Second):
+		t.Fatalf("unhealthy module is not stopped")
+	}
+}
@@ -31,6 +31,7 @@ func main() {
 		klog.Fatal(err)
 	}
 	klog.Infoln("Mapper register finished")
+	go grpcclient.StartHeartbeat(common.DefaultHeartbeatInterval, make(chan struct{}))
 
 	panel := device.NewDevPanel()
 	err = panel.DevInit(deviceList, deviceModelList)
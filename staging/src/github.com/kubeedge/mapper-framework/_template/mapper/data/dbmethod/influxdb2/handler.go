@@ -24,6 +24,8 @@ import (
 
 	"github.com/kubeedge/Template/driver"
 	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/config"
+	"github.com/kubeedge/mapper-framework/pkg/forwarder"
 )
 
 func DataHandler(ctx context.Context, twin *common.Twin, client *driver.CustomizedClient, visitorConfig *driver.VisitorConfig, dataModel *common.DataModel) {
@@ -37,6 +39,25 @@ func DataHandler(ctx context.Context, twin *common.Twin, client *driver.Customiz
 		klog.Errorf("init database client err: %v", err)
 		return
 	}
+	// the data is buffered locally and retried when the database is unavailable
+	fw, err := forwarder.New(forwarder.NameOf(dataModel, "influx"), config.Cfg().Buffer, func(data []*common.DataModel) error {
+		for _, d := range data {
+			if err := dbConfig.AddData(d, dbClient); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		klog.Errorf("new forwarder of influx database err: %v", err)
+		dbConfig.CloseSession(dbClient)
+		return
+	}
+	go func() {
+		fw.Run(ctx)
+		dbConfig.CloseSession(dbClient)
+	}()
+
 	reportCycle := time.Millisecond * time.Duration(twin.Property.ReportCycle)
 	if reportCycle == 0 {
 		reportCycle = common.DefaultReportCycle
@@ -59,13 +80,8 @@ func DataHandler(ctx context.Context, twin *common.Twin, client *driver.Customiz
 				dataModel.SetValue(sData)
 				dataModel.SetTimeStamp()
 
-				err = dbConfig.AddData(dataModel, dbClient)
-				if err != nil {
-					klog.Errorf("influx database add data error: %v", err)
-					return
-				}
+				fw.Push(dataModel)
 			case <-ctx.Done():
-				dbConfig.CloseSession(dbClient)
 				return
 			}
 		}
@@ -42,7 +42,14 @@ func (pm *PushMethod) InitPushMethod() error {
 	return nil
 }
 
+// Push pushes the data, the data failed to be pushed is dropped
 func (pm *PushMethod) Push(data *common.DataModel) {
+	if err := pm.TryPush(data); err != nil {
+		klog.Errorf("Publish device data by HTTP failed: %v", err)
+	}
+}
+
+func (pm *PushMethod) TryPush(data *common.DataModel) error {
 	klog.V(2).Info("Publish device data by HTTP")
 
 	targetUrl := pm.HTTP.HostName + ":" + strconv.Itoa(pm.HTTP.Port) + pm.HTTP.RequestPath
@@ -56,18 +63,18 @@ func (pm *PushMethod) Push(data *common.DataModel) {
 	resp, err := http.Post(targetUrl,
 		"application/x-www-form-urlencoded",
 		strings.NewReader(payload))
-
 	if err != nil {
-		fmt.Println(err)
+		return fmt.Errorf("publish device data by HTTP failed, err = %v", err)
 	}
 	defer resp.Body.Close()
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		// handle error
-		klog.Errorf("Publish device data by HTTP failed, err = %v", err)
-		return
+		return fmt.Errorf("read HTTP response failed, err = %v", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("publish device data by HTTP failed, status = %s, body = %s", resp.Status, string(body))
 	}
 	klog.V(1).Info("###############  Message published.  ###############")
 	klog.V(3).Infof("HTTP reviced %s", string(body))
-
+	return nil
 }
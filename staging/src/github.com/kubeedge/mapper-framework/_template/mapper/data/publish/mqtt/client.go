@@ -3,7 +3,6 @@ package mqtt
 import (
 	"encoding/json"
 	"fmt"
-	"os"
 	"time"
 
 	mqtt "github.com/eclipse/paho.mqtt.golang"
@@ -40,7 +39,14 @@ func (pm *PushMethod) InitPushMethod() error {
 	return nil
 }
 
+// Push pushes the data, the data failed to be pushed is dropped
 func (pm *PushMethod) Push(data *common.DataModel) {
+	if err := pm.TryPush(data); err != nil {
+		klog.Errorf("Publish device data by MQTT failed: %v", err)
+	}
+}
+
+func (pm *PushMethod) TryPush(data *common.DataModel) error {
 	klog.V(1).Infof("Publish %v to %s on topic: %s, Qos: %d, Retained: %v",
 		data.Value, pm.MQTT.Address, pm.MQTT.Topic, pm.MQTT.QoS, pm.MQTT.Retained)
 
@@ -48,16 +54,18 @@ func (pm *PushMethod) Push(data *common.DataModel) {
 	client := mqtt.NewClient(opts)
 
 	if token := client.Connect(); token.Wait() && token.Error() != nil {
-		fmt.Println(token.Error())
-		os.Exit(1)
+		return fmt.Errorf("connect to MQTT broker %s failed, err = %v", pm.MQTT.Address, token.Error())
 	}
+	defer client.Disconnect(250)
+
 	formatTimeStr := time.Unix(data.TimeStamp/1e3, 0).Format("2006-01-02 15:04:05")
 	str_time := "time is " + formatTimeStr + "  "
 	str_publish := str_time + pm.MQTT.Topic + ": " + data.Value
 
 	token := client.Publish(pm.MQTT.Topic, byte(pm.MQTT.QoS), pm.MQTT.Retained, str_publish)
-	token.Wait()
-
-	client.Disconnect(250)
+	if token.Wait() && token.Error() != nil {
+		return fmt.Errorf("publish to MQTT topic %s failed, err = %v", pm.MQTT.Topic, token.Error())
+	}
 	klog.V(2).Info("###############  Message published.  ###############")
+	return nil
 }
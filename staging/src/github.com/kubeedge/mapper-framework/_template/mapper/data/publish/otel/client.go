@@ -20,21 +20,36 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/url"
+	"strconv"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
-	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/resource"
+	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/global"
+)
+
+const (
+	meterName     = "github.com/kubeedge/Template/data/publish/otel"
+	exportTimeout = 10 * time.Second
 )
 
 type Config struct {
 	EndpointURL string `json:"endpointURL,omitempty"`
 }
 
+type PushMethod struct {
+	OTEL     *Config
+	exporter *otlpmetrichttp.Exporter
+}
+
 func NewConfig(clientConfig json.RawMessage) (*Config, error) {
 	var cfg Config
 	err := json.Unmarshal(clientConfig, &cfg)
@@ -47,31 +62,73 @@ func NewConfig(clientConfig json.RawMessage) (*Config, error) {
 	return &cfg, nil
 }
 
-func (cfg *Config) InitProvider(reportCycle time.Duration, dataModel *common.DataModel) (*metric.MeterProvider, error) {
-	exp, err := otlpmetrichttp.New(context.Background(), WithEndpointURL(cfg.EndpointURL)...)
+func NewDataPanel(config json.RawMessage) (global.DataPanel, error) {
+	cfg, err := NewConfig(config)
 	if err != nil {
 		return nil, err
 	}
+	return &PushMethod{
+		OTEL: cfg,
+	}, nil
+}
+
+func (pm *PushMethod) InitPushMethod() error {
+	klog.V(1).Info("Init OTEL")
+	exp, err := otlpmetrichttp.New(context.Background(), WithEndpointURL(pm.OTEL.EndpointURL)...)
+	if err != nil {
+		return err
+	}
+	pm.exporter = exp
+	return nil
+}
 
-	if reportCycle == 0 {
-		reportCycle = common.DefaultReportCycle
+// Push pushes the data, the data failed to be pushed is dropped
+func (pm *PushMethod) Push(data *common.DataModel) {
+	if err := pm.TryPush(data); err != nil {
+		klog.Errorf("Publish device data by OTEL failed: %v", err)
 	}
+}
 
+// TryPush exports the data as a gauge, so that the data failed to be exported can be exported
+// again with its original timestamp.
+func (pm *PushMethod) TryPush(data *common.DataModel) error {
+	value, err := strconv.ParseFloat(data.Value, 64)
+	if err != nil {
+		klog.Errorf("Drop the data %s of %s/%s which isn't a number", data.Value, data.DeviceName, data.PropertyName)
+		return nil
+	}
 	res, err := resource.Merge(
 		resource.Default(),
 		resource.NewSchemaless(
-			attribute.String("device.id", dataModel.Namespace+"/"+dataModel.DeviceName),
+			attribute.String("device.id", data.Namespace+"/"+data.DeviceName),
 			//semconv.DeviceID(dataModel.Namespace+"/"+dataModel.DeviceName), // go.opentelemetry.io/otel/semconv/v1.17.0+
 		))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	reader := metric.NewPeriodicReader(exp, metric.WithInterval(reportCycle))
-	return metric.NewMeterProvider(
-		metric.WithResource(res),
-		metric.WithReader(reader),
-	), nil
+	rm := &metricdata.ResourceMetrics{
+		Resource: res,
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: meterName},
+			Metrics: []metricdata.Metrics{{
+				Name: data.PropertyName,
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{{
+						Time:  time.UnixMilli(data.TimeStamp),
+						Value: value,
+					}},
+				},
+			}},
+		}},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	if err := pm.exporter.Export(ctx, rm); err != nil {
+		return fmt.Errorf("export device data by OTEL failed, err = %v", err)
+	}
+	klog.V(2).Info("###############  Message published.  ###############")
+	return nil
 }
 
 func WithEndpointURL(v string) []otlpmetrichttp.Option {
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/global"
+)
+
+const (
+	labelNamespace = "namespace"
+	labelDevice    = "device"
+)
+
+var (
+	labelNameRE        = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	invalidMetricRunes = regexp.MustCompile(`[^a-zA-Z0-9_:]`)
+)
+
+type Config struct {
+	Port           int64             `json:"port,omitempty"`
+	RemoteWriteURL string            `json:"remoteWriteURL,omitempty"`
+	MetricName     string            `json:"metricName,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+}
+
+// PushMethod exposes the data as gauges on the /metrics endpoint of the port,
+// or writes them to the remote write endpoint.
+type PushMethod struct {
+	Prometheus *Config
+	endpoint   *endpoint
+	writer     *remoteWriter
+	// closed is guarded by the mutex of the endpoint
+	closed bool
+}
+
+func NewConfig(clientConfig json.RawMessage) (*Config, error) {
+	var cfg Config
+	err := json.Unmarshal(clientConfig, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.RemoteWriteURL == "" && cfg.Port <= 0 {
+		return nil, errors.New("port or remoteWriteURL is required")
+	}
+	for name := range cfg.Labels {
+		if !labelNameRE.MatchString(name) || strings.HasPrefix(name, "__") {
+			return nil, fmt.Errorf("invalid label name %s", name)
+		}
+		if name == labelNamespace || name == labelDevice {
+			return nil, fmt.Errorf("label %s is reserved", name)
+		}
+		// an empty label is the same as no label for Prometheus
+		if cfg.Labels[name] == "" {
+			return nil, fmt.Errorf("value of label %s is required", name)
+		}
+	}
+	return &cfg, nil
+}
+
+func NewDataPanel(config json.RawMessage) (global.DataPanel, error) {
+	cfg, err := NewConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return &PushMethod{
+		Prometheus: cfg,
+	}, nil
+}
+
+func (pm *PushMethod) InitPushMethod() error {
+	klog.V(1).Info("Init Prometheus")
+	if pm.Prometheus.RemoteWriteURL != "" {
+		pm.writer = newRemoteWriter(pm.Prometheus.RemoteWriteURL)
+		return nil
+	}
+	e, err := getEndpoint(pm.Prometheus.Port)
+	if err != nil {
+		return err
+	}
+	pm.endpoint = e
+	return nil
+}
+
+// Push pushes the data, the data failed to be pushed is dropped
+func (pm *PushMethod) Push(data *common.DataModel) {
+	if err := pm.TryPush(data); err != nil {
+		klog.Errorf("Publish device data by Prometheus failed: %v", err)
+	}
+}
+
+// TryPush sets the gauge of the data, the data which isn't a number is dropped.
+func (pm *PushMethod) TryPush(data *common.DataModel) error {
+	value, err := parseValue(data.Value)
+	if err != nil {
+		klog.Errorf("Drop the data %s of %s/%s which isn't a number", data.Value, data.DeviceName, data.PropertyName)
+		return nil
+	}
+	s := sample{
+		name:      metricName(pm.Prometheus.MetricName, data.PropertyName),
+		labels:    pm.labels(data),
+		value:     value,
+		timestamp: data.TimeStamp,
+	}
+	if pm.writer != nil {
+		if err := pm.writer.write(s); err != nil {
+			return fmt.Errorf("write device data to Prometheus failed, err = %v", err)
+		}
+		klog.V(2).Info("###############  Message published.  ###############")
+		return nil
+	}
+	pm.endpoint.set(pm, s)
+	return nil
+}
+
+// Close deletes the gauges set by the PushMethod from the /metrics endpoint
+func (pm *PushMethod) Close() {
+	if pm.endpoint != nil {
+		pm.endpoint.release(pm)
+	}
+}
+
+// labels returns the labels of the gauge sorted by name
+func (pm *PushMethod) labels(data *common.DataModel) []label {
+	labels := []label{
+		{name: labelNamespace, value: data.Namespace},
+		{name: labelDevice, value: data.DeviceName},
+	}
+	for name, value := range pm.Prometheus.Labels {
+		labels = append(labels, label{name: name, value: value})
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].name < labels[j].name })
+	return labels
+}
+
+// metricName returns the name of the gauge, the characters not allowed by Prometheus are replaced with "_"
+func metricName(name, propertyName string) string {
+	if name == "" {
+		name = propertyName
+	}
+	name = invalidMetricRunes.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+func parseValue(value string) (float64, error) {
+	if b, err := strconv.ParseBool(value); err == nil {
+		if b {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"k8s.io/klog/v2"
+)
+
+const metricHelp = "The value of the device property."
+
+var (
+	endpointsMu sync.Mutex
+	// endpoints are the /metrics endpoints by port, they are shared by the properties pushed to the same port
+	endpoints = make(map[int64]*endpoint)
+)
+
+type label struct {
+	name  string
+	value string
+}
+
+type sample struct {
+	name      string
+	labels    []label
+	value     float64
+	timestamp int64
+}
+
+// key identifies the gauge of the sample
+func (s sample) key() string {
+	var b strings.Builder
+	b.WriteString(s.name)
+	for _, l := range s.labels {
+		b.WriteString("," + l.name + "=" + l.value)
+	}
+	return b.String()
+}
+
+// labelValue returns the value of the label, it's empty if the sample doesn't have the label
+func (s sample) labelValue(name string) string {
+	for _, l := range s.labels {
+		if l.name == name {
+			return l.value
+		}
+	}
+	return ""
+}
+
+// gauge is the latest sample of a gauge and the PushMethod which sets it
+type gauge struct {
+	sample
+	owner *PushMethod
+}
+
+// endpoint serves the latest value of the gauges on /metrics
+type endpoint struct {
+	mu     sync.Mutex
+	gauges map[string]gauge
+}
+
+// getEndpoint returns the endpoint of the port, the endpoint is started at the first call.
+func getEndpoint(port int64) (*endpoint, error) {
+	endpointsMu.Lock()
+	defer endpointsMu.Unlock()
+	if e, ok := endpoints[port]; ok {
+		return e, nil
+	}
+
+	e := &endpoint{gauges: make(map[string]gauge)}
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(e); err != nil {
+		return nil, err
+	}
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("listen on port %d for Prometheus failed: %v", port, err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			klog.Errorf("Prometheus endpoint on port %d stopped: %v", port, err)
+		}
+	}()
+	klog.Infof("Serve device data for Prometheus on port %d", port)
+	endpoints[port] = e
+	return e, nil
+}
+
+// set sets the gauge of the sample, the samples of a closed PushMethod are dropped
+func (e *endpoint) set(owner *PushMethod, s sample) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if owner.closed {
+		return
+	}
+	e.gauges[s.key()] = gauge{sample: s, owner: owner}
+}
+
+// release deletes the gauges set by the PushMethod, so that the gauges of the removed
+// devices and properties are not served with their last values
+func (e *endpoint) release(owner *PushMethod) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	owner.closed = true
+	for key, g := range e.gauges {
+		if g.owner == owner {
+			delete(e.gauges, key)
+		}
+	}
+}
+
+// Describe sends nothing, so that the gauges of different properties can have different labels.
+func (e *endpoint) Describe(chan<- *prometheus.Desc) {}
+
+// Collect collects the gauges of the same name with the union of their label names, the labels
+// a gauge doesn't have are empty, so that the label dimensions of each metric family are consistent.
+func (e *endpoint) Collect(ch chan<- prometheus.Metric) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	labelNames := make(map[string]map[string]struct{})
+	for _, g := range e.gauges {
+		names, ok := labelNames[g.name]
+		if !ok {
+			names = make(map[string]struct{})
+			labelNames[g.name] = names
+		}
+		for _, l := range g.labels {
+			names[l.name] = struct{}{}
+		}
+	}
+	descs := make(map[string]*prometheus.Desc, len(labelNames))
+	sortedNames := make(map[string][]string, len(labelNames))
+	for name, names := range labelNames {
+		sorted := make([]string, 0, len(names))
+		for n := range names {
+			sorted = append(sorted, n)
+		}
+		sort.Strings(sorted)
+		sortedNames[name] = sorted
+		descs[name] = prometheus.NewDesc(name, metricHelp, sorted, nil)
+	}
+
+	for _, g := range e.gauges {
+		names := sortedNames[g.name]
+		values := make([]string, len(names))
+		for i, n := range names {
+			values[i] = g.labelValue(n)
+		}
+		metric, err := prometheus.NewConstMetric(descs[g.name], prometheus.GaugeValue, g.value, values...)
+		if err != nil {
+			klog.Errorf("Failed to collect %s: %v", g.name, err)
+			continue
+		}
+		ch <- metric
+	}
+}
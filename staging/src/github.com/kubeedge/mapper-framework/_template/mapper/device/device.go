@@ -20,10 +20,13 @@ import (
 	httpMethod "github.com/kubeedge/Template/data/publish/http"
 	mqttMethod "github.com/kubeedge/Template/data/publish/mqtt"
 	otelMethod "github.com/kubeedge/Template/data/publish/otel"
+	prometheusMethod "github.com/kubeedge/Template/data/publish/prometheus"
 	"github.com/kubeedge/Template/data/stream"
 	"github.com/kubeedge/Template/driver"
 	dmiapi "github.com/kubeedge/api/apis/dmi/v1beta1"
 	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/config"
+	"github.com/kubeedge/mapper-framework/pkg/forwarder"
 	"github.com/kubeedge/mapper-framework/pkg/global"
 	"github.com/kubeedge/mapper-framework/pkg/util/parse"
 )
@@ -186,11 +189,6 @@ func dataHandler(ctx context.Context, dev *driver.CustomizedDev) {
 
 // pushHandler start data panel work
 func pushHandler(ctx context.Context, twin *common.Twin, client *driver.CustomizedClient, visitorConfig *driver.VisitorConfig, dataModel *common.DataModel) {
-	if twin.Property.PushMethod.MethodName == common.PushMethodOTEL {
-		otelMethod.DataHandler(ctx, twin, client, visitorConfig, dataModel)
-		return
-	}
-
 	var dataPanel global.DataPanel
 	var err error
 	// initialization dataPanel
@@ -199,6 +197,10 @@ func pushHandler(ctx context.Context, twin *common.Twin, client *driver.Customiz
 		dataPanel, err = httpMethod.NewDataPanel(twin.Property.PushMethod.MethodConfig)
 	case common.PushMethodMQTT:
 		dataPanel, err = mqttMethod.NewDataPanel(twin.Property.PushMethod.MethodConfig)
+	case common.PushMethodOTEL:
+		dataPanel, err = otelMethod.NewDataPanel(twin.Property.PushMethod.MethodConfig)
+	case common.PushMethodPrometheus:
+		dataPanel, err = prometheusMethod.NewDataPanel(twin.Property.PushMethod.MethodConfig)
 	default:
 		err = errors.New("custom protocols are not currently supported when push data")
 	}
@@ -212,6 +214,26 @@ func pushHandler(ctx context.Context, twin *common.Twin, client *driver.Customiz
 		klog.Errorf("init publish method err: %v", err)
 		return
 	}
+	// the data is buffered locally and retried when the push target is unavailable
+	fw, err := forwarder.New(forwarder.NameOf(dataModel, twin.Property.PushMethod.MethodName), config.Cfg().Buffer,
+		func(data []*common.DataModel) error {
+			reliable, ok := dataPanel.(global.ReliableDataPanel)
+			for _, d := range data {
+				if !ok {
+					dataPanel.Push(d)
+					continue
+				}
+				if err := reliable.TryPush(d); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		klog.Errorf("new forwarder of publish method err: %v", err)
+		return
+	}
+	go fw.Run(ctx)
 	reportCycle := time.Millisecond * time.Duration(twin.Property.ReportCycle)
 	if reportCycle == 0 {
 		reportCycle = common.DefaultReportCycle
@@ -233,8 +255,12 @@ func pushHandler(ctx context.Context, twin *common.Twin, client *driver.Customiz
 				}
 				dataModel.SetValue(sData)
 				dataModel.SetTimeStamp()
-				dataPanel.Push(dataModel)
+				fw.Push(dataModel)
 			case <-ctx.Done():
+				ticker.Stop()
+				if closable, ok := dataPanel.(global.ClosableDataPanel); ok {
+					closable.Close()
+				}
 				return
 			}
 		}
@@ -268,7 +294,7 @@ func setVisitor(visitorConfig *driver.VisitorConfig, twin *common.Twin, dev *dri
 	klog.V(2).Infof("Convert type: %s, value: %s ", twin.Property.PProperty.DataType, twin.ObservedDesired.Value)
 	var value interface{}
 	if twin.ObservedDesired.Value != "" {
-		convertedValue, err := common.Convert(twin.Property.PProperty.DataType, twin.ObservedDesired.Value)
+		convertedValue, err := common.ConvertProperty(twin.Property.PProperty, twin.ObservedDesired.Value)
 		if err != nil {
 			klog.Errorf("Failed to convert value as %s : %v", twin.Property.PProperty.DataType, err)
 			return err
@@ -485,9 +511,9 @@ func (d *DevPanel) WriteDevice(deviceMethodName, deviceID, propertyName, data st
 		return fmt.Errorf("can't find device propertyName %s in device instance", propertyName)
 	}
 	klog.V(2).Infof("start writing values %v to device %s property %s", data, deviceID, propertyName)
-	writeData, err := common.Convert(strings.ToLower(dataType), data)
+	writeData, err := common.ConvertProperty(deviceproperty.PProperty, data)
 	if err != nil {
-		return fmt.Errorf("conversion data format failed, datatype is %s, data is %s", strings.ToLower(dataType), data)
+		return fmt.Errorf("conversion data format failed, datatype is %s, data is %s: %v", strings.ToLower(dataType), data, err)
 	}
 	var visitorConfig driver.VisitorConfig
 	err = json.Unmarshal(deviceproperty.Visitors, &visitorConfig)
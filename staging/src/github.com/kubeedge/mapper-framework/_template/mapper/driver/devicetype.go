@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/simulator"
 )
 
 // CustomizedDev is the customized device configuration and client information.
@@ -16,6 +17,8 @@ type CustomizedClient struct {
 	// TODO add some variables to help you better implement device drivers
 	deviceMutex sync.Mutex
 	ProtocolConfig
+	// simulated is the simulated device if the protocol is simulation
+	simulated *simulator.Device
 }
 
 type ProtocolConfig struct {
@@ -25,6 +28,9 @@ type ProtocolConfig struct {
 
 type ConfigData struct {
 	// TODO: add your protocol config data
+	// the config of the simulated device if the protocol is simulation, the config data of
+	// DMI only holds scalar values, so the fields are inlined
+	simulator.ProtocolConfigData
 }
 
 type VisitorConfig struct {
@@ -35,4 +41,6 @@ type VisitorConfig struct {
 type VisitorConfigData struct {
 	// TODO: add your visitor config data
 	DataType string `json:"dataType"`
+	// how the property behaves if the protocol is simulation, inlined as the protocol config
+	simulator.VisitorConfigData
 }
@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/simulator"
 )
 
 func NewClient(protocol ProtocolConfig) (*CustomizedClient, error) {
@@ -12,6 +13,9 @@ func NewClient(protocol ProtocolConfig) (*CustomizedClient, error) {
 		deviceMutex:    sync.Mutex{},
 		// TODO initialize the variables you added
 	}
+	if protocol.ProtocolName == simulator.ProtocolName {
+		client.simulated = simulator.NewDevice(protocol.ProtocolConfigData)
+	}
 	return client, nil
 }
 
@@ -22,18 +26,27 @@ func (c *CustomizedClient) InitDevice() error {
 }
 
 func (c *CustomizedClient) GetDeviceData(visitor *VisitorConfig) (interface{}, error) {
+	if c.simulated != nil {
+		return c.simulated.Read(visitor.DataType, &visitor.VisitorConfigData.VisitorConfigData)
+	}
 	// TODO: add the code to get device's data
 	// you can use c.ProtocolConfig and visitor
 	return nil, nil
 }
 
 func (c *CustomizedClient) DeviceDataWrite(visitor *VisitorConfig, deviceMethodName string, propertyName string, data interface{}) error {
+	if c.simulated != nil {
+		return c.simulated.Write(&visitor.VisitorConfigData.VisitorConfigData, data)
+	}
 	// TODO: add the code to write device's data
 	// you can use c.ProtocolConfig and visitor to write data to device
 	return nil
 }
 
 func (c *CustomizedClient) SetDeviceData(data interface{}, visitor *VisitorConfig) error {
+	if c.simulated != nil {
+		return c.simulated.Write(&visitor.VisitorConfigData.VisitorConfigData, data)
+	}
 	// TODO: set device's data
 	// you can use c.ProtocolConfig and visitor
 	return nil
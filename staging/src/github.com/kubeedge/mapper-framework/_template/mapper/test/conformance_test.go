@@ -0,0 +1,120 @@
+package test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	dmiapi "github.com/kubeedge/api/apis/dmi/v1beta1"
+	"github.com/kubeedge/mapper-framework/pkg/conformance"
+	"github.com/kubeedge/mapper-framework/pkg/simulator"
+)
+
+// the config of the mapper under test, the sockets and the port are filled by the test
+const mapperConfig = `grpc_server:
+  socket_path: %s
+common:
+  name: Template-mapper
+  version: v1.13.0
+  api_version: v1.0.0
+  protocol: %s
+  address: 127.0.0.1
+  edgecore_sock: %s
+  http_port: "17777"
+`
+
+// TestConformance checks the mapper against the DMI contract with a simulated device.
+// Replace the device with a device of your protocol to check the driver as well.
+// The mapper is built from ../cmd unless MAPPER_BINARY is set.
+func TestConformance(t *testing.T) {
+	// the path of unix domain sockets is limited to about 100 characters
+	dir, err := os.MkdirTemp("", "mapper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	binary := os.Getenv("MAPPER_BINARY")
+	if binary == "" {
+		binary = filepath.Join(dir, "mapper")
+		build := exec.Command("go", "build", "-o", binary, "../cmd")
+		build.Stdout, build.Stderr = os.Stdout, os.Stderr
+		if err := build.Run(); err != nil {
+			t.Fatalf("failed to build the mapper: %v", err)
+		}
+	}
+
+	edgecoreSock := filepath.Join(dir, "dmi.sock")
+	mapperSock := filepath.Join(dir, "mapper.sock")
+	configFile := filepath.Join(dir, "config.yaml")
+	config := fmt.Sprintf(mapperConfig, mapperSock, simulator.ProtocolName, edgecoreSock)
+	if err := os.WriteFile(configFile, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	conformance.Run(t, conformance.Config{
+		EdgeCoreSocket: edgecoreSock,
+		MapperSocket:   mapperSock,
+		StartMapper: func() (func(), error) {
+			cmd := exec.Command(binary, "--config-file", configFile)
+			cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+			if err := cmd.Start(); err != nil {
+				return nil, err
+			}
+			return func() {
+				_ = cmd.Process.Kill()
+				_ = cmd.Wait()
+			}, nil
+		},
+		Model: &dmiapi.DeviceModel{
+			Name:      "simulated-model",
+			Namespace: "default",
+			Spec: &dmiapi.DeviceModelSpec{
+				Properties: []*dmiapi.ModelProperty{{Name: "temperature", Type: "float", AccessMode: "ReadWrite"}},
+			},
+		},
+		Device: &dmiapi.Device{
+			Name:      "simulated-device",
+			Namespace: "default",
+			Spec: &dmiapi.DeviceSpec{
+				DeviceModelReference: "simulated-model",
+				Protocol:             &dmiapi.ProtocolConfig{ProtocolName: simulator.ProtocolName},
+				Properties: []*dmiapi.DeviceProperty{{
+					Name:          "temperature",
+					Desired:       &dmiapi.TwinProperty{Metadata: map[string]string{"type": "float"}},
+					CollectCycle:  500,
+					ReportCycle:   500,
+					ReportToCloud: true,
+					Visitors: &dmiapi.VisitorConfig{
+						ProtocolName: simulator.ProtocolName,
+						ConfigData: &dmiapi.CustomizedValue{Data: map[string]*anypb.Any{
+							"dataType": newAny(t, wrapperspb.String("float")),
+							"register": newAny(t, wrapperspb.String("temperature")),
+							"behavior": newAny(t, wrapperspb.String(simulator.BehaviorRandomWalk)),
+							"value":    newAny(t, wrapperspb.String("20")),
+							"min":      newAny(t, wrapperspb.Float(10)),
+							"max":      newAny(t, wrapperspb.Float(30)),
+						}},
+					},
+				}},
+			},
+		},
+		Property:     "temperature",
+		DesiredValue: "25",
+	})
+}
+
+// newAny wraps the value as edgecore does with the config data of the device
+func newAny(t *testing.T, value proto.Message) *anypb.Any {
+	a, err := anypb.New(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
@@ -50,6 +50,10 @@ type ModelProperty struct {
 	Minimum     string `json:"minimum,omitempty"`
 	Maximum     string `json:"maximum,omitempty"`
 	Unit        string `json:"unit,omitempty"`
+	// EnumValues are the allowed values of an enum property.
+	EnumValues []string `json:"enumValues,omitempty"`
+	// Schema is the JSON schema fragment of an object or array property.
+	Schema string `json:"schema,omitempty"`
 }
 
 // ProtocolConfig is structure to store protocol information in device.
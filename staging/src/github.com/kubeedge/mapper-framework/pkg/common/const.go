@@ -32,14 +32,33 @@ const (
 )
 
 const (
-	PushMethodHTTP = "http"
-	PushMethodMQTT = "mqtt"
-	PushMethodOTEL = "otel"
+	PushMethodHTTP       = "http"
+	PushMethodMQTT       = "mqtt"
+	PushMethodOTEL       = "otel"
+	PushMethodPrometheus = "prometheus"
 )
 
 const DefaultCollectCycle = time.Second
 const DefaultReportCycle = time.Second
 
+// DefaultHeartbeatInterval is the interval the mapper registers to edgecore without data as heartbeats
+const DefaultHeartbeatInterval = 30 * time.Second
+
+// Defaults of the local buffer of the data pushed to the push methods and databases
+const (
+	DefaultBufferMaxSize       = 1000
+	DefaultBufferBatchSize     = 10
+	DefaultBufferFlushInterval = time.Second
+	DefaultBufferMinBackoff    = time.Second
+	DefaultBufferMaxBackoff    = time.Minute
+)
+
+// Drop policies of the local buffer when it's full
+const (
+	DropPolicyOldest = "oldest"
+	DropPolicyNewest = "newest"
+)
+
 const (
 	DevInitModeRegister  = "register"
 	DevInitModeConfigmap = "configmap"
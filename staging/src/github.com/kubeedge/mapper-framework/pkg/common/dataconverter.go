@@ -40,13 +40,44 @@ func Convert(valueType string, value string) (result interface{}, err error) {
 		return strconv.ParseFloat(value, 64)
 	case "boolean":
 		return strconv.ParseBool(value)
-	case "string":
+	case "string", "enum":
 		return value, nil
+	case "object":
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(value), &object); err != nil {
+			return nil, fmt.Errorf("convert %q to object failed: %v", value, err)
+		}
+		return object, nil
+	case "array":
+		var array []interface{}
+		if err := json.Unmarshal([]byte(value), &array); err != nil {
+			return nil, fmt.Errorf("convert %q to array failed: %v", value, err)
+		}
+		return array, nil
 	default:
 		return nil, errors.New("Convert failed")
 	}
 }
 
+// ConvertProperty converts string to the type of the model property,
+// the value of an enum property must be one of its allowed values.
+func ConvertProperty(property ModelProperty, value string) (interface{}, error) {
+	valueType := strings.ToLower(property.DataType)
+	result, err := Convert(valueType, value)
+	if err != nil {
+		return nil, err
+	}
+	if valueType == "enum" && len(property.EnumValues) != 0 {
+		for _, allowed := range property.EnumValues {
+			if value == allowed {
+				return result, nil
+			}
+		}
+		return nil, fmt.Errorf("%q is not one of the allowed values %v", value, property.EnumValues)
+	}
+	return result, nil
+}
+
 // ConvertToString other types to string
 func ConvertToString(value interface{}) (string, error) {
 	var result string
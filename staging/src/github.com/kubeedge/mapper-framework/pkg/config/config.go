@@ -18,6 +18,7 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/spf13/pflag"
 	"gopkg.in/yaml.v2"
@@ -31,6 +32,7 @@ var config *Config
 type Config struct {
 	GrpcServer GRPCServer `yaml:"grpc_server"`
 	Common     Common     `yaml:"common"`
+	Buffer     Buffer     `yaml:"buffer"`
 }
 
 type GRPCServer struct {
@@ -47,6 +49,17 @@ type Common struct {
 	HTTPPort     string `yaml:"http_port"`
 }
 
+// Buffer is the local buffer of the data pushed to the push methods and databases,
+// the data is kept in memory only if the path is empty.
+type Buffer struct {
+	Path          string        `yaml:"path"`
+	MaxSize       int           `yaml:"max_size"`
+	BatchSize     int           `yaml:"batch_size"`
+	FlushInterval time.Duration `yaml:"flush_interval"`
+	MaxBackoff    time.Duration `yaml:"max_backoff"`
+	DropPolicy    string        `yaml:"drop_policy"`
+}
+
 // Parse the configuration file. If failed, return error.
 func Parse() (c *Config, err error) {
 	var level klog.Level
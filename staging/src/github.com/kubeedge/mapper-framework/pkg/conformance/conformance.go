@@ -0,0 +1,222 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conformance checks that a mapper implements the DMI contract. It starts a fake
+// edgecore serving DeviceManagerService, and drives the DeviceMapperService of the mapper
+// under test over its unix domain socket.
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+
+	dmiapi "github.com/kubeedge/api/apis/dmi/v1beta1"
+)
+
+const (
+	defaultTimeout     = 30 * time.Second
+	defaultQuietPeriod = 5 * time.Second
+	// settlePeriod is how long the reports sent before the device is removed may still arrive
+	settlePeriod = time.Second
+	callTimeout  = 10 * time.Second
+)
+
+// Config of the conformance tests
+type Config struct {
+	// EdgeCoreSocket is the socket the fake edgecore serves on, it's the edgecore_sock of the mapper.
+	EdgeCoreSocket string
+	// MapperSocket is the socket of the mapper, it's the grpc_server.socket_path of the mapper.
+	MapperSocket string
+	// StartMapper starts the mapper under test once the fake edgecore is serving, and returns
+	// the function stopping it. The mapper is started by the caller if it's nil.
+	StartMapper func() (stop func(), err error)
+
+	// Model is the device model of Device.
+	Model *dmiapi.DeviceModel
+	// Device is registered to the mapper, its properties to report must have reportToCloud set.
+	Device *dmiapi.Device
+	// Property of Device whose desired value is set to DesiredValue to check the twin updates,
+	// the check is skipped if it's empty.
+	Property     string
+	DesiredValue string
+
+	// Timeout of every expectation, default 30 seconds.
+	Timeout time.Duration
+	// QuietPeriod is how long no status is expected after the device is removed, default 5 seconds.
+	QuietPeriod time.Duration
+}
+
+// Run runs the conformance tests against the mapper.
+func Run(t *testing.T, cfg Config) {
+	if cfg.Model == nil || cfg.Device == nil {
+		t.Fatal("the device model and the device are required")
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.QuietPeriod <= 0 {
+		cfg.QuietPeriod = defaultQuietPeriod
+	}
+
+	edgecore, err := NewFakeEdgeCore(cfg.EdgeCoreSocket)
+	if err != nil {
+		t.Fatalf("failed to start fake edgecore: %v", err)
+	}
+	defer edgecore.Stop()
+
+	if cfg.StartMapper != nil {
+		stop, err := cfg.StartMapper()
+		if err != nil {
+			t.Fatalf("failed to start the mapper: %v", err)
+		}
+		defer stop()
+	}
+
+	if !t.Run("MapperRegister", func(t *testing.T) {
+		if !waitFor(cfg.Timeout, func() bool { return len(edgecore.Mappers()) > 0 }) {
+			t.Fatal("the mapper didn't register to edgecore")
+		}
+		mapper := edgecore.Mappers()[0]
+		if mapper.GetName() == "" || mapper.GetProtocol() == "" {
+			t.Errorf("the mapper registered without name or protocol: %v", mapper)
+		}
+	}) {
+		return
+	}
+
+	conn, err := grpc.NewClient("unix:"+cfg.MapperSocket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to connect to the mapper: %v", err)
+	}
+	defer conn.Close()
+	mapper := dmiapi.NewDeviceMapperServiceClient(conn)
+
+	// the mapper starts serving after it registers
+	var ready bool
+	waitFor(cfg.Timeout, func() bool {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := mapper.CreateDeviceModel(ctx, &dmiapi.CreateDeviceModelRequest{Model: cfg.Model})
+		ready = err == nil
+		return ready
+	})
+
+	if !t.Run("CreateDeviceModel", func(t *testing.T) {
+		if !ready {
+			t.Fatal("the mapper failed to create the device model")
+		}
+	}) {
+		return
+	}
+
+	namespace, name := cfg.Device.GetNamespace(), cfg.Device.GetName()
+	if !t.Run("RegisterDevice", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+		if _, err := mapper.RegisterDevice(ctx, &dmiapi.RegisterDeviceRequest{Device: cfg.Device}); err != nil {
+			t.Fatalf("failed to register device: %v", err)
+		}
+		if !waitFor(cfg.Timeout, func() bool { return len(edgecore.DeviceStatuses(namespace, name)) > 0 }) {
+			t.Fatal("no status of the registered device is reported")
+		}
+		if _, err := mapper.GetDevice(ctx, &dmiapi.GetDeviceRequest{DeviceName: name, DeviceNamespace: namespace}); err != nil {
+			t.Errorf("failed to get the registered device: %v", err)
+		}
+	}) {
+		return
+	}
+
+	t.Run("UpdateDeviceModel", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+		if _, err := mapper.UpdateDeviceModel(ctx, &dmiapi.UpdateDeviceModelRequest{Model: cfg.Model}); err != nil {
+			t.Errorf("failed to update device model: %v", err)
+		}
+	})
+
+	if cfg.Property != "" {
+		t.Run("UpdateDesiredValue", func(t *testing.T) {
+			device := proto.Clone(cfg.Device).(*dmiapi.Device)
+			found := false
+			for _, property := range device.GetSpec().GetProperties() {
+				if property.GetName() == cfg.Property {
+					property.Desired = &dmiapi.TwinProperty{Value: cfg.DesiredValue}
+					found = true
+				}
+			}
+			if !found {
+				t.Fatalf("the device has no property %s", cfg.Property)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+			defer cancel()
+			if _, err := mapper.UpdateDevice(ctx, &dmiapi.UpdateDeviceRequest{Device: device}); err != nil {
+				t.Fatalf("failed to update device: %v", err)
+			}
+			if !waitFor(cfg.Timeout, func() bool {
+				return observedDesired(edgecore.DeviceStatuses(namespace, name), cfg.Property, cfg.DesiredValue)
+			}) {
+				t.Errorf("the twin of %s isn't updated to the desired value %s", cfg.Property, cfg.DesiredValue)
+			}
+		})
+	}
+
+	t.Run("RemoveDevice", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+		if _, err := mapper.RemoveDevice(ctx, &dmiapi.RemoveDeviceRequest{DeviceName: name, DeviceNamespace: namespace}); err != nil {
+			t.Fatalf("failed to remove device: %v", err)
+		}
+		if _, err := mapper.GetDevice(ctx, &dmiapi.GetDeviceRequest{DeviceName: name, DeviceNamespace: namespace}); err == nil {
+			t.Error("the removed device is still got from the mapper")
+		}
+
+		time.Sleep(settlePeriod)
+		reported := len(edgecore.DeviceStatuses(namespace, name))
+		time.Sleep(cfg.QuietPeriod)
+		if len(edgecore.DeviceStatuses(namespace, name)) != reported {
+			t.Error("the status of the removed device is still reported")
+		}
+	})
+
+	t.Run("RemoveDeviceModel", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+		if _, err := mapper.RemoveDeviceModel(ctx, &dmiapi.RemoveDeviceModelRequest{
+			ModelName:      cfg.Model.GetName(),
+			ModelNamespace: cfg.Model.GetNamespace(),
+		}); err != nil {
+			t.Errorf("failed to remove device model: %v", err)
+		}
+	})
+}
+
+// observedDesired returns whether the property is reported with the observed desired value
+func observedDesired(statuses []*dmiapi.ReportDeviceStatusRequest, property, value string) bool {
+	for _, status := range statuses {
+		for _, twin := range status.GetReportedDevice().GetTwins() {
+			if twin.GetPropertyName() == property && twin.GetObservedDesired().GetValue() == value {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	dmiapi "github.com/kubeedge/api/apis/dmi/v1beta1"
+)
+
+// FakeEdgeCore is a DeviceManagerService recording what the mappers report.
+type FakeEdgeCore struct {
+	dmiapi.UnimplementedDeviceManagerServiceServer
+
+	mu       sync.Mutex
+	mappers  []*dmiapi.MapperInfo
+	statuses []*dmiapi.ReportDeviceStatusRequest
+	states   []*dmiapi.ReportDeviceStatesRequest
+
+	socket string
+	server *grpc.Server
+}
+
+// NewFakeEdgeCore starts a FakeEdgeCore serving on the unix domain socket.
+func NewFakeEdgeCore(socket string) (*FakeEdgeCore, error) {
+	if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, err
+	}
+	f := &FakeEdgeCore{
+		socket: socket,
+		server: grpc.NewServer(),
+	}
+	dmiapi.RegisterDeviceManagerServiceServer(f.server, f)
+	go func() {
+		_ = f.server.Serve(lis)
+	}()
+	return f, nil
+}
+
+// Stop stops serving and removes the socket.
+func (f *FakeEdgeCore) Stop() {
+	f.server.Stop()
+	_ = os.Remove(f.socket)
+}
+
+// MapperRegister records the mapper, no device or device model is returned, the devices
+// and the device models are registered through the DeviceMapperService of the mapper.
+func (f *FakeEdgeCore) MapperRegister(_ context.Context, request *dmiapi.MapperRegisterRequest) (*dmiapi.MapperRegisterResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.mappers = append(f.mappers, request.GetMapper())
+	return &dmiapi.MapperRegisterResponse{}, nil
+}
+
+// ReportDeviceStatus records the twins reported by the mapper.
+func (f *FakeEdgeCore) ReportDeviceStatus(_ context.Context, request *dmiapi.ReportDeviceStatusRequest) (*dmiapi.ReportDeviceStatusResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.statuses = append(f.statuses, request)
+	return &dmiapi.ReportDeviceStatusResponse{}, nil
+}
+
+// ReportDeviceStates records the states reported by the mapper.
+func (f *FakeEdgeCore) ReportDeviceStates(_ context.Context, request *dmiapi.ReportDeviceStatesRequest) (*dmiapi.ReportDeviceStatesResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.states = append(f.states, request)
+	return &dmiapi.ReportDeviceStatesResponse{}, nil
+}
+
+// ReportDiscoveredDevices accepts the discovered devices.
+func (f *FakeEdgeCore) ReportDiscoveredDevices(context.Context, *dmiapi.ReportDiscoveredDevicesRequest) (*dmiapi.ReportDiscoveredDevicesResponse, error) {
+	return &dmiapi.ReportDiscoveredDevicesResponse{}, nil
+}
+
+// Mappers returns the registrations of the mappers, including the heartbeats.
+func (f *FakeEdgeCore) Mappers() []*dmiapi.MapperInfo {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*dmiapi.MapperInfo(nil), f.mappers...)
+}
+
+// DeviceStatuses returns the twins reported for the device.
+func (f *FakeEdgeCore) DeviceStatuses(namespace, name string) []*dmiapi.ReportDeviceStatusRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var statuses []*dmiapi.ReportDeviceStatusRequest
+	for _, status := range f.statuses {
+		if status.GetDeviceNamespace() == namespace && status.GetDeviceName() == name {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+// DeviceStates returns the states reported for the device.
+func (f *FakeEdgeCore) DeviceStates(namespace, name string) []*dmiapi.ReportDeviceStatesRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var states []*dmiapi.ReportDeviceStatesRequest
+	for _, state := range f.states {
+		if state.GetDeviceNamespace() == namespace && state.GetDeviceName() == name {
+			states = append(states, state)
+		}
+	}
+	return states
+}
+
+// waitFor polls the condition until it's true or the timeout expires.
+func waitFor(timeout time.Duration, condition func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if condition() {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
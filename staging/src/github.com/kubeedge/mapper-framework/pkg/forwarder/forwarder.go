@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/config"
+)
+
+// SendFunc sends a batch of data to the push method or the database. If it returns an error,
+// the whole batch is sent again later, so the data is delivered at least once.
+type SendFunc func(data []*common.DataModel) error
+
+// Forwarder buffers the collected data and sends them in batches, the data failed to be sent
+// are retried with exponential backoff until they are sent or dropped by the drop policy.
+type Forwarder struct {
+	name          string
+	queue         *Queue
+	send          SendFunc
+	batchSize     int
+	flushInterval time.Duration
+	maxBackoff    time.Duration
+	notify        chan struct{}
+}
+
+// New creates a forwarder sending the data with send. The name must be unique in the mapper,
+// it's the directory of the buffered data under the buffer path.
+func New(name string, cfg config.Buffer, send SendFunc) (*Forwarder, error) {
+	dir := ""
+	if cfg.Path != "" {
+		dir = filepath.Join(cfg.Path, name)
+	}
+	queue, err := NewQueue(dir, cfg.MaxSize, cfg.DropPolicy)
+	if err != nil {
+		return nil, err
+	}
+	f := &Forwarder{
+		name:          name,
+		queue:         queue,
+		send:          send,
+		batchSize:     cfg.BatchSize,
+		flushInterval: cfg.FlushInterval,
+		maxBackoff:    cfg.MaxBackoff,
+		notify:        make(chan struct{}, 1),
+	}
+	if f.batchSize <= 0 {
+		f.batchSize = common.DefaultBufferBatchSize
+	}
+	if f.flushInterval <= 0 {
+		f.flushInterval = common.DefaultBufferFlushInterval
+	}
+	if f.maxBackoff <= 0 {
+		f.maxBackoff = common.DefaultBufferMaxBackoff
+	}
+	return f, nil
+}
+
+// NameOf returns the name of the forwarder of the property of a device sending data with the method.
+func NameOf(data *common.DataModel, method string) string {
+	name := strings.Join([]string{data.Namespace, data.DeviceName, data.PropertyName, method}, "_")
+	return strings.NewReplacer("/", "-", "\\", "-").Replace(name)
+}
+
+// Push buffers a copy of the data, it never blocks on the push method or the database.
+func (f *Forwarder) Push(data *common.DataModel) {
+	copied := *data
+	if err := f.queue.Push(&copied); err != nil {
+		klog.Errorf("Failed to buffer the data of %s: %v", f.name, err)
+		return
+	}
+	if f.queue.Len() >= f.batchSize {
+		select {
+		case f.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run sends the buffered data until the context is done, the data left are kept in the buffer.
+func (f *Forwarder) Run(ctx context.Context) {
+	ticker := time.NewTicker(f.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-f.notify:
+		}
+		f.flush(ctx)
+	}
+}
+
+// flush sends all the buffered data in batches, it backs off when the sending fails.
+func (f *Forwarder) flush(ctx context.Context) {
+	backoff := common.DefaultBufferMinBackoff
+	for f.queue.Len() > 0 {
+		batch, last := f.queue.Peek(f.batchSize)
+		err := f.send(batch)
+		if err == nil {
+			f.queue.Remove(last)
+			backoff = common.DefaultBufferMinBackoff
+			continue
+		}
+
+		klog.Warningf("Failed to send %d data of %s, retry in %v: %v", len(batch), f.name, backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > f.maxBackoff {
+			backoff = f.maxBackoff
+		}
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/config"
+)
+
+func TestForwarderFlushRetry(t *testing.T) {
+	var sent []string
+	failures := 1
+	f, err := New("test", config.Buffer{BatchSize: 2}, func(data []*common.DataModel) error {
+		if failures > 0 {
+			failures--
+			return errors.New("push target is unavailable")
+		}
+		sent = append(sent, valuesOf(data)...)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to create forwarder: %v", err)
+	}
+	for _, v := range []string{"1", "2", "3"} {
+		f.Push(&common.DataModel{Value: v})
+	}
+
+	f.flush(context.Background())
+	if !reflect.DeepEqual(sent, []string{"1", "2", "3"}) {
+		t.Errorf("expected the data sent in order [1 2 3] after retrying, but got %v", sent)
+	}
+	if f.queue.Len() != 0 {
+		t.Errorf("expected the buffer empty, but %d data left", f.queue.Len())
+	}
+}
+
+func TestForwarderDropOldestDuringSend(t *testing.T) {
+	var f *Forwarder
+	var sent []string
+	pushed := false
+	f, err := New("test", config.Buffer{MaxSize: 2, BatchSize: 2, DropPolicy: common.DropPolicyOldest},
+		func(data []*common.DataModel) error {
+			if !pushed {
+				// the data collected while the batch is sent drop the batch from the buffer
+				pushed = true
+				f.Push(&common.DataModel{Value: "3"})
+				f.Push(&common.DataModel{Value: "4"})
+			}
+			sent = append(sent, valuesOf(data)...)
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("failed to create forwarder: %v", err)
+	}
+	f.Push(&common.DataModel{Value: "1"})
+	f.Push(&common.DataModel{Value: "2"})
+
+	f.flush(context.Background())
+	if !reflect.DeepEqual(sent, []string{"1", "2", "3", "4"}) {
+		t.Errorf("expected all data sent in order [1 2 3 4], but got %v", sent)
+	}
+}
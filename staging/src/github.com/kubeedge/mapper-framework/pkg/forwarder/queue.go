@@ -0,0 +1,207 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+)
+
+const entrySuffix = ".json"
+
+// ErrDropped is returned when the queue is full and the newest data is dropped
+var ErrDropped = errors.New("the buffer is full, the data is dropped")
+
+type entry struct {
+	seq  uint64
+	data *common.DataModel
+}
+
+// Queue is a bounded FIFO queue of data. If it has a directory, every data is also written
+// to a file of the directory, so that the data is kept across restarts of the mapper.
+type Queue struct {
+	mu         sync.Mutex
+	dir        string
+	maxSize    int
+	dropPolicy string
+	entries    []entry
+	nextSeq    uint64
+}
+
+// NewQueue creates a queue holding at most maxSize data, and loads the data left in the directory.
+func NewQueue(dir string, maxSize int, dropPolicy string) (*Queue, error) {
+	if maxSize <= 0 {
+		maxSize = common.DefaultBufferMaxSize
+	}
+	if dropPolicy == "" {
+		dropPolicy = common.DropPolicyOldest
+	}
+	if dropPolicy != common.DropPolicyOldest && dropPolicy != common.DropPolicyNewest {
+		return nil, fmt.Errorf("unsupported drop policy %s", dropPolicy)
+	}
+	q := &Queue{dir: dir, maxSize: maxSize, dropPolicy: dropPolicy}
+	if dir == "" {
+		return q, nil
+	}
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// load reads the data left in the directory in order
+func (q *Queue) load() error {
+	files, err := os.ReadDir(q.dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), entrySuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(f.Name(), entrySuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(q.dir, f.Name()))
+		if err != nil {
+			return err
+		}
+		data := &common.DataModel{}
+		if err := json.Unmarshal(content, data); err != nil {
+			klog.Warningf("Drop the corrupted buffered data %s: %v", f.Name(), err)
+			q.removeFile(seq)
+			continue
+		}
+		q.entries = append(q.entries, entry{seq: seq, data: data})
+	}
+	sort.Slice(q.entries, func(i, j int) bool { return q.entries[i].seq < q.entries[j].seq })
+	if len(q.entries) > 0 {
+		q.nextSeq = q.entries[len(q.entries)-1].seq + 1
+	}
+	for len(q.entries) > q.maxSize {
+		q.removeFile(q.entries[0].seq)
+		q.entries = q.entries[1:]
+	}
+	return nil
+}
+
+// Push appends the data to the queue. When the queue is full, the oldest data is dropped,
+// or ErrDropped is returned if the drop policy is newest.
+func (q *Queue) Push(data *common.DataModel) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.entries) >= q.maxSize {
+		if q.dropPolicy == common.DropPolicyNewest {
+			return ErrDropped
+		}
+		klog.V(2).Infof("The buffer is full, drop the oldest data of %s/%s", q.entries[0].data.DeviceName, q.entries[0].data.PropertyName)
+		q.removeFile(q.entries[0].seq)
+		q.entries = q.entries[1:]
+	}
+
+	e := entry{seq: q.nextSeq, data: data}
+	if q.dir != "" {
+		if err := q.writeFile(e); err != nil {
+			return err
+		}
+	}
+	q.nextSeq++
+	q.entries = append(q.entries, e)
+	return nil
+}
+
+// Peek returns at most n data from the head of the queue without removing them, and the
+// sequence number of the last data returned, which is passed to Remove once they are sent.
+func (q *Queue) Peek(n int) ([]*common.DataModel, uint64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if n > len(q.entries) {
+		n = len(q.entries)
+	}
+	data := make([]*common.DataModel, 0, n)
+	var last uint64
+	for _, e := range q.entries[:n] {
+		data = append(data, e.data)
+		last = e.seq
+	}
+	return data, last
+}
+
+// Remove removes the data from the head of the queue up to the sequence number last. The data
+// pushed after Peek are kept even if the data peeked are dropped by Push in the meantime.
+func (q *Queue) Remove(last uint64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n := 0
+	for n < len(q.entries) && q.entries[n].seq <= last {
+		q.removeFile(q.entries[n].seq)
+		n++
+	}
+	q.entries = q.entries[n:]
+}
+
+// Len returns the number of the data in the queue.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.entries)
+}
+
+func (q *Queue) fileName(seq uint64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%020d%s", seq, entrySuffix))
+}
+
+// writeFile writes the data to a temporary file and renames it, so that a crash never leaves
+// a partially written data in the directory.
+func (q *Queue) writeFile(e entry) error {
+	content, err := json.Marshal(e.data)
+	if err != nil {
+		return err
+	}
+	name := q.fileName(e.seq)
+	if err := os.WriteFile(name+".tmp", content, 0640); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+func (q *Queue) removeFile(seq uint64) {
+	if q.dir == "" {
+		return
+	}
+	if err := os.Remove(q.fileName(seq)); err != nil && !os.IsNotExist(err) {
+		klog.Warningf("Failed to remove the buffered data %d: %v", seq, err)
+	}
+}
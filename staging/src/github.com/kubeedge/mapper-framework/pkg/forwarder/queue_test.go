@@ -0,0 +1,128 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+)
+
+func pushValues(t *testing.T, q *Queue, values ...string) {
+	t.Helper()
+	for _, v := range values {
+		if err := q.Push(&common.DataModel{DeviceName: "device", PropertyName: "property", Value: v}); err != nil {
+			t.Fatalf("failed to push %s: %v", v, err)
+		}
+	}
+}
+
+func valuesOf(data []*common.DataModel) []string {
+	values := make([]string, 0, len(data))
+	for _, d := range data {
+		values = append(values, d.Value)
+	}
+	return values
+}
+
+func TestQueueOrder(t *testing.T) {
+	q, err := NewQueue("", 10, common.DropPolicyOldest)
+	if err != nil {
+		t.Fatalf("failed to create queue: %v", err)
+	}
+	pushValues(t, q, "1", "2", "3", "4", "5")
+
+	batch, last := q.Peek(3)
+	if got := valuesOf(batch); !reflect.DeepEqual(got, []string{"1", "2", "3"}) {
+		t.Errorf("expected the first batch [1 2 3], but got %v", got)
+	}
+	q.Remove(last)
+	batch, _ = q.Peek(3)
+	if got := valuesOf(batch); !reflect.DeepEqual(got, []string{"4", "5"}) {
+		t.Errorf("expected the second batch [4 5], but got %v", got)
+	}
+}
+
+func TestQueueDropOldestDuringSend(t *testing.T) {
+	q, err := NewQueue("", 3, common.DropPolicyOldest)
+	if err != nil {
+		t.Fatalf("failed to create queue: %v", err)
+	}
+	pushValues(t, q, "1", "2", "3")
+
+	batch, last := q.Peek(3)
+	// the data pushed while the batch is sent drop the oldest data of the batch
+	pushValues(t, q, "4", "5")
+	if got := valuesOf(batch); !reflect.DeepEqual(got, []string{"1", "2", "3"}) {
+		t.Errorf("expected the batch [1 2 3], but got %v", got)
+	}
+	q.Remove(last)
+
+	batch, _ = q.Peek(3)
+	if got := valuesOf(batch); !reflect.DeepEqual(got, []string{"4", "5"}) {
+		t.Errorf("expected the data pushed during sending [4 5] kept, but got %v", got)
+	}
+}
+
+func TestQueueDropNewest(t *testing.T) {
+	q, err := NewQueue("", 2, common.DropPolicyNewest)
+	if err != nil {
+		t.Fatalf("failed to create queue: %v", err)
+	}
+	pushValues(t, q, "1", "2")
+	if err := q.Push(&common.DataModel{Value: "3"}); !errors.Is(err, ErrDropped) {
+		t.Errorf("expected ErrDropped, but got %v", err)
+	}
+	batch, _ := q.Peek(3)
+	if got := valuesOf(batch); !reflect.DeepEqual(got, []string{"1", "2"}) {
+		t.Errorf("expected [1 2], but got %v", got)
+	}
+}
+
+func TestQueueReplay(t *testing.T) {
+	dir := t.TempDir()
+	q, err := NewQueue(dir, 10, common.DropPolicyOldest)
+	if err != nil {
+		t.Fatalf("failed to create queue: %v", err)
+	}
+	pushValues(t, q, "1", "2", "3")
+	_, last := q.Peek(1)
+	q.Remove(last)
+
+	// the mapper restarts
+	q, err = NewQueue(dir, 10, common.DropPolicyOldest)
+	if err != nil {
+		t.Fatalf("failed to reload queue: %v", err)
+	}
+	pushValues(t, q, "4")
+	batch, _ := q.Peek(10)
+	if got := valuesOf(batch); !reflect.DeepEqual(got, []string{"2", "3", "4"}) {
+		t.Errorf("expected the data replayed in order [2 3 4], but got %v", got)
+	}
+
+	// the reloaded queue is truncated to the max size from the oldest data
+	q, err = NewQueue(dir, 2, common.DropPolicyOldest)
+	if err != nil {
+		t.Fatalf("failed to reload queue: %v", err)
+	}
+	batch, _ = q.Peek(10)
+	if got := valuesOf(batch); !reflect.DeepEqual(got, []string{"3", "4"}) {
+		t.Errorf("expected the newest data [3 4] replayed, but got %v", got)
+	}
+}
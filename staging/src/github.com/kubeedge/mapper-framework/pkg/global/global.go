@@ -45,6 +45,24 @@ type DataPanel interface {
 	Push(data *common.DataModel)
 }
 
+// ReliableDataPanel is implemented by the DataPanel which reports whether the data is pushed,
+// the data failed to be pushed is buffered and retried by the framework
+type ReliableDataPanel interface {
+	DataPanel
+
+	// TryPush implement push operation, it returns an error if the data isn't pushed
+	TryPush(data *common.DataModel) error
+}
+
+// ClosableDataPanel is implemented by the DataPanel which keeps the pushed data, e.g. serves
+// the latest values, the data is released by Close when the push of the property stops
+type ClosableDataPanel interface {
+	DataPanel
+
+	// Close releases the data pushed by the DataPanel
+	Close()
+}
+
 // DataBaseClient defined database interface, save data and provide data to REST API
 type DataBaseClient interface {
 	// TODO add more interface
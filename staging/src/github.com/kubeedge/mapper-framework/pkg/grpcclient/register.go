@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"k8s.io/klog/v2"
 
 	dmiapi "github.com/kubeedge/api/apis/dmi/v1beta1"
 	"github.com/kubeedge/mapper-framework/pkg/common"
@@ -60,3 +61,21 @@ func RegisterMapper(withData bool) ([]*dmiapi.Device, []*dmiapi.DeviceModel, err
 
 	return resp.DeviceList, resp.ModelList, err
 }
+
+// StartHeartbeat registers the mapper to edgecore without data periodically, so that edgecore
+// knows the mapper is alive. edgecore only refreshes the heartbeat time of the mapper for the
+// registrations whose mapper info is unchanged. It blocks until the stop channel is closed.
+func StartHeartbeat(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if _, _, err := RegisterMapper(false); err != nil {
+				klog.Errorf("failed to send heartbeat to edgecore: %v", err)
+			}
+		}
+	}
+}
@@ -76,3 +76,39 @@ func ReportDeviceStates(request *dmiapi.ReportDeviceStatesRequest) error {
 	_, err = c.ReportDeviceStates(ctx, request)
 	return err
 }
+
+// ReportDiscoveredDevices reports the devices discovered by the mapper to edgecore,
+// the name of the mapper is filled in if the request doesn't set it.
+func ReportDiscoveredDevices(request *dmiapi.ReportDiscoveredDevicesRequest) error {
+	cfg := config.Cfg()
+
+	conn, err := grpc.Dial(cfg.Common.EdgeCoreSock,
+		grpc.WithInsecure(),
+		grpc.WithBlock(),
+		grpc.WithContextDialer(
+			func(ctx context.Context, s string) (net.Conn, error) {
+				unixAddress, err := net.ResolveUnixAddr("unix", cfg.Common.EdgeCoreSock)
+				if err != nil {
+					return nil, err
+				}
+				return net.DialUnix("unix", nil, unixAddress)
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("did not connect: %v", err)
+	}
+	defer conn.Close()
+
+	c := dmiapi.NewDeviceManagerServiceClient(conn)
+
+	// init context，set timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if request.MapperName == "" {
+		request.MapperName = cfg.Common.Name
+	}
+	_, err = c.ReportDiscoveredDevices(ctx, request)
+	return err
+}
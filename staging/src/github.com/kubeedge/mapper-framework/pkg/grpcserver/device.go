@@ -149,7 +149,11 @@ func (s *Server) GetDevice(_ context.Context, request *dmiapi.GetDeviceRequest)
 			Status: &dmiapi.DeviceStatus{},
 		},
 	}
-	deviceValue := reflect.ValueOf(device)
+	// the device panel returns a pointer to the device of the mapper
+	deviceValue := reflect.Indirect(reflect.ValueOf(device))
+	if deviceValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("device %s is not a struct", deviceID)
+	}
 	twinsValue := deviceValue.FieldByName("Instance").FieldByName("Twins")
 	if !twinsValue.IsValid() {
 		return nil, fmt.Errorf("twins field not found")
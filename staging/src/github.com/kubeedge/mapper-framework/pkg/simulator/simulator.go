@@ -0,0 +1,296 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulator simulates devices, so that mappers and device pipelines can run
+// without hardware. Every property of a simulated device is a register whose values are
+// generated by a behavior, and the values written to a register are read back.
+package simulator
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+)
+
+// ProtocolName is the name of the simulation protocol
+const ProtocolName = "simulation"
+
+// Behaviors of the registers
+const (
+	BehaviorConstant   = "constant"
+	BehaviorRandomWalk = "randomWalk"
+	BehaviorSine       = "sine"
+	BehaviorReplay     = "replay"
+)
+
+const (
+	defaultStep   = 1
+	defaultPeriod = time.Minute
+)
+
+// ProtocolConfigData is the protocol config of a simulated device
+type ProtocolConfigData struct {
+	// Seed of the random walks, the random walks are reproducible if it's set
+	Seed int64 `json:"seed,omitempty"`
+}
+
+// VisitorConfigData defines how the register of a property behaves
+type VisitorConfigData struct {
+	// Register is the name of the register, it's unique in the device
+	Register string `json:"register"`
+	// Behavior is one of constant, randomWalk, sine and replay, default constant
+	Behavior string `json:"behavior,omitempty"`
+	// Value is the value of constant registers and the initial value of random walks
+	Value string `json:"value,omitempty"`
+	// Min and Max bound the random walks if Max is greater than Min
+	Min float64 `json:"min,omitempty"`
+	Max float64 `json:"max,omitempty"`
+	// Step is the max change of a random walk between two reads, default 1
+	Step float64 `json:"step,omitempty"`
+	// Amplitude, Offset and Period in milliseconds define the sine waves, the period is 1 minute by default
+	Amplitude float64 `json:"amplitude,omitempty"`
+	Offset    float64 `json:"offset,omitempty"`
+	Period    int64   `json:"period,omitempty"`
+	// File is the CSV file replayed row by row, the replay restarts after the last row
+	File string `json:"file,omitempty"`
+	// Column is the index of the replayed column
+	Column int `json:"column,omitempty"`
+	// Header means the first row of the CSV file is skipped
+	Header bool `json:"header,omitempty"`
+}
+
+type register struct {
+	// written is the value written to the register, it's read back instead of the generated values
+	written interface{}
+	walk    *float64
+	// hold means the next read of the random walk returns its current value without a step
+	hold bool
+	rows []string
+	next int
+}
+
+// Device is a simulated device
+type Device struct {
+	mu        sync.Mutex
+	rand      *rand.Rand
+	start     time.Time
+	now       func() time.Time
+	registers map[string]*register
+}
+
+// NewDevice creates a simulated device.
+func NewDevice(config ProtocolConfigData) *Device {
+	seed := config.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &Device{
+		rand:      rand.New(rand.NewSource(seed)),
+		start:     time.Now(),
+		now:       time.Now,
+		registers: make(map[string]*register),
+	}
+}
+
+func (d *Device) register(name string) *register {
+	r, ok := d.registers[name]
+	if !ok {
+		r = &register{}
+		d.registers[name] = r
+	}
+	return r
+}
+
+// Read returns the next value of the register converted to the data type.
+func (d *Device) Read(dataType string, visitor *VisitorConfigData) (interface{}, error) {
+	if visitor == nil || visitor.Register == "" {
+		return nil, errors.New("the register of the simulated property is required")
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	r := d.register(visitor.Register)
+	switch visitor.Behavior {
+	case BehaviorRandomWalk:
+		return d.randomWalk(r, dataType, visitor)
+	case BehaviorSine:
+		if r.written != nil {
+			return r.written, nil
+		}
+		period := defaultPeriod
+		if visitor.Period > 0 {
+			period = time.Duration(visitor.Period) * time.Millisecond
+		}
+		phase := 2 * math.Pi * float64(d.now().Sub(d.start)%period) / float64(period)
+		return fromFloat(dataType, visitor.Offset+visitor.Amplitude*math.Sin(phase))
+	case BehaviorReplay:
+		if r.written != nil {
+			return r.written, nil
+		}
+		return replay(r, dataType, visitor)
+	case "", BehaviorConstant:
+		if r.written != nil {
+			return r.written, nil
+		}
+		return common.Convert(dataType, visitor.Value)
+	default:
+		return nil, fmt.Errorf("unsupported simulation behavior %s", visitor.Behavior)
+	}
+}
+
+// Write writes the value to the register, the value is read back until the next write.
+// Random walks continue from the written value instead. Writing an empty string is ignored,
+// it's written when the property has no desired value.
+func (d *Device) Write(visitor *VisitorConfigData, value interface{}) error {
+	if visitor == nil || visitor.Register == "" {
+		return errors.New("the register of the simulated property is required")
+	}
+	if s, ok := value.(string); ok && s == "" {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	r := d.register(visitor.Register)
+	if visitor.Behavior == BehaviorRandomWalk {
+		f, err := toFloat(value)
+		if err != nil {
+			return err
+		}
+		r.walk = &f
+		r.hold = true
+		return nil
+	}
+	r.written = value
+	return nil
+}
+
+func (d *Device) randomWalk(r *register, dataType string, visitor *VisitorConfigData) (interface{}, error) {
+	if r.walk == nil {
+		initial := (visitor.Min + visitor.Max) / 2
+		if visitor.Value != "" {
+			v, err := strconv.ParseFloat(visitor.Value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid initial value %s of random walk: %v", visitor.Value, err)
+			}
+			initial = v
+		}
+		r.walk = &initial
+		r.hold = true
+	}
+	if r.hold {
+		r.hold = false
+	} else {
+		step := visitor.Step
+		if step <= 0 {
+			step = defaultStep
+		}
+		*r.walk += (d.rand.Float64()*2 - 1) * step
+	}
+	if visitor.Max > visitor.Min {
+		*r.walk = math.Max(visitor.Min, math.Min(visitor.Max, *r.walk))
+	}
+	return fromFloat(dataType, *r.walk)
+}
+
+func replay(r *register, dataType string, visitor *VisitorConfigData) (interface{}, error) {
+	if r.rows == nil {
+		rows, err := readColumn(visitor.File, visitor.Column, visitor.Header)
+		if err != nil {
+			return nil, err
+		}
+		r.rows = rows
+	}
+	value := r.rows[r.next]
+	r.next = (r.next + 1) % len(r.rows)
+	return common.Convert(dataType, value)
+}
+
+// readColumn reads the column of the CSV file
+func readColumn(file string, column int, header bool) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("read CSV file %s failed: %v", file, err)
+	}
+	if header && len(records) > 0 {
+		records = records[1:]
+	}
+	rows := make([]string, 0, len(records))
+	for _, record := range records {
+		if column < 0 || column >= len(record) {
+			return nil, fmt.Errorf("column %d is out of range in CSV file %s", column, file)
+		}
+		rows = append(rows, record[column])
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("nothing to replay in CSV file %s", file)
+	}
+	return rows, nil
+}
+
+// fromFloat converts the generated value to the data type
+func fromFloat(dataType string, f float64) (interface{}, error) {
+	switch dataType {
+	case "int":
+		return int64(math.Round(f)), nil
+	case "float", "double":
+		return f, nil
+	case "boolean":
+		return f >= 0.5, nil
+	case "string":
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	default:
+		return nil, fmt.Errorf("data type %s can't be simulated by numbers", dataType)
+	}
+}
+
+func toFloat(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("can't write %v of type %T to a random walk", value, value)
+	}
+}
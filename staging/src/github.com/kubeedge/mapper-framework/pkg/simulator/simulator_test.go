@@ -0,0 +1,297 @@
+/*
+Copyright 2025 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func readN(t *testing.T, d *Device, dataType string, visitor *VisitorConfigData, n int) []interface{} {
+	t.Helper()
+	values := make([]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		v, err := d.Read(dataType, visitor)
+		if err != nil {
+			t.Fatalf("failed to read register %s: %v", visitor.Register, err)
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+func writeCSV(t *testing.T, content string) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "replay.csv")
+	if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write CSV file: %v", err)
+	}
+	return file
+}
+
+func TestReadInvalidVisitor(t *testing.T) {
+	d := NewDevice(ProtocolConfigData{Seed: 1})
+	if _, err := d.Read("int", nil); err == nil {
+		t.Errorf("expected error when the visitor is nil")
+	}
+	if _, err := d.Read("int", &VisitorConfigData{}); err == nil {
+		t.Errorf("expected error when the register is empty")
+	}
+	if _, err := d.Read("int", &VisitorConfigData{Register: "r", Behavior: "square"}); err == nil {
+		t.Errorf("expected error when the behavior is unsupported")
+	}
+}
+
+func TestConstant(t *testing.T) {
+	d := NewDevice(ProtocolConfigData{Seed: 1})
+	visitor := &VisitorConfigData{Register: "r", Value: "42"}
+	for _, v := range readN(t, d, "int", visitor, 3) {
+		if v != int64(42) {
+			t.Errorf("expected constant 42, but got %v", v)
+		}
+	}
+}
+
+func TestRandomWalkReproducible(t *testing.T) {
+	visitor := &VisitorConfigData{Register: "r", Behavior: BehaviorRandomWalk, Value: "5", Min: 0, Max: 10, Step: 3}
+	first := readN(t, NewDevice(ProtocolConfigData{Seed: 42}), "double", visitor, 100)
+	second := readN(t, NewDevice(ProtocolConfigData{Seed: 42}), "double", visitor, 100)
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("expected the random walks of the same seed to be equal, but got %v and %v", first, second)
+	}
+
+	if first[0] != 5.0 {
+		t.Errorf("expected the random walk to start with 5, but got %v", first[0])
+	}
+	for i, v := range first {
+		f := v.(float64)
+		if f < 0 || f > 10 {
+			t.Errorf("expected value %d in [0, 10], but got %v", i, f)
+		}
+		if i > 0 && math.Abs(f-first[i-1].(float64)) > 3 {
+			t.Errorf("expected value %d to change by at most 3, but got %v after %v", i, f, first[i-1])
+		}
+	}
+}
+
+func TestRandomWalkClamp(t *testing.T) {
+	d := NewDevice(ProtocolConfigData{Seed: 1})
+	high := &VisitorConfigData{Register: "high", Behavior: BehaviorRandomWalk, Value: "20", Min: 0, Max: 10}
+	if v, err := d.Read("double", high); err != nil || v != 10.0 {
+		t.Errorf("expected the initial value clamped to 10, but got %v, %v", v, err)
+	}
+	low := &VisitorConfigData{Register: "low", Behavior: BehaviorRandomWalk, Value: "-20", Min: 0, Max: 10}
+	if v, err := d.Read("double", low); err != nil || v != 0.0 {
+		t.Errorf("expected the initial value clamped to 0, but got %v, %v", v, err)
+	}
+	// it's bounded only if Max is greater than Min
+	unbounded := &VisitorConfigData{Register: "unbounded", Behavior: BehaviorRandomWalk, Value: "20"}
+	if v, err := d.Read("double", unbounded); err != nil || v != 20.0 {
+		t.Errorf("expected the initial value 20 of the unbounded random walk, but got %v, %v", v, err)
+	}
+	// the initial value defaults to the middle of the bounds
+	middle := &VisitorConfigData{Register: "middle", Behavior: BehaviorRandomWalk, Min: 2, Max: 8}
+	if v, err := d.Read("double", middle); err != nil || v != 5.0 {
+		t.Errorf("expected the initial value 5 in the middle of the bounds, but got %v, %v", v, err)
+	}
+	invalid := &VisitorConfigData{Register: "invalid", Behavior: BehaviorRandomWalk, Value: "abc"}
+	if _, err := d.Read("double", invalid); err == nil {
+		t.Errorf("expected error when the initial value is not a number")
+	}
+}
+
+func TestSine(t *testing.T) {
+	d := NewDevice(ProtocolConfigData{Seed: 1})
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	d.start = start
+	visitor := &VisitorConfigData{Register: "r", Behavior: BehaviorSine, Amplitude: 2, Offset: 10, Period: 4000}
+
+	cases := []struct {
+		elapsed time.Duration
+		want    float64
+	}{
+		{elapsed: 0, want: 10},
+		{elapsed: time.Second, want: 12},
+		{elapsed: 2 * time.Second, want: 10},
+		{elapsed: 3 * time.Second, want: 8},
+		// the phase restarts after a period
+		{elapsed: 5 * time.Second, want: 12},
+	}
+	for _, c := range cases {
+		d.now = func() time.Time { return start.Add(c.elapsed) }
+		v, err := d.Read("double", visitor)
+		if err != nil {
+			t.Fatalf("failed to read sine: %v", err)
+		}
+		if math.Abs(v.(float64)-c.want) > 1e-9 {
+			t.Errorf("expected %v after %s, but got %v", c.want, c.elapsed, v)
+		}
+	}
+
+	// the period is 1 minute by default
+	d.now = func() time.Time { return start.Add(15 * time.Second) }
+	v, err := d.Read("double", &VisitorConfigData{Register: "default", Behavior: BehaviorSine, Amplitude: 1})
+	if err != nil || math.Abs(v.(float64)-1) > 1e-9 {
+		t.Errorf("expected 1 after a quarter of the default period, but got %v, %v", v, err)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	file := writeCSV(t, "time,temperature\n1,20\n2,21\n3,22\n")
+
+	d := NewDevice(ProtocolConfigData{Seed: 1})
+	visitor := &VisitorConfigData{Register: "r", Behavior: BehaviorReplay, File: file, Column: 1, Header: true}
+	got := readN(t, d, "int", visitor, 5)
+	want := []interface{}{int64(20), int64(21), int64(22), int64(20), int64(21)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected the replay to wrap around as %v, but got %v", want, got)
+	}
+
+	// the header is replayed as a row if Header is not set
+	d = NewDevice(ProtocolConfigData{Seed: 1})
+	noHeader := &VisitorConfigData{Register: "r", Behavior: BehaviorReplay, File: file, Column: 1}
+	if v, err := d.Read("string", noHeader); err != nil || v != "temperature" {
+		t.Errorf("expected the header replayed, but got %v, %v", v, err)
+	}
+	if _, err := NewDevice(ProtocolConfigData{Seed: 1}).Read("int", noHeader); err == nil {
+		t.Errorf("expected error when the header is converted to int")
+	}
+}
+
+func TestReplayErrors(t *testing.T) {
+	file := writeCSV(t, "time,temperature\n1,20\n")
+	headerOnly := writeCSV(t, "time,temperature\n")
+
+	cases := map[string]*VisitorConfigData{
+		"column out of range": {Register: "r", Behavior: BehaviorReplay, File: file, Column: 2, Header: true},
+		"negative column":     {Register: "r", Behavior: BehaviorReplay, File: file, Column: -1, Header: true},
+		"nothing to replay":   {Register: "r", Behavior: BehaviorReplay, File: headerOnly, Header: true},
+		"file not found":      {Register: "r", Behavior: BehaviorReplay, File: filepath.Join(t.TempDir(), "missing.csv")},
+	}
+	for name, visitor := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewDevice(ProtocolConfigData{Seed: 1}).Read("int", visitor); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	d := NewDevice(ProtocolConfigData{Seed: 1})
+	if err := d.Write(nil, 1); err == nil {
+		t.Errorf("expected error when the visitor is nil")
+	}
+
+	constant := &VisitorConfigData{Register: "constant", Value: "1"}
+	if err := d.Write(constant, int64(5)); err != nil {
+		t.Fatalf("failed to write constant: %v", err)
+	}
+	// an empty value means no desired value and is ignored
+	if err := d.Write(constant, ""); err != nil {
+		t.Fatalf("failed to write empty value: %v", err)
+	}
+	if v, err := d.Read("int", constant); err != nil || v != int64(5) {
+		t.Errorf("expected the written value 5 read back, but got %v, %v", v, err)
+	}
+
+	sine := &VisitorConfigData{Register: "sine", Behavior: BehaviorSine, Amplitude: 1}
+	if err := d.Write(sine, 0.5); err != nil {
+		t.Fatalf("failed to write sine: %v", err)
+	}
+	if v, err := d.Read("double", sine); err != nil || v != 0.5 {
+		t.Errorf("expected the written value 0.5 read back, but got %v, %v", v, err)
+	}
+
+	replayed := &VisitorConfigData{Register: "replay", Behavior: BehaviorReplay, File: filepath.Join(t.TempDir(), "missing.csv")}
+	if err := d.Write(replayed, "on"); err != nil {
+		t.Fatalf("failed to write replay: %v", err)
+	}
+	if v, err := d.Read("string", replayed); err != nil || v != "on" {
+		t.Errorf("expected the written value on read back, but got %v, %v", v, err)
+	}
+}
+
+func TestWriteRandomWalk(t *testing.T) {
+	d := NewDevice(ProtocolConfigData{Seed: 1})
+	visitor := &VisitorConfigData{Register: "r", Behavior: BehaviorRandomWalk, Value: "5", Min: 0, Max: 100, Step: 1}
+	readN(t, d, "double", visitor, 3)
+
+	if err := d.Write(visitor, "50"); err != nil {
+		t.Fatalf("failed to write random walk: %v", err)
+	}
+	values := readN(t, d, "double", visitor, 10)
+	if values[0] != 50.0 {
+		t.Errorf("expected the written value 50 read back, but got %v", values[0])
+	}
+	for i := 1; i < len(values); i++ {
+		if math.Abs(values[i].(float64)-values[i-1].(float64)) > 1 {
+			t.Errorf("expected the random walk to continue from the written value, but got %v", values)
+			break
+		}
+	}
+
+	if err := d.Write(visitor, true); err != nil {
+		t.Fatalf("failed to write boolean to random walk: %v", err)
+	}
+	if v, err := d.Read("double", visitor); err != nil || v != 1.0 {
+		t.Errorf("expected the written boolean read back as 1, but got %v, %v", v, err)
+	}
+	if err := d.Write(visitor, "abc"); err == nil {
+		t.Errorf("expected error when a non-number is written to a random walk")
+	}
+	if err := d.Write(visitor, []int{1}); err == nil {
+		t.Errorf("expected error when a slice is written to a random walk")
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	cases := []struct {
+		dataType string
+		value    float64
+		want     interface{}
+		wantErr  bool
+	}{
+		{dataType: "int", value: 2.4, want: int64(2)},
+		{dataType: "int", value: 2.5, want: int64(3)},
+		{dataType: "int", value: -2.5, want: int64(-3)},
+		{dataType: "float", value: 1.5, want: 1.5},
+		{dataType: "double", value: 1.25, want: 1.25},
+		{dataType: "boolean", value: 0.5, want: true},
+		{dataType: "boolean", value: 0.49, want: false},
+		{dataType: "string", value: 1.5, want: "1.5"},
+		{dataType: "string", value: 3, want: "3"},
+		{dataType: "object", value: 1, wantErr: true},
+	}
+	for _, c := range cases {
+		got, err := fromFloat(c.dataType, c.value)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("expected error when converting %v to %s", c.value, c.dataType)
+			}
+			continue
+		}
+		if err != nil || got != c.want {
+			t.Errorf("expected %v of %T when converting %v to %s, but got %v of %T, %v",
+				c.want, c.want, c.value, c.dataType, got, got, err)
+		}
+	}
+}
@@ -177,12 +177,19 @@ func buildPropertiesFromGrpc(device *dmiapi.Device) []common.DeviceProperty {
 					return nil
 				}
 			case pptv.PushMethod.Otel != nil:
-				dbMethodName = common.PushMethodOTEL
+				pushMethodName = common.PushMethodOTEL
 				pushMethod, err = json.Marshal(pptv.PushMethod.Otel)
 				if err != nil {
 					klog.Errorf("err: %+v", err)
 					return nil
 				}
+			case pptv.PushMethod.Prometheus != nil:
+				pushMethodName = common.PushMethodPrometheus
+				pushMethod, err = json.Marshal(pptv.PushMethod.Prometheus)
+				if err != nil {
+					klog.Errorf("err: %+v", err)
+					return nil
+				}
 			default:
 				klog.Errorf("get PushMethod err: Unsupported pushmethod type")
 			}
@@ -251,6 +258,8 @@ func GetDeviceModelFromGrpc(model *dmiapi.DeviceModel) common.DeviceModel {
 			Minimum:     property.GetMinimum(),
 			Maximum:     property.GetMaximum(),
 			Unit:        property.GetUnit(),
+			EnumValues:  property.GetEnumValues(),
+			Schema:      property.GetSchema(),
 		}
 		properties = append(properties, p)
 	}